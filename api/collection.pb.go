@@ -0,0 +1,983 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: collection.proto
+
+package api
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Models
+type CollectionFolder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	OwnerUid      string                 `protobuf:"bytes,2,opt,name=owner_uid,json=ownerUid,proto3" json:"owner_uid,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,5,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Position      int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	PostCount     int32                  `protobuf:"varint,7,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionFolder) Reset() {
+	*x = CollectionFolder{}
+	mi := &file_collection_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionFolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionFolder) ProtoMessage() {}
+
+func (x *CollectionFolder) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionFolder.ProtoReflect.Descriptor instead.
+func (*CollectionFolder) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CollectionFolder) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CollectionFolder) GetOwnerUid() string {
+	if x != nil {
+		return x.OwnerUid
+	}
+	return ""
+}
+
+func (x *CollectionFolder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionFolder) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *CollectionFolder) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *CollectionFolder) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CollectionFolder) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *CollectionFolder) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CollectionFolder) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateCollectionFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,2,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionFolderRequest) Reset() {
+	*x = CreateCollectionFolderRequest{}
+	mi := &file_collection_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionFolderRequest) ProtoMessage() {}
+
+func (x *CreateCollectionFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionFolderRequest) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCollectionFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCollectionFolderRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type CreateCollectionFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *CollectionFolder      `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionFolderResponse) Reset() {
+	*x = CreateCollectionFolderResponse{}
+	mi := &file_collection_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionFolderResponse) ProtoMessage() {}
+
+func (x *CreateCollectionFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionFolderResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionFolderResponse) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCollectionFolderResponse) GetFolder() *CollectionFolder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type ListMyCollectionFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyCollectionFoldersRequest) Reset() {
+	*x = ListMyCollectionFoldersRequest{}
+	mi := &file_collection_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyCollectionFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyCollectionFoldersRequest) ProtoMessage() {}
+
+func (x *ListMyCollectionFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyCollectionFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListMyCollectionFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{3}
+}
+
+type ListUserCollectionFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserCollectionFoldersRequest) Reset() {
+	*x = ListUserCollectionFoldersRequest{}
+	mi := &file_collection_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserCollectionFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserCollectionFoldersRequest) ProtoMessage() {}
+
+func (x *ListUserCollectionFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserCollectionFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListUserCollectionFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListUserCollectionFoldersRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type ListCollectionFoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folders       []*CollectionFolder    `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionFoldersResponse) Reset() {
+	*x = ListCollectionFoldersResponse{}
+	mi := &file_collection_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionFoldersResponse) ProtoMessage() {}
+
+func (x *ListCollectionFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCollectionFoldersResponse) GetFolders() []*CollectionFolder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+type UpdateCollectionFolderBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,2,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionFolderBody) Reset() {
+	*x = UpdateCollectionFolderBody{}
+	mi := &file_collection_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionFolderBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionFolderBody) ProtoMessage() {}
+
+func (x *UpdateCollectionFolderBody) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionFolderBody.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionFolderBody) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateCollectionFolderBody) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCollectionFolderBody) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type UpdateCollectionFolderRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Uid           string                      `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Folder        *UpdateCollectionFolderBody `protobuf:"bytes,2,opt,name=folder,proto3" json:"folder,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask      `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionFolderRequest) Reset() {
+	*x = UpdateCollectionFolderRequest{}
+	mi := &file_collection_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionFolderRequest) ProtoMessage() {}
+
+func (x *UpdateCollectionFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionFolderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionFolderRequest) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateCollectionFolderRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *UpdateCollectionFolderRequest) GetFolder() *UpdateCollectionFolderBody {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+func (x *UpdateCollectionFolderRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type ReorderCollectionFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderUids    []string               `protobuf:"bytes,1,rep,name=folder_uids,json=folderUids,proto3" json:"folder_uids,omitempty"` // full ordering of the caller's folders
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionFoldersRequest) Reset() {
+	*x = ReorderCollectionFoldersRequest{}
+	mi := &file_collection_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionFoldersRequest) ProtoMessage() {}
+
+func (x *ReorderCollectionFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReorderCollectionFoldersRequest) GetFolderUids() []string {
+	if x != nil {
+		return x.FolderUids
+	}
+	return nil
+}
+
+type DeleteCollectionFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionFolderRequest) Reset() {
+	*x = DeleteCollectionFolderRequest{}
+	mi := &file_collection_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionFolderRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionFolderRequest) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteCollectionFolderRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type FilePostToCollectionFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderUid     string                 `protobuf:"bytes,1,opt,name=folder_uid,json=folderUid,proto3" json:"folder_uid,omitempty"`
+	PostUid       string                 `protobuf:"bytes,2,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"` // private, only visible to the folder owner
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilePostToCollectionFolderRequest) Reset() {
+	*x = FilePostToCollectionFolderRequest{}
+	mi := &file_collection_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilePostToCollectionFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilePostToCollectionFolderRequest) ProtoMessage() {}
+
+func (x *FilePostToCollectionFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilePostToCollectionFolderRequest.ProtoReflect.Descriptor instead.
+func (*FilePostToCollectionFolderRequest) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FilePostToCollectionFolderRequest) GetFolderUid() string {
+	if x != nil {
+		return x.FolderUid
+	}
+	return ""
+}
+
+func (x *FilePostToCollectionFolderRequest) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+func (x *FilePostToCollectionFolderRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RemovePostFromCollectionFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderUid     string                 `protobuf:"bytes,1,opt,name=folder_uid,json=folderUid,proto3" json:"folder_uid,omitempty"`
+	PostUid       string                 `protobuf:"bytes,2,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePostFromCollectionFolderRequest) Reset() {
+	*x = RemovePostFromCollectionFolderRequest{}
+	mi := &file_collection_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePostFromCollectionFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePostFromCollectionFolderRequest) ProtoMessage() {}
+
+func (x *RemovePostFromCollectionFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePostFromCollectionFolderRequest.ProtoReflect.Descriptor instead.
+func (*RemovePostFromCollectionFolderRequest) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemovePostFromCollectionFolderRequest) GetFolderUid() string {
+	if x != nil {
+		return x.FolderUid
+	}
+	return ""
+}
+
+func (x *RemovePostFromCollectionFolderRequest) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+type PostCollectionFolder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderUid     string                 `protobuf:"bytes,1,opt,name=folder_uid,json=folderUid,proto3" json:"folder_uid,omitempty"`
+	FolderName    string                 `protobuf:"bytes,2,opt,name=folder_name,json=folderName,proto3" json:"folder_name,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	FiledAt       int64                  `protobuf:"varint,4,opt,name=filed_at,json=filedAt,proto3" json:"filed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostCollectionFolder) Reset() {
+	*x = PostCollectionFolder{}
+	mi := &file_collection_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostCollectionFolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCollectionFolder) ProtoMessage() {}
+
+func (x *PostCollectionFolder) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCollectionFolder.ProtoReflect.Descriptor instead.
+func (*PostCollectionFolder) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PostCollectionFolder) GetFolderUid() string {
+	if x != nil {
+		return x.FolderUid
+	}
+	return ""
+}
+
+func (x *PostCollectionFolder) GetFolderName() string {
+	if x != nil {
+		return x.FolderName
+	}
+	return ""
+}
+
+func (x *PostCollectionFolder) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PostCollectionFolder) GetFiledAt() int64 {
+	if x != nil {
+		return x.FiledAt
+	}
+	return 0
+}
+
+type ListPostCollectionFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostUid       string                 `protobuf:"bytes,1,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostCollectionFoldersRequest) Reset() {
+	*x = ListPostCollectionFoldersRequest{}
+	mi := &file_collection_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostCollectionFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostCollectionFoldersRequest) ProtoMessage() {}
+
+func (x *ListPostCollectionFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostCollectionFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListPostCollectionFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListPostCollectionFoldersRequest) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+type ListPostCollectionFoldersResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Folders       []*PostCollectionFolder `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostCollectionFoldersResponse) Reset() {
+	*x = ListPostCollectionFoldersResponse{}
+	mi := &file_collection_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostCollectionFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostCollectionFoldersResponse) ProtoMessage() {}
+
+func (x *ListPostCollectionFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostCollectionFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListPostCollectionFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_collection_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListPostCollectionFoldersResponse) GetFolders() []*PostCollectionFolder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+var File_collection_proto protoreflect.FileDescriptor
+
+const file_collection_proto_rawDesc = "" +
+	"\n" +
+	"\x10collection.proto\x12\n" +
+	"collection\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xb7\x02\n" +
+	"\x10CollectionFolder\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12 \n" +
+	"\towner_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\bownerUid\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12\"\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bB\x03\xe0A\x02R\tisDefault\x12 \n" +
+	"\tis_public\x18\x05 \x01(\bB\x03\xe0A\x02R\bisPublic\x12\x1f\n" +
+	"\bposition\x18\x06 \x01(\x05B\x03\xe0A\x02R\bposition\x12\"\n" +
+	"\n" +
+	"post_count\x18\a \x01(\x05B\x03\xe0A\x02R\tpostCount\x12\"\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x12\"\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03B\x03\xe0A\x02R\tupdatedAt\"U\n" +
+	"\x1dCreateCollectionFolderRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1b\n" +
+	"\tis_public\x18\x02 \x01(\bR\bisPublic\"[\n" +
+	"\x1eCreateCollectionFolderResponse\x129\n" +
+	"\x06folder\x18\x01 \x01(\v2\x1c.collection.CollectionFolderB\x03\xe0A\x02R\x06folder\" \n" +
+	"\x1eListMyCollectionFoldersRequest\"9\n" +
+	" ListUserCollectionFoldersRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"\\\n" +
+	"\x1dListCollectionFoldersResponse\x12;\n" +
+	"\afolders\x18\x01 \x03(\v2\x1c.collection.CollectionFolderB\x03\xe0A\x02R\afolders\"M\n" +
+	"\x1aUpdateCollectionFolderBody\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_public\x18\x02 \x01(\bR\bisPublic\"\xbd\x01\n" +
+	"\x1dUpdateCollectionFolderRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12C\n" +
+	"\x06folder\x18\x02 \x01(\v2&.collection.UpdateCollectionFolderBodyB\x03\xe0A\x02R\x06folder\x12@\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"G\n" +
+	"\x1fReorderCollectionFoldersRequest\x12$\n" +
+	"\vfolder_uids\x18\x01 \x03(\tB\x03\xe0A\x02R\n" +
+	"folderUids\"6\n" +
+	"\x1dDeleteCollectionFolderRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"{\n" +
+	"!FilePostToCollectionFolderRequest\x12\"\n" +
+	"\n" +
+	"folder_uid\x18\x01 \x01(\tB\x03\xe0A\x02R\tfolderUid\x12\x1e\n" +
+	"\bpost_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\apostUid\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"k\n" +
+	"%RemovePostFromCollectionFolderRequest\x12\"\n" +
+	"\n" +
+	"folder_uid\x18\x01 \x01(\tB\x03\xe0A\x02R\tfolderUid\x12\x1e\n" +
+	"\bpost_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\apostUid\"\x99\x01\n" +
+	"\x14PostCollectionFolder\x12\"\n" +
+	"\n" +
+	"folder_uid\x18\x01 \x01(\tB\x03\xe0A\x02R\tfolderUid\x12$\n" +
+	"\vfolder_name\x18\x02 \x01(\tB\x03\xe0A\x02R\n" +
+	"folderName\x12\x17\n" +
+	"\x04note\x18\x03 \x01(\tB\x03\xe0A\x02R\x04note\x12\x1e\n" +
+	"\bfiled_at\x18\x04 \x01(\x03B\x03\xe0A\x02R\afiledAt\"B\n" +
+	" ListPostCollectionFoldersRequest\x12\x1e\n" +
+	"\bpost_uid\x18\x01 \x01(\tB\x03\xe0A\x02R\apostUid\"d\n" +
+	"!ListPostCollectionFoldersResponse\x12?\n" +
+	"\afolders\x18\x01 \x03(\v2 .collection.PostCollectionFolderB\x03\xe0A\x02R\afolders2\xb1\v\n" +
+	"\x11CollectionService\x12\x99\x01\n" +
+	"\x16CreateCollectionFolder\x12).collection.CreateCollectionFolderRequest\x1a*.collection.CreateCollectionFolderResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/me/collection-folders\x12\x97\x01\n" +
+	"\x17ListMyCollectionFolders\x12*.collection.ListMyCollectionFoldersRequest\x1a).collection.ListCollectionFoldersResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/me/collection-folders\x12\xa4\x01\n" +
+	"\x19ListUserCollectionFolders\x12,.collection.ListUserCollectionFoldersRequest\x1a).collection.ListCollectionFoldersResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/users/{uid}/collection-folders\x12\x90\x01\n" +
+	"\x16UpdateCollectionFolder\x12).collection.UpdateCollectionFolderRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-:\x06folder2#/api/v1/me/collection-folders/{uid}\x12\x91\x01\n" +
+	"\x18ReorderCollectionFolders\x12+.collection.ReorderCollectionFoldersRequest\x1a\x16.google.protobuf.Empty\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/me/collection-folders/reorder\x12\x88\x01\n" +
+	"\x16DeleteCollectionFolder\x12).collection.DeleteCollectionFolderRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%*#/api/v1/me/collection-folders/{uid}\x12\xab\x01\n" +
+	"\x1aFilePostToCollectionFolder\x12-.collection.FilePostToCollectionFolderRequest\x1a\x16.google.protobuf.Empty\"F\x82\xd3\xe4\x93\x02@:\x01*\x1a;/api/v1/me/collection-folders/{folder_uid}/posts/{post_uid}\x12\xb0\x01\n" +
+	"\x1eRemovePostFromCollectionFolder\x121.collection.RemovePostFromCollectionFolderRequest\x1a\x16.google.protobuf.Empty\"C\x82\xd3\xe4\x93\x02=*;/api/v1/me/collection-folders/{folder_uid}/posts/{post_uid}\x12\xab\x01\n" +
+	"\x19ListPostCollectionFolders\x12,.collection.ListPostCollectionFoldersRequest\x1a-.collection.ListPostCollectionFoldersResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/v1/me/collections/{post_uid}/foldersB\x0fZ\raeibi/api;apib\x06proto3"
+
+var (
+	file_collection_proto_rawDescOnce sync.Once
+	file_collection_proto_rawDescData []byte
+)
+
+func file_collection_proto_rawDescGZIP() []byte {
+	file_collection_proto_rawDescOnce.Do(func() {
+		file_collection_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_collection_proto_rawDesc), len(file_collection_proto_rawDesc)))
+	})
+	return file_collection_proto_rawDescData
+}
+
+var file_collection_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_collection_proto_goTypes = []any{
+	(*CollectionFolder)(nil),                      // 0: collection.CollectionFolder
+	(*CreateCollectionFolderRequest)(nil),         // 1: collection.CreateCollectionFolderRequest
+	(*CreateCollectionFolderResponse)(nil),        // 2: collection.CreateCollectionFolderResponse
+	(*ListMyCollectionFoldersRequest)(nil),        // 3: collection.ListMyCollectionFoldersRequest
+	(*ListUserCollectionFoldersRequest)(nil),      // 4: collection.ListUserCollectionFoldersRequest
+	(*ListCollectionFoldersResponse)(nil),         // 5: collection.ListCollectionFoldersResponse
+	(*UpdateCollectionFolderBody)(nil),            // 6: collection.UpdateCollectionFolderBody
+	(*UpdateCollectionFolderRequest)(nil),         // 7: collection.UpdateCollectionFolderRequest
+	(*ReorderCollectionFoldersRequest)(nil),       // 8: collection.ReorderCollectionFoldersRequest
+	(*DeleteCollectionFolderRequest)(nil),         // 9: collection.DeleteCollectionFolderRequest
+	(*FilePostToCollectionFolderRequest)(nil),     // 10: collection.FilePostToCollectionFolderRequest
+	(*RemovePostFromCollectionFolderRequest)(nil), // 11: collection.RemovePostFromCollectionFolderRequest
+	(*PostCollectionFolder)(nil),                  // 12: collection.PostCollectionFolder
+	(*ListPostCollectionFoldersRequest)(nil),      // 13: collection.ListPostCollectionFoldersRequest
+	(*ListPostCollectionFoldersResponse)(nil),     // 14: collection.ListPostCollectionFoldersResponse
+	(*fieldmaskpb.FieldMask)(nil),                 // 15: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                         // 16: google.protobuf.Empty
+}
+var file_collection_proto_depIdxs = []int32{
+	0,  // 0: collection.CreateCollectionFolderResponse.folder:type_name -> collection.CollectionFolder
+	0,  // 1: collection.ListCollectionFoldersResponse.folders:type_name -> collection.CollectionFolder
+	6,  // 2: collection.UpdateCollectionFolderRequest.folder:type_name -> collection.UpdateCollectionFolderBody
+	15, // 3: collection.UpdateCollectionFolderRequest.update_mask:type_name -> google.protobuf.FieldMask
+	12, // 4: collection.ListPostCollectionFoldersResponse.folders:type_name -> collection.PostCollectionFolder
+	1,  // 5: collection.CollectionService.CreateCollectionFolder:input_type -> collection.CreateCollectionFolderRequest
+	3,  // 6: collection.CollectionService.ListMyCollectionFolders:input_type -> collection.ListMyCollectionFoldersRequest
+	4,  // 7: collection.CollectionService.ListUserCollectionFolders:input_type -> collection.ListUserCollectionFoldersRequest
+	7,  // 8: collection.CollectionService.UpdateCollectionFolder:input_type -> collection.UpdateCollectionFolderRequest
+	8,  // 9: collection.CollectionService.ReorderCollectionFolders:input_type -> collection.ReorderCollectionFoldersRequest
+	9,  // 10: collection.CollectionService.DeleteCollectionFolder:input_type -> collection.DeleteCollectionFolderRequest
+	10, // 11: collection.CollectionService.FilePostToCollectionFolder:input_type -> collection.FilePostToCollectionFolderRequest
+	11, // 12: collection.CollectionService.RemovePostFromCollectionFolder:input_type -> collection.RemovePostFromCollectionFolderRequest
+	13, // 13: collection.CollectionService.ListPostCollectionFolders:input_type -> collection.ListPostCollectionFoldersRequest
+	2,  // 14: collection.CollectionService.CreateCollectionFolder:output_type -> collection.CreateCollectionFolderResponse
+	5,  // 15: collection.CollectionService.ListMyCollectionFolders:output_type -> collection.ListCollectionFoldersResponse
+	5,  // 16: collection.CollectionService.ListUserCollectionFolders:output_type -> collection.ListCollectionFoldersResponse
+	16, // 17: collection.CollectionService.UpdateCollectionFolder:output_type -> google.protobuf.Empty
+	16, // 18: collection.CollectionService.ReorderCollectionFolders:output_type -> google.protobuf.Empty
+	16, // 19: collection.CollectionService.DeleteCollectionFolder:output_type -> google.protobuf.Empty
+	16, // 20: collection.CollectionService.FilePostToCollectionFolder:output_type -> google.protobuf.Empty
+	16, // 21: collection.CollectionService.RemovePostFromCollectionFolder:output_type -> google.protobuf.Empty
+	14, // 22: collection.CollectionService.ListPostCollectionFolders:output_type -> collection.ListPostCollectionFoldersResponse
+	14, // [14:23] is the sub-list for method output_type
+	5,  // [5:14] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_collection_proto_init() }
+func file_collection_proto_init() {
+	if File_collection_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_collection_proto_rawDesc), len(file_collection_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_collection_proto_goTypes,
+		DependencyIndexes: file_collection_proto_depIdxs,
+		MessageInfos:      file_collection_proto_msgTypes,
+	}.Build()
+	File_collection_proto = out.File
+	file_collection_proto_goTypes = nil
+	file_collection_proto_depIdxs = nil
+}
@@ -0,0 +1,448 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: collection.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CollectionService_CreateCollectionFolder_FullMethodName         = "/collection.CollectionService/CreateCollectionFolder"
+	CollectionService_ListMyCollectionFolders_FullMethodName        = "/collection.CollectionService/ListMyCollectionFolders"
+	CollectionService_ListUserCollectionFolders_FullMethodName      = "/collection.CollectionService/ListUserCollectionFolders"
+	CollectionService_UpdateCollectionFolder_FullMethodName         = "/collection.CollectionService/UpdateCollectionFolder"
+	CollectionService_ReorderCollectionFolders_FullMethodName       = "/collection.CollectionService/ReorderCollectionFolders"
+	CollectionService_DeleteCollectionFolder_FullMethodName         = "/collection.CollectionService/DeleteCollectionFolder"
+	CollectionService_FilePostToCollectionFolder_FullMethodName     = "/collection.CollectionService/FilePostToCollectionFolder"
+	CollectionService_RemovePostFromCollectionFolder_FullMethodName = "/collection.CollectionService/RemovePostFromCollectionFolder"
+	CollectionService_ListPostCollectionFolders_FullMethodName      = "/collection.CollectionService/ListPostCollectionFolders"
+)
+
+// CollectionServiceClient is the client API for CollectionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CollectionService
+type CollectionServiceClient interface {
+	// POST /api/v1/me/collection-folders 创建收藏夹
+	CreateCollectionFolder(ctx context.Context, in *CreateCollectionFolderRequest, opts ...grpc.CallOption) (*CreateCollectionFolderResponse, error)
+	// GET /api/v1/me/collection-folders 当前用户收藏夹列表
+	ListMyCollectionFolders(ctx context.Context, in *ListMyCollectionFoldersRequest, opts ...grpc.CallOption) (*ListCollectionFoldersResponse, error)
+	// GET /api/v1/users/{uid}/collection-folders 用户公开收藏夹列表
+	ListUserCollectionFolders(ctx context.Context, in *ListUserCollectionFoldersRequest, opts ...grpc.CallOption) (*ListCollectionFoldersResponse, error)
+	// PATCH /api/v1/me/collection-folders/{uid} 重命名/修改公开状态
+	UpdateCollectionFolder(ctx context.Context, in *UpdateCollectionFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// POST /api/v1/me/collection-folders/reorder 调整收藏夹顺序
+	ReorderCollectionFolders(ctx context.Context, in *ReorderCollectionFoldersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DELETE /api/v1/me/collection-folders/{uid} 删除收藏夹
+	DeleteCollectionFolder(ctx context.Context, in *DeleteCollectionFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PUT /api/v1/me/collection-folders/{folder_uid}/posts/{post_uid} 将已收藏帖子归入收藏夹并设置备注
+	FilePostToCollectionFolder(ctx context.Context, in *FilePostToCollectionFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DELETE /api/v1/me/collection-folders/{folder_uid}/posts/{post_uid} 将帖子移出收藏夹
+	RemovePostFromCollectionFolder(ctx context.Context, in *RemovePostFromCollectionFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GET /api/v1/me/collections/{post_uid}/folders 帖子所在收藏夹及备注
+	ListPostCollectionFolders(ctx context.Context, in *ListPostCollectionFoldersRequest, opts ...grpc.CallOption) (*ListPostCollectionFoldersResponse, error)
+}
+
+type collectionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCollectionServiceClient(cc grpc.ClientConnInterface) CollectionServiceClient {
+	return &collectionServiceClient{cc}
+}
+
+func (c *collectionServiceClient) CreateCollectionFolder(ctx context.Context, in *CreateCollectionFolderRequest, opts ...grpc.CallOption) (*CreateCollectionFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCollectionFolderResponse)
+	err := c.cc.Invoke(ctx, CollectionService_CreateCollectionFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListMyCollectionFolders(ctx context.Context, in *ListMyCollectionFoldersRequest, opts ...grpc.CallOption) (*ListCollectionFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionFoldersResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListMyCollectionFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListUserCollectionFolders(ctx context.Context, in *ListUserCollectionFoldersRequest, opts ...grpc.CallOption) (*ListCollectionFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionFoldersResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListUserCollectionFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) UpdateCollectionFolder(ctx context.Context, in *UpdateCollectionFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_UpdateCollectionFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ReorderCollectionFolders(ctx context.Context, in *ReorderCollectionFoldersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_ReorderCollectionFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) DeleteCollectionFolder(ctx context.Context, in *DeleteCollectionFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_DeleteCollectionFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) FilePostToCollectionFolder(ctx context.Context, in *FilePostToCollectionFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_FilePostToCollectionFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) RemovePostFromCollectionFolder(ctx context.Context, in *RemovePostFromCollectionFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_RemovePostFromCollectionFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListPostCollectionFolders(ctx context.Context, in *ListPostCollectionFoldersRequest, opts ...grpc.CallOption) (*ListPostCollectionFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostCollectionFoldersResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListPostCollectionFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CollectionServiceServer is the server API for CollectionService service.
+// All implementations must embed UnimplementedCollectionServiceServer
+// for forward compatibility.
+//
+// CollectionService
+type CollectionServiceServer interface {
+	// POST /api/v1/me/collection-folders 创建收藏夹
+	CreateCollectionFolder(context.Context, *CreateCollectionFolderRequest) (*CreateCollectionFolderResponse, error)
+	// GET /api/v1/me/collection-folders 当前用户收藏夹列表
+	ListMyCollectionFolders(context.Context, *ListMyCollectionFoldersRequest) (*ListCollectionFoldersResponse, error)
+	// GET /api/v1/users/{uid}/collection-folders 用户公开收藏夹列表
+	ListUserCollectionFolders(context.Context, *ListUserCollectionFoldersRequest) (*ListCollectionFoldersResponse, error)
+	// PATCH /api/v1/me/collection-folders/{uid} 重命名/修改公开状态
+	UpdateCollectionFolder(context.Context, *UpdateCollectionFolderRequest) (*emptypb.Empty, error)
+	// POST /api/v1/me/collection-folders/reorder 调整收藏夹顺序
+	ReorderCollectionFolders(context.Context, *ReorderCollectionFoldersRequest) (*emptypb.Empty, error)
+	// DELETE /api/v1/me/collection-folders/{uid} 删除收藏夹
+	DeleteCollectionFolder(context.Context, *DeleteCollectionFolderRequest) (*emptypb.Empty, error)
+	// PUT /api/v1/me/collection-folders/{folder_uid}/posts/{post_uid} 将已收藏帖子归入收藏夹并设置备注
+	FilePostToCollectionFolder(context.Context, *FilePostToCollectionFolderRequest) (*emptypb.Empty, error)
+	// DELETE /api/v1/me/collection-folders/{folder_uid}/posts/{post_uid} 将帖子移出收藏夹
+	RemovePostFromCollectionFolder(context.Context, *RemovePostFromCollectionFolderRequest) (*emptypb.Empty, error)
+	// GET /api/v1/me/collections/{post_uid}/folders 帖子所在收藏夹及备注
+	ListPostCollectionFolders(context.Context, *ListPostCollectionFoldersRequest) (*ListPostCollectionFoldersResponse, error)
+	mustEmbedUnimplementedCollectionServiceServer()
+}
+
+// UnimplementedCollectionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCollectionServiceServer struct{}
+
+func (UnimplementedCollectionServiceServer) CreateCollectionFolder(context.Context, *CreateCollectionFolderRequest) (*CreateCollectionFolderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCollectionFolder not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListMyCollectionFolders(context.Context, *ListMyCollectionFoldersRequest) (*ListCollectionFoldersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyCollectionFolders not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListUserCollectionFolders(context.Context, *ListUserCollectionFoldersRequest) (*ListCollectionFoldersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserCollectionFolders not implemented")
+}
+func (UnimplementedCollectionServiceServer) UpdateCollectionFolder(context.Context, *UpdateCollectionFolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCollectionFolder not implemented")
+}
+func (UnimplementedCollectionServiceServer) ReorderCollectionFolders(context.Context, *ReorderCollectionFoldersRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderCollectionFolders not implemented")
+}
+func (UnimplementedCollectionServiceServer) DeleteCollectionFolder(context.Context, *DeleteCollectionFolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCollectionFolder not implemented")
+}
+func (UnimplementedCollectionServiceServer) FilePostToCollectionFolder(context.Context, *FilePostToCollectionFolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method FilePostToCollectionFolder not implemented")
+}
+func (UnimplementedCollectionServiceServer) RemovePostFromCollectionFolder(context.Context, *RemovePostFromCollectionFolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemovePostFromCollectionFolder not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListPostCollectionFolders(context.Context, *ListPostCollectionFoldersRequest) (*ListPostCollectionFoldersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPostCollectionFolders not implemented")
+}
+func (UnimplementedCollectionServiceServer) mustEmbedUnimplementedCollectionServiceServer() {}
+func (UnimplementedCollectionServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeCollectionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CollectionServiceServer will
+// result in compilation errors.
+type UnsafeCollectionServiceServer interface {
+	mustEmbedUnimplementedCollectionServiceServer()
+}
+
+func RegisterCollectionServiceServer(s grpc.ServiceRegistrar, srv CollectionServiceServer) {
+	// If the following call panics, it indicates UnimplementedCollectionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CollectionService_ServiceDesc, srv)
+}
+
+func _CollectionService_CreateCollectionFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).CreateCollectionFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_CreateCollectionFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).CreateCollectionFolder(ctx, req.(*CreateCollectionFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListMyCollectionFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyCollectionFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListMyCollectionFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListMyCollectionFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListMyCollectionFolders(ctx, req.(*ListMyCollectionFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListUserCollectionFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserCollectionFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListUserCollectionFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListUserCollectionFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListUserCollectionFolders(ctx, req.(*ListUserCollectionFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_UpdateCollectionFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).UpdateCollectionFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_UpdateCollectionFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).UpdateCollectionFolder(ctx, req.(*UpdateCollectionFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ReorderCollectionFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCollectionFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ReorderCollectionFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ReorderCollectionFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ReorderCollectionFolders(ctx, req.(*ReorderCollectionFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_DeleteCollectionFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).DeleteCollectionFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_DeleteCollectionFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).DeleteCollectionFolder(ctx, req.(*DeleteCollectionFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_FilePostToCollectionFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilePostToCollectionFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).FilePostToCollectionFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_FilePostToCollectionFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).FilePostToCollectionFolder(ctx, req.(*FilePostToCollectionFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_RemovePostFromCollectionFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePostFromCollectionFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).RemovePostFromCollectionFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_RemovePostFromCollectionFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).RemovePostFromCollectionFolder(ctx, req.(*RemovePostFromCollectionFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListPostCollectionFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostCollectionFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListPostCollectionFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListPostCollectionFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListPostCollectionFolders(ctx, req.(*ListPostCollectionFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CollectionService_ServiceDesc is the grpc.ServiceDesc for CollectionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CollectionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "collection.CollectionService",
+	HandlerType: (*CollectionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCollectionFolder",
+			Handler:    _CollectionService_CreateCollectionFolder_Handler,
+		},
+		{
+			MethodName: "ListMyCollectionFolders",
+			Handler:    _CollectionService_ListMyCollectionFolders_Handler,
+		},
+		{
+			MethodName: "ListUserCollectionFolders",
+			Handler:    _CollectionService_ListUserCollectionFolders_Handler,
+		},
+		{
+			MethodName: "UpdateCollectionFolder",
+			Handler:    _CollectionService_UpdateCollectionFolder_Handler,
+		},
+		{
+			MethodName: "ReorderCollectionFolders",
+			Handler:    _CollectionService_ReorderCollectionFolders_Handler,
+		},
+		{
+			MethodName: "DeleteCollectionFolder",
+			Handler:    _CollectionService_DeleteCollectionFolder_Handler,
+		},
+		{
+			MethodName: "FilePostToCollectionFolder",
+			Handler:    _CollectionService_FilePostToCollectionFolder_Handler,
+		},
+		{
+			MethodName: "RemovePostFromCollectionFolder",
+			Handler:    _CollectionService_RemovePostFromCollectionFolder_Handler,
+		},
+		{
+			MethodName: "ListPostCollectionFolders",
+			Handler:    _CollectionService_ListPostCollectionFolders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "collection.proto",
+}
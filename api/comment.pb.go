@@ -99,6 +99,12 @@ type Comment struct {
 	UpdatedAt     int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	LikeCount     int32                  `protobuf:"varint,12,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
 	Liked         bool                   `protobuf:"varint,13,opt,name=liked,proto3" json:"liked,omitempty"`
+	IpRegion      string                 `protobuf:"bytes,14,opt,name=ip_region,json=ipRegion,proto3" json:"ip_region,omitempty"` // coarse GeoIP region, empty when unknown
+	Ip            string                 `protobuf:"bytes,15,opt,name=ip,proto3" json:"ip,omitempty"`                             // raw client IP, only returned to admins
+	Reactions     []*Reaction            `protobuf:"bytes,16,rep,name=reactions,proto3" json:"reactions,omitempty"`               // in configured order, zero counts omitted
+	Edited        bool                   `protobuf:"varint,17,opt,name=edited,proto3" json:"edited,omitempty"`
+	Pinned        bool                   `protobuf:"varint,18,opt,name=pinned,proto3" json:"pinned,omitempty"` // pinned by the post author, listed first
+	Attachments   []*Attachment          `protobuf:"bytes,19,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -224,11 +230,54 @@ func (x *Comment) GetLiked() bool {
 	return false
 }
 
+func (x *Comment) GetIpRegion() string {
+	if x != nil {
+		return x.IpRegion
+	}
+	return ""
+}
+
+func (x *Comment) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Comment) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Comment) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *Comment) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Comment) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
 type CreateTopCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostUid       string                 `protobuf:"bytes,1,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
 	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	Images        []string               `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	Attachments   []string               `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -284,6 +333,13 @@ func (x *CreateTopCommentRequest) GetImages() []string {
 	return nil
 }
 
+func (x *CreateTopCommentRequest) GetAttachments() []string {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
 type CreateTopCommentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
@@ -340,6 +396,8 @@ type CreateReplyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ParentUid     string                 `protobuf:"bytes,1,opt,name=parent_uid,json=parentUid,proto3" json:"parent_uid,omitempty"`
 	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Images        []string               `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	Attachments   []string               `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -388,6 +446,20 @@ func (x *CreateReplyRequest) GetContent() string {
 	return ""
 }
 
+func (x *CreateReplyRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *CreateReplyRequest) GetAttachments() []string {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
 type CreateReplyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
@@ -444,6 +516,7 @@ type ListTopCommentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostUid       string                 `protobuf:"bytes,1,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
 	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"` // "newest" (default), "oldest", "likes", "best"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -492,6 +565,13 @@ func (x *ListTopCommentsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListTopCommentsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
 type ListTopCommentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
@@ -656,6 +736,142 @@ func (x *ListRepliesResponse) GetTotal() int32 {
 	return 0
 }
 
+type SearchCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PostUid       string                 `protobuf:"bytes,2,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	AuthorUid     string                 `protobuf:"bytes,3,opt,name=author_uid,json=authorUid,proto3" json:"author_uid,omitempty"`
+	CreatedAfter  int64                  `protobuf:"varint,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`    // unix seconds, inclusive
+	CreatedBefore int64                  `protobuf:"varint,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"` // unix seconds, exclusive
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCommentsRequest) Reset() {
+	*x = SearchCommentsRequest{}
+	mi := &file_comment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCommentsRequest) ProtoMessage() {}
+
+func (x *SearchCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCommentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchCommentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchCommentsRequest) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+func (x *SearchCommentsRequest) GetAuthorUid() string {
+	if x != nil {
+		return x.AuthorUid
+	}
+	return ""
+}
+
+func (x *SearchCommentsRequest) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *SearchCommentsRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *SearchCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCommentsResponse) Reset() {
+	*x = SearchCommentsResponse{}
+	mi := &file_comment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCommentsResponse) ProtoMessage() {}
+
+func (x *SearchCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCommentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *SearchCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
@@ -665,7 +881,7 @@ type GetCommentRequest struct {
 
 func (x *GetCommentRequest) Reset() {
 	*x = GetCommentRequest{}
-	mi := &file_comment_proto_msgTypes[10]
+	mi := &file_comment_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -677,7 +893,7 @@ func (x *GetCommentRequest) String() string {
 func (*GetCommentRequest) ProtoMessage() {}
 
 func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_proto_msgTypes[10]
+	mi := &file_comment_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,7 +906,7 @@ func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
 func (*GetCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_proto_rawDescGZIP(), []int{10}
+	return file_comment_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetCommentRequest) GetUid() string {
@@ -709,7 +925,7 @@ type GetCommentResponse struct {
 
 func (x *GetCommentResponse) Reset() {
 	*x = GetCommentResponse{}
-	mi := &file_comment_proto_msgTypes[11]
+	mi := &file_comment_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -721,7 +937,7 @@ func (x *GetCommentResponse) String() string {
 func (*GetCommentResponse) ProtoMessage() {}
 
 func (x *GetCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_proto_msgTypes[11]
+	mi := &file_comment_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,7 +950,7 @@ func (x *GetCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentResponse.ProtoReflect.Descriptor instead.
 func (*GetCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_proto_rawDescGZIP(), []int{11}
+	return file_comment_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetCommentResponse) GetComment() *Comment {
@@ -744,28 +960,29 @@ func (x *GetCommentResponse) GetComment() *Comment {
 	return nil
 }
 
-type DeleteCommentRequest struct {
+type GetCommentThreadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next page of direct replies
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCommentRequest) Reset() {
-	*x = DeleteCommentRequest{}
-	mi := &file_comment_proto_msgTypes[12]
+func (x *GetCommentThreadRequest) Reset() {
+	*x = GetCommentThreadRequest{}
+	mi := &file_comment_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentRequest) String() string {
+func (x *GetCommentThreadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRequest) ProtoMessage() {}
+func (*GetCommentThreadRequest) ProtoMessage() {}
 
-func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_proto_msgTypes[12]
+func (x *GetCommentThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,41 +993,51 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetCommentThreadRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentThreadRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DeleteCommentRequest) GetUid() string {
+func (x *GetCommentThreadRequest) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-type LikeCommentRequest struct {
+func (x *GetCommentThreadRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetCommentThreadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Action        ToggleAction           `protobuf:"varint,2,opt,name=action,proto3,enum=common.ToggleAction" json:"action,omitempty"`
+	Ancestors     []*Comment             `protobuf:"bytes,1,rep,name=ancestors,proto3" json:"ancestors,omitempty"` // root first, ends at the direct parent
+	Comment       *Comment               `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Replies       []*Comment             `protobuf:"bytes,3,rep,name=replies,proto3" json:"replies,omitempty"`                                    // a page of direct replies, oldest first
+	RepliesTotal  int32                  `protobuf:"varint,4,opt,name=replies_total,json=repliesTotal,proto3" json:"replies_total,omitempty"`     // all direct replies
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // pass back as page_token for more replies
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeCommentRequest) Reset() {
-	*x = LikeCommentRequest{}
-	mi := &file_comment_proto_msgTypes[13]
+func (x *GetCommentThreadResponse) Reset() {
+	*x = GetCommentThreadResponse{}
+	mi := &file_comment_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeCommentRequest) String() string {
+func (x *GetCommentThreadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentRequest) ProtoMessage() {}
+func (*GetCommentThreadResponse) ProtoMessage() {}
 
-func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_proto_msgTypes[13]
+func (x *GetCommentThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,47 +1048,69 @@ func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
-func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetCommentThreadResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentThreadResponse) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LikeCommentRequest) GetUid() string {
+func (x *GetCommentThreadResponse) GetAncestors() []*Comment {
 	if x != nil {
-		return x.Uid
+		return x.Ancestors
 	}
-	return ""
+	return nil
 }
 
-func (x *LikeCommentRequest) GetAction() ToggleAction {
+func (x *GetCommentThreadResponse) GetComment() *Comment {
 	if x != nil {
-		return x.Action
+		return x.Comment
 	}
-	return ToggleAction_TOGGLE_ACTION_ADD
+	return nil
 }
 
-type LikeCommentResponse struct {
+func (x *GetCommentThreadResponse) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *GetCommentThreadResponse) GetRepliesTotal() int32 {
+	if x != nil {
+		return x.RepliesTotal
+	}
+	return 0
+}
+
+func (x *GetCommentThreadResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeCommentResponse) Reset() {
-	*x = LikeCommentResponse{}
-	mi := &file_comment_proto_msgTypes[14]
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	mi := &file_comment_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeCommentResponse) String() string {
+func (x *UpdateCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentResponse) ProtoMessage() {}
+func (*UpdateCommentRequest) ProtoMessage() {}
 
-func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_proto_msgTypes[14]
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,28 +1121,616 @@ func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentResponse.ProtoReflect.Descriptor instead.
-func (*LikeCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *LikeCommentResponse) GetCount() int32 {
+func (x *UpdateCommentRequest) GetUid() string {
 	if x != nil {
-		return x.Count
+		return x.Uid
 	}
-	return 0
+	return ""
 }
 
-var File_comment_proto protoreflect.FileDescriptor
+func (x *UpdateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
 
-const file_comment_proto_rawDesc = "" +
-	"\n" +
-	"\rcomment.proto\x12\acomment\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\fcommon.proto\"k\n" +
-	"\rCommentAuthor\x12\x15\n" +
-	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1f\n" +
-	"\bnickname\x18\x02 \x01(\tB\x03\xe0A\x02R\bnickname\x12\"\n" +
-	"\n" +
-	"avatar_url\x18\x03 \x01(\tB\x03\xe0A\x02R\tavatarUrl\"\xdd\x03\n" +
+type CommentRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ReplacedAt    int64                  `protobuf:"varint,2,opt,name=replaced_at,json=replacedAt,proto3" json:"replaced_at,omitempty"` // when an edit replaced this text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentRevision) Reset() {
+	*x = CommentRevision{}
+	mi := &file_comment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentRevision) ProtoMessage() {}
+
+func (x *CommentRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentRevision.ProtoReflect.Descriptor instead.
+func (*CommentRevision) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CommentRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommentRevision) GetReplacedAt() int64 {
+	if x != nil {
+		return x.ReplacedAt
+	}
+	return 0
+}
+
+type ListCommentRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentRevisionsRequest) Reset() {
+	*x = ListCommentRevisionsRequest{}
+	mi := &file_comment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentRevisionsRequest) ProtoMessage() {}
+
+func (x *ListCommentRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListCommentRevisionsRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type ListCommentRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*CommentRevision     `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentRevisionsResponse) Reset() {
+	*x = ListCommentRevisionsResponse{}
+	mi := &file_comment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentRevisionsResponse) ProtoMessage() {}
+
+func (x *ListCommentRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListCommentRevisionsResponse) GetRevisions() []*CommentRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_comment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteCommentRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type TrashedComment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	PostUid       string                 `protobuf:"bytes,2,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	RootUid       string                 `protobuf:"bytes,3,opt,name=root_uid,json=rootUid,proto3" json:"root_uid,omitempty"`
+	ParentUid     string                 `protobuf:"bytes,4,opt,name=parent_uid,json=parentUid,proto3" json:"parent_uid,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Images        []string               `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeletedAt     int64                  `protobuf:"varint,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // purged for good after this
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashedComment) Reset() {
+	*x = TrashedComment{}
+	mi := &file_comment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashedComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashedComment) ProtoMessage() {}
+
+func (x *TrashedComment) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashedComment.ProtoReflect.Descriptor instead.
+func (*TrashedComment) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TrashedComment) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *TrashedComment) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+func (x *TrashedComment) GetRootUid() string {
+	if x != nil {
+		return x.RootUid
+	}
+	return ""
+}
+
+func (x *TrashedComment) GetParentUid() string {
+	if x != nil {
+		return x.ParentUid
+	}
+	return ""
+}
+
+func (x *TrashedComment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TrashedComment) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *TrashedComment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TrashedComment) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *TrashedComment) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ListMyTrashedCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageToken     string                 `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTrashedCommentsRequest) Reset() {
+	*x = ListMyTrashedCommentsRequest{}
+	mi := &file_comment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTrashedCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTrashedCommentsRequest) ProtoMessage() {}
+
+func (x *ListMyTrashedCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTrashedCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTrashedCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListMyTrashedCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMyTrashedCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*TrashedComment      `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTrashedCommentsResponse) Reset() {
+	*x = ListMyTrashedCommentsResponse{}
+	mi := &file_comment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTrashedCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTrashedCommentsResponse) ProtoMessage() {}
+
+func (x *ListMyTrashedCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTrashedCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyTrashedCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListMyTrashedCommentsResponse) GetComments() []*TrashedComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListMyTrashedCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCommentRequest) Reset() {
+	*x = RestoreCommentRequest{}
+	mi := &file_comment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCommentRequest) ProtoMessage() {}
+
+func (x *RestoreCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCommentRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RestoreCommentRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type PinCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Action        ToggleAction           `protobuf:"varint,2,opt,name=action,proto3,enum=common.ToggleAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinCommentRequest) Reset() {
+	*x = PinCommentRequest{}
+	mi := &file_comment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinCommentRequest) ProtoMessage() {}
+
+func (x *PinCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinCommentRequest.ProtoReflect.Descriptor instead.
+func (*PinCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PinCommentRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *PinCommentRequest) GetAction() ToggleAction {
+	if x != nil {
+		return x.Action
+	}
+	return ToggleAction_TOGGLE_ACTION_ADD
+}
+
+type LikeCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Action        ToggleAction           `protobuf:"varint,2,opt,name=action,proto3,enum=common.ToggleAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeCommentRequest) Reset() {
+	*x = LikeCommentRequest{}
+	mi := &file_comment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCommentRequest) ProtoMessage() {}
+
+func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
+func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LikeCommentRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *LikeCommentRequest) GetAction() ToggleAction {
+	if x != nil {
+		return x.Action
+	}
+	return ToggleAction_TOGGLE_ACTION_ADD
+}
+
+type LikeCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeCommentResponse) Reset() {
+	*x = LikeCommentResponse{}
+	mi := &file_comment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCommentResponse) ProtoMessage() {}
+
+func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCommentResponse.ProtoReflect.Descriptor instead.
+func (*LikeCommentResponse) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LikeCommentResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_comment_proto protoreflect.FileDescriptor
+
+const file_comment_proto_rawDesc = "" +
+	"\n" +
+	"\rcomment.proto\x12\acomment\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\fcommon.proto\x1a\n" +
+	"post.proto\"k\n" +
+	"\rCommentAuthor\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1f\n" +
+	"\bnickname\x18\x02 \x01(\tB\x03\xe0A\x02R\bnickname\x12\"\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tB\x03\xe0A\x02R\tavatarUrl\"\xb7\x05\n" +
 	"\aComment\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x123\n" +
 	"\x06author\x18\x02 \x01(\v2\x16.comment.CommentAuthorB\x03\xe0A\x02R\x06author\x12\x1e\n" +
@@ -913,26 +1750,36 @@ const file_comment_proto_rawDesc = "" +
 	"updated_at\x18\v \x01(\x03B\x03\xe0A\x02R\tupdatedAt\x12\"\n" +
 	"\n" +
 	"like_count\x18\f \x01(\x05B\x03\xe0A\x02R\tlikeCount\x12\x19\n" +
-	"\x05liked\x18\r \x01(\bB\x03\xe0A\x02R\x05liked\"p\n" +
+	"\x05liked\x18\r \x01(\bB\x03\xe0A\x02R\x05liked\x12 \n" +
+	"\tip_region\x18\x0e \x01(\tB\x03\xe0A\x02R\bipRegion\x12\x0e\n" +
+	"\x02ip\x18\x0f \x01(\tR\x02ip\x123\n" +
+	"\treactions\x18\x10 \x03(\v2\x10.common.ReactionB\x03\xe0A\x02R\treactions\x12\x1b\n" +
+	"\x06edited\x18\x11 \x01(\bB\x03\xe0A\x02R\x06edited\x12\x1b\n" +
+	"\x06pinned\x18\x12 \x01(\bB\x03\xe0A\x02R\x06pinned\x127\n" +
+	"\vattachments\x18\x13 \x03(\v2\x10.post.AttachmentB\x03\xe0A\x02R\vattachments\"\x92\x01\n" +
 	"\x17CreateTopCommentRequest\x12\x1e\n" +
 	"\bpost_uid\x18\x01 \x01(\tB\x03\xe0A\x02R\apostUid\x12\x1d\n" +
 	"\acontent\x18\x02 \x01(\tB\x03\xe0A\x02R\acontent\x12\x16\n" +
-	"\x06images\x18\x03 \x03(\tR\x06images\"[\n" +
+	"\x06images\x18\x03 \x03(\tR\x06images\x12 \n" +
+	"\vattachments\x18\x04 \x03(\tR\vattachments\"[\n" +
 	"\x18CreateTopCommentResponse\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12(\n" +
-	"\rcomment_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\fcommentCount\"W\n" +
+	"\rcomment_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\fcommentCount\"\x91\x01\n" +
 	"\x12CreateReplyRequest\x12\"\n" +
 	"\n" +
 	"parent_uid\x18\x01 \x01(\tB\x03\xe0A\x02R\tparentUid\x12\x1d\n" +
-	"\acontent\x18\x02 \x01(\tB\x03\xe0A\x02R\acontent\"R\n" +
+	"\acontent\x18\x02 \x01(\tB\x03\xe0A\x02R\acontent\x12\x16\n" +
+	"\x06images\x18\x03 \x03(\tR\x06images\x12 \n" +
+	"\vattachments\x18\x04 \x03(\tR\vattachments\"R\n" +
 	"\x13CreateReplyResponse\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12$\n" +
 	"\vreply_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\n" +
-	"replyCount\"W\n" +
+	"replyCount\"k\n" +
 	"\x16ListTopCommentsRequest\x12\x1e\n" +
 	"\bpost_uid\x18\x01 \x01(\tB\x03\xe0A\x02R\apostUid\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"y\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\"y\n" +
 	"\x17ListTopCommentsResponse\x121\n" +
 	"\bcomments\x18\x01 \x03(\v2\x10.comment.CommentB\x03\xe0A\x02R\bcomments\x12+\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"?\n" +
@@ -942,26 +1789,92 @@ const file_comment_proto_rawDesc = "" +
 	"\x13ListRepliesResponse\x121\n" +
 	"\bcomments\x18\x01 \x03(\v2\x10.comment.CommentB\x03\xe0A\x02R\bcomments\x12\x17\n" +
 	"\x04page\x18\x02 \x01(\x05B\x03\xe0A\x02R\x04page\x12\x19\n" +
-	"\x05total\x18\x03 \x01(\x05B\x03\xe0A\x02R\x05total\"*\n" +
+	"\x05total\x18\x03 \x01(\x05B\x03\xe0A\x02R\x05total\"\xd2\x01\n" +
+	"\x15SearchCommentsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x19\n" +
+	"\bpost_uid\x18\x02 \x01(\tR\apostUid\x12\x1d\n" +
+	"\n" +
+	"author_uid\x18\x03 \x01(\tR\tauthorUid\x12#\n" +
+	"\rcreated_after\x18\x04 \x01(\x03R\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x05 \x01(\x03R\rcreatedBefore\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"x\n" +
+	"\x16SearchCommentsResponse\x121\n" +
+	"\bcomments\x18\x01 \x03(\v2\x10.comment.CommentB\x03\xe0A\x02R\bcomments\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"*\n" +
 	"\x11GetCommentRequest\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"E\n" +
 	"\x12GetCommentResponse\x12/\n" +
-	"\acomment\x18\x01 \x01(\v2\x10.comment.CommentB\x03\xe0A\x02R\acomment\"-\n" +
+	"\acomment\x18\x01 \x01(\v2\x10.comment.CommentB\x03\xe0A\x02R\acomment\"O\n" +
+	"\x17GetCommentThreadRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x88\x02\n" +
+	"\x18GetCommentThreadResponse\x123\n" +
+	"\tancestors\x18\x01 \x03(\v2\x10.comment.CommentB\x03\xe0A\x02R\tancestors\x12/\n" +
+	"\acomment\x18\x02 \x01(\v2\x10.comment.CommentB\x03\xe0A\x02R\acomment\x12/\n" +
+	"\areplies\x18\x03 \x03(\v2\x10.comment.CommentB\x03\xe0A\x02R\areplies\x12(\n" +
+	"\rreplies_total\x18\x04 \x01(\x05B\x03\xe0A\x02R\frepliesTotal\x12+\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"L\n" +
+	"\x14UpdateCommentRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1d\n" +
+	"\acontent\x18\x02 \x01(\tB\x03\xe0A\x02R\acontent\"V\n" +
+	"\x0fCommentRevision\x12\x1d\n" +
+	"\acontent\x18\x01 \x01(\tB\x03\xe0A\x02R\acontent\x12$\n" +
+	"\vreplaced_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\n" +
+	"replacedAt\"4\n" +
+	"\x1bListCommentRevisionsRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"[\n" +
+	"\x1cListCommentRevisionsResponse\x12;\n" +
+	"\trevisions\x18\x01 \x03(\v2\x18.comment.CommentRevisionB\x03\xe0A\x02R\trevisions\"-\n" +
 	"\x14DeleteCommentRequest\x12\x15\n" +
-	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"Y\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"\xae\x02\n" +
+	"\x0eTrashedComment\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1e\n" +
+	"\bpost_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\apostUid\x12\x1e\n" +
+	"\broot_uid\x18\x03 \x01(\tB\x03\xe0A\x02R\arootUid\x12\x1d\n" +
+	"\n" +
+	"parent_uid\x18\x04 \x01(\tR\tparentUid\x12\x1d\n" +
+	"\acontent\x18\x05 \x01(\tB\x03\xe0A\x02R\acontent\x12\x1b\n" +
+	"\x06images\x18\x06 \x03(\tB\x03\xe0A\x02R\x06images\x12\"\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x12\"\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\x03B\x03\xe0A\x02R\tdeletedAt\x12\"\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\x03B\x03\xe0A\x02R\texpiresAt\"=\n" +
+	"\x1cListMyTrashedCommentsRequest\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x01 \x01(\tR\tpageToken\"\x86\x01\n" +
+	"\x1dListMyTrashedCommentsResponse\x128\n" +
+	"\bcomments\x18\x01 \x03(\v2\x17.comment.TrashedCommentB\x03\xe0A\x02R\bcomments\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\".\n" +
+	"\x15RestoreCommentRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"X\n" +
+	"\x11PinCommentRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12,\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"Y\n" +
 	"\x12LikeCommentRequest\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12,\n" +
 	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"0\n" +
 	"\x13LikeCommentResponse\x12\x19\n" +
-	"\x05count\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05count2\xc8\x06\n" +
+	"\x05count\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05count2\xa1\r\n" +
 	"\x0eCommentService\x12\x85\x01\n" +
 	"\x10CreateTopComment\x12 .comment.CreateTopCommentRequest\x1a!.comment.CreateTopCommentResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/posts/{post_uid}/comments\x12z\n" +
 	"\vCreateReply\x12\x1b.comment.CreateReplyRequest\x1a\x1c.comment.CreateReplyResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/comments/{parent_uid}/replies\x12\x7f\n" +
 	"\x0fListTopComments\x12\x1f.comment.ListTopCommentsRequest\x1a .comment.ListTopCommentsResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/posts/{post_uid}/comments\x12p\n" +
-	"\vListReplies\x12\x1b.comment.ListRepliesRequest\x1a\x1c.comment.ListRepliesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/comments/{uid}/replies\x12e\n" +
+	"\vListReplies\x12\x1b.comment.ListRepliesRequest\x1a\x1c.comment.ListRepliesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/comments/{uid}/replies\x12r\n" +
+	"\x0eSearchComments\x12\x1e.comment.SearchCommentsRequest\x1a\x1f.comment.SearchCommentsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/search/comments\x12e\n" +
+	"\n" +
+	"GetComment\x12\x1a.comment.GetCommentRequest\x1a\x1b.comment.GetCommentResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/comments/{uid}\x12~\n" +
+	"\x10GetCommentThread\x12 .comment.GetCommentThreadRequest\x1a!.comment.GetCommentThreadResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/comments/{uid}/thread\x12i\n" +
+	"\rUpdateComment\x12\x1d.comment.UpdateCommentRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/api/v1/comments/{uid}\x12\x8d\x01\n" +
+	"\x14ListCommentRevisions\x12$.comment.ListCommentRevisionsRequest\x1a%.comment.ListCommentRevisionsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/comments/{uid}/revisions\x12f\n" +
+	"\rDeleteComment\x12\x1d.comment.DeleteCommentRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/comments/{uid}\x12\x89\x01\n" +
+	"\x15ListMyTrashedComments\x12%.comment.ListMyTrashedCommentsRequest\x1a&.comment.ListMyTrashedCommentsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/me/trash/comments\x12s\n" +
+	"\x0eRestoreComment\x12\x1e.comment.RestoreCommentRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/comments/{uid}/restore\x12g\n" +
 	"\n" +
-	"GetComment\x12\x1a.comment.GetCommentRequest\x1a\x1b.comment.GetCommentResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/comments/{uid}\x12f\n" +
-	"\rDeleteComment\x12\x1d.comment.DeleteCommentRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/comments/{uid}\x12p\n" +
+	"PinComment\x12\x1a.comment.PinCommentRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/comments/{uid}/pin\x12p\n" +
 	"\vLikeComment\x12\x1b.comment.LikeCommentRequest\x1a\x1c.comment.LikeCommentResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/comments/{uid}/likeB\x0fZ\raeibi/api;apib\x06proto3"
 
 var (
@@ -976,52 +1889,90 @@ func file_comment_proto_rawDescGZIP() []byte {
 	return file_comment_proto_rawDescData
 }
 
-var file_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_comment_proto_goTypes = []any{
-	(*CommentAuthor)(nil),            // 0: comment.CommentAuthor
-	(*Comment)(nil),                  // 1: comment.Comment
-	(*CreateTopCommentRequest)(nil),  // 2: comment.CreateTopCommentRequest
-	(*CreateTopCommentResponse)(nil), // 3: comment.CreateTopCommentResponse
-	(*CreateReplyRequest)(nil),       // 4: comment.CreateReplyRequest
-	(*CreateReplyResponse)(nil),      // 5: comment.CreateReplyResponse
-	(*ListTopCommentsRequest)(nil),   // 6: comment.ListTopCommentsRequest
-	(*ListTopCommentsResponse)(nil),  // 7: comment.ListTopCommentsResponse
-	(*ListRepliesRequest)(nil),       // 8: comment.ListRepliesRequest
-	(*ListRepliesResponse)(nil),      // 9: comment.ListRepliesResponse
-	(*GetCommentRequest)(nil),        // 10: comment.GetCommentRequest
-	(*GetCommentResponse)(nil),       // 11: comment.GetCommentResponse
-	(*DeleteCommentRequest)(nil),     // 12: comment.DeleteCommentRequest
-	(*LikeCommentRequest)(nil),       // 13: comment.LikeCommentRequest
-	(*LikeCommentResponse)(nil),      // 14: comment.LikeCommentResponse
-	(ToggleAction)(0),                // 15: common.ToggleAction
-	(*emptypb.Empty)(nil),            // 16: google.protobuf.Empty
+	(*CommentAuthor)(nil),                 // 0: comment.CommentAuthor
+	(*Comment)(nil),                       // 1: comment.Comment
+	(*CreateTopCommentRequest)(nil),       // 2: comment.CreateTopCommentRequest
+	(*CreateTopCommentResponse)(nil),      // 3: comment.CreateTopCommentResponse
+	(*CreateReplyRequest)(nil),            // 4: comment.CreateReplyRequest
+	(*CreateReplyResponse)(nil),           // 5: comment.CreateReplyResponse
+	(*ListTopCommentsRequest)(nil),        // 6: comment.ListTopCommentsRequest
+	(*ListTopCommentsResponse)(nil),       // 7: comment.ListTopCommentsResponse
+	(*ListRepliesRequest)(nil),            // 8: comment.ListRepliesRequest
+	(*ListRepliesResponse)(nil),           // 9: comment.ListRepliesResponse
+	(*SearchCommentsRequest)(nil),         // 10: comment.SearchCommentsRequest
+	(*SearchCommentsResponse)(nil),        // 11: comment.SearchCommentsResponse
+	(*GetCommentRequest)(nil),             // 12: comment.GetCommentRequest
+	(*GetCommentResponse)(nil),            // 13: comment.GetCommentResponse
+	(*GetCommentThreadRequest)(nil),       // 14: comment.GetCommentThreadRequest
+	(*GetCommentThreadResponse)(nil),      // 15: comment.GetCommentThreadResponse
+	(*UpdateCommentRequest)(nil),          // 16: comment.UpdateCommentRequest
+	(*CommentRevision)(nil),               // 17: comment.CommentRevision
+	(*ListCommentRevisionsRequest)(nil),   // 18: comment.ListCommentRevisionsRequest
+	(*ListCommentRevisionsResponse)(nil),  // 19: comment.ListCommentRevisionsResponse
+	(*DeleteCommentRequest)(nil),          // 20: comment.DeleteCommentRequest
+	(*TrashedComment)(nil),                // 21: comment.TrashedComment
+	(*ListMyTrashedCommentsRequest)(nil),  // 22: comment.ListMyTrashedCommentsRequest
+	(*ListMyTrashedCommentsResponse)(nil), // 23: comment.ListMyTrashedCommentsResponse
+	(*RestoreCommentRequest)(nil),         // 24: comment.RestoreCommentRequest
+	(*PinCommentRequest)(nil),             // 25: comment.PinCommentRequest
+	(*LikeCommentRequest)(nil),            // 26: comment.LikeCommentRequest
+	(*LikeCommentResponse)(nil),           // 27: comment.LikeCommentResponse
+	(*Reaction)(nil),                      // 28: common.Reaction
+	(*Attachment)(nil),                    // 29: post.Attachment
+	(ToggleAction)(0),                     // 30: common.ToggleAction
+	(*emptypb.Empty)(nil),                 // 31: google.protobuf.Empty
 }
 var file_comment_proto_depIdxs = []int32{
 	0,  // 0: comment.Comment.author:type_name -> comment.CommentAuthor
 	0,  // 1: comment.Comment.reply_to_author:type_name -> comment.CommentAuthor
-	1,  // 2: comment.ListTopCommentsResponse.comments:type_name -> comment.Comment
-	1,  // 3: comment.ListRepliesResponse.comments:type_name -> comment.Comment
-	1,  // 4: comment.GetCommentResponse.comment:type_name -> comment.Comment
-	15, // 5: comment.LikeCommentRequest.action:type_name -> common.ToggleAction
-	2,  // 6: comment.CommentService.CreateTopComment:input_type -> comment.CreateTopCommentRequest
-	4,  // 7: comment.CommentService.CreateReply:input_type -> comment.CreateReplyRequest
-	6,  // 8: comment.CommentService.ListTopComments:input_type -> comment.ListTopCommentsRequest
-	8,  // 9: comment.CommentService.ListReplies:input_type -> comment.ListRepliesRequest
-	10, // 10: comment.CommentService.GetComment:input_type -> comment.GetCommentRequest
-	12, // 11: comment.CommentService.DeleteComment:input_type -> comment.DeleteCommentRequest
-	13, // 12: comment.CommentService.LikeComment:input_type -> comment.LikeCommentRequest
-	3,  // 13: comment.CommentService.CreateTopComment:output_type -> comment.CreateTopCommentResponse
-	5,  // 14: comment.CommentService.CreateReply:output_type -> comment.CreateReplyResponse
-	7,  // 15: comment.CommentService.ListTopComments:output_type -> comment.ListTopCommentsResponse
-	9,  // 16: comment.CommentService.ListReplies:output_type -> comment.ListRepliesResponse
-	11, // 17: comment.CommentService.GetComment:output_type -> comment.GetCommentResponse
-	16, // 18: comment.CommentService.DeleteComment:output_type -> google.protobuf.Empty
-	14, // 19: comment.CommentService.LikeComment:output_type -> comment.LikeCommentResponse
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	28, // 2: comment.Comment.reactions:type_name -> common.Reaction
+	29, // 3: comment.Comment.attachments:type_name -> post.Attachment
+	1,  // 4: comment.ListTopCommentsResponse.comments:type_name -> comment.Comment
+	1,  // 5: comment.ListRepliesResponse.comments:type_name -> comment.Comment
+	1,  // 6: comment.SearchCommentsResponse.comments:type_name -> comment.Comment
+	1,  // 7: comment.GetCommentResponse.comment:type_name -> comment.Comment
+	1,  // 8: comment.GetCommentThreadResponse.ancestors:type_name -> comment.Comment
+	1,  // 9: comment.GetCommentThreadResponse.comment:type_name -> comment.Comment
+	1,  // 10: comment.GetCommentThreadResponse.replies:type_name -> comment.Comment
+	17, // 11: comment.ListCommentRevisionsResponse.revisions:type_name -> comment.CommentRevision
+	21, // 12: comment.ListMyTrashedCommentsResponse.comments:type_name -> comment.TrashedComment
+	30, // 13: comment.PinCommentRequest.action:type_name -> common.ToggleAction
+	30, // 14: comment.LikeCommentRequest.action:type_name -> common.ToggleAction
+	2,  // 15: comment.CommentService.CreateTopComment:input_type -> comment.CreateTopCommentRequest
+	4,  // 16: comment.CommentService.CreateReply:input_type -> comment.CreateReplyRequest
+	6,  // 17: comment.CommentService.ListTopComments:input_type -> comment.ListTopCommentsRequest
+	8,  // 18: comment.CommentService.ListReplies:input_type -> comment.ListRepliesRequest
+	10, // 19: comment.CommentService.SearchComments:input_type -> comment.SearchCommentsRequest
+	12, // 20: comment.CommentService.GetComment:input_type -> comment.GetCommentRequest
+	14, // 21: comment.CommentService.GetCommentThread:input_type -> comment.GetCommentThreadRequest
+	16, // 22: comment.CommentService.UpdateComment:input_type -> comment.UpdateCommentRequest
+	18, // 23: comment.CommentService.ListCommentRevisions:input_type -> comment.ListCommentRevisionsRequest
+	20, // 24: comment.CommentService.DeleteComment:input_type -> comment.DeleteCommentRequest
+	22, // 25: comment.CommentService.ListMyTrashedComments:input_type -> comment.ListMyTrashedCommentsRequest
+	24, // 26: comment.CommentService.RestoreComment:input_type -> comment.RestoreCommentRequest
+	25, // 27: comment.CommentService.PinComment:input_type -> comment.PinCommentRequest
+	26, // 28: comment.CommentService.LikeComment:input_type -> comment.LikeCommentRequest
+	3,  // 29: comment.CommentService.CreateTopComment:output_type -> comment.CreateTopCommentResponse
+	5,  // 30: comment.CommentService.CreateReply:output_type -> comment.CreateReplyResponse
+	7,  // 31: comment.CommentService.ListTopComments:output_type -> comment.ListTopCommentsResponse
+	9,  // 32: comment.CommentService.ListReplies:output_type -> comment.ListRepliesResponse
+	11, // 33: comment.CommentService.SearchComments:output_type -> comment.SearchCommentsResponse
+	13, // 34: comment.CommentService.GetComment:output_type -> comment.GetCommentResponse
+	15, // 35: comment.CommentService.GetCommentThread:output_type -> comment.GetCommentThreadResponse
+	31, // 36: comment.CommentService.UpdateComment:output_type -> google.protobuf.Empty
+	19, // 37: comment.CommentService.ListCommentRevisions:output_type -> comment.ListCommentRevisionsResponse
+	31, // 38: comment.CommentService.DeleteComment:output_type -> google.protobuf.Empty
+	23, // 39: comment.CommentService.ListMyTrashedComments:output_type -> comment.ListMyTrashedCommentsResponse
+	31, // 40: comment.CommentService.RestoreComment:output_type -> google.protobuf.Empty
+	31, // 41: comment.CommentService.PinComment:output_type -> google.protobuf.Empty
+	27, // 42: comment.CommentService.LikeComment:output_type -> comment.LikeCommentResponse
+	29, // [29:43] is the sub-list for method output_type
+	15, // [15:29] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_comment_proto_init() }
@@ -1030,13 +1981,14 @@ func file_comment_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_post_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_comment_proto_rawDesc), len(file_comment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,13 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CommentService_CreateTopComment_FullMethodName = "/comment.CommentService/CreateTopComment"
-	CommentService_CreateReply_FullMethodName      = "/comment.CommentService/CreateReply"
-	CommentService_ListTopComments_FullMethodName  = "/comment.CommentService/ListTopComments"
-	CommentService_ListReplies_FullMethodName      = "/comment.CommentService/ListReplies"
-	CommentService_GetComment_FullMethodName       = "/comment.CommentService/GetComment"
-	CommentService_DeleteComment_FullMethodName    = "/comment.CommentService/DeleteComment"
-	CommentService_LikeComment_FullMethodName      = "/comment.CommentService/LikeComment"
+	CommentService_CreateTopComment_FullMethodName      = "/comment.CommentService/CreateTopComment"
+	CommentService_CreateReply_FullMethodName           = "/comment.CommentService/CreateReply"
+	CommentService_ListTopComments_FullMethodName       = "/comment.CommentService/ListTopComments"
+	CommentService_ListReplies_FullMethodName           = "/comment.CommentService/ListReplies"
+	CommentService_SearchComments_FullMethodName        = "/comment.CommentService/SearchComments"
+	CommentService_GetComment_FullMethodName            = "/comment.CommentService/GetComment"
+	CommentService_GetCommentThread_FullMethodName      = "/comment.CommentService/GetCommentThread"
+	CommentService_UpdateComment_FullMethodName         = "/comment.CommentService/UpdateComment"
+	CommentService_ListCommentRevisions_FullMethodName  = "/comment.CommentService/ListCommentRevisions"
+	CommentService_DeleteComment_FullMethodName         = "/comment.CommentService/DeleteComment"
+	CommentService_ListMyTrashedComments_FullMethodName = "/comment.CommentService/ListMyTrashedComments"
+	CommentService_RestoreComment_FullMethodName        = "/comment.CommentService/RestoreComment"
+	CommentService_PinComment_FullMethodName            = "/comment.CommentService/PinComment"
+	CommentService_LikeComment_FullMethodName           = "/comment.CommentService/LikeComment"
 )
 
 // CommentServiceClient is the client API for CommentService service.
@@ -43,10 +50,24 @@ type CommentServiceClient interface {
 	ListTopComments(ctx context.Context, in *ListTopCommentsRequest, opts ...grpc.CallOption) (*ListTopCommentsResponse, error)
 	// GET /api/v1/comments/{uid}/replies 回复列表
 	ListReplies(ctx context.Context, in *ListRepliesRequest, opts ...grpc.CallOption) (*ListRepliesResponse, error)
+	// GET /api/v1/search/comments 评论搜索
+	SearchComments(ctx context.Context, in *SearchCommentsRequest, opts ...grpc.CallOption) (*SearchCommentsResponse, error)
 	// GET /api/v1/comments/{uid} 评论详情
 	GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*GetCommentResponse, error)
-	// DELETE /api/v1/comments/{uid} 软删评论
+	// GET /api/v1/comments/{uid}/thread 评论上下文（祖先链、评论本身及其直接回复）
+	GetCommentThread(ctx context.Context, in *GetCommentThreadRequest, opts ...grpc.CallOption) (*GetCommentThreadResponse, error)
+	// PATCH /api/v1/comments/{uid} 编辑评论
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GET /api/v1/comments/{uid}/revisions 评论编辑历史
+	ListCommentRevisions(ctx context.Context, in *ListCommentRevisionsRequest, opts ...grpc.CallOption) (*ListCommentRevisionsResponse, error)
+	// DELETE /api/v1/comments/{uid} 软删评论（评论作者或帖子作者）
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GET /api/v1/me/trash/comments 回收站中的评论
+	ListMyTrashedComments(ctx context.Context, in *ListMyTrashedCommentsRequest, opts ...grpc.CallOption) (*ListMyTrashedCommentsResponse, error)
+	// POST /api/v1/comments/{uid}/restore 从回收站恢复评论
+	RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// POST /api/v1/comments/{uid}/pin 帖子作者置顶或取消置顶一级评论
+	PinComment(ctx context.Context, in *PinCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// POST /api/v1/comments/{uid}/like 点赞或取消赞
 	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error)
 }
@@ -99,6 +120,16 @@ func (c *commentServiceClient) ListReplies(ctx context.Context, in *ListRepliesR
 	return out, nil
 }
 
+func (c *commentServiceClient) SearchComments(ctx context.Context, in *SearchCommentsRequest, opts ...grpc.CallOption) (*SearchCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_SearchComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentServiceClient) GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*GetCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCommentResponse)
@@ -109,6 +140,36 @@ func (c *commentServiceClient) GetComment(ctx context.Context, in *GetCommentReq
 	return out, nil
 }
 
+func (c *commentServiceClient) GetCommentThread(ctx context.Context, in *GetCommentThreadRequest, opts ...grpc.CallOption) (*GetCommentThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentThreadResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetCommentThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommentService_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ListCommentRevisions(ctx context.Context, in *ListCommentRevisionsRequest, opts ...grpc.CallOption) (*ListCommentRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentRevisionsResponse)
+	err := c.cc.Invoke(ctx, CommentService_ListCommentRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -119,6 +180,36 @@ func (c *commentServiceClient) DeleteComment(ctx context.Context, in *DeleteComm
 	return out, nil
 }
 
+func (c *commentServiceClient) ListMyTrashedComments(ctx context.Context, in *ListMyTrashedCommentsRequest, opts ...grpc.CallOption) (*ListMyTrashedCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyTrashedCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_ListMyTrashedComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommentService_RestoreComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) PinComment(ctx context.Context, in *PinCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommentService_PinComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentServiceClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LikeCommentResponse)
@@ -143,10 +234,24 @@ type CommentServiceServer interface {
 	ListTopComments(context.Context, *ListTopCommentsRequest) (*ListTopCommentsResponse, error)
 	// GET /api/v1/comments/{uid}/replies 回复列表
 	ListReplies(context.Context, *ListRepliesRequest) (*ListRepliesResponse, error)
+	// GET /api/v1/search/comments 评论搜索
+	SearchComments(context.Context, *SearchCommentsRequest) (*SearchCommentsResponse, error)
 	// GET /api/v1/comments/{uid} 评论详情
 	GetComment(context.Context, *GetCommentRequest) (*GetCommentResponse, error)
-	// DELETE /api/v1/comments/{uid} 软删评论
+	// GET /api/v1/comments/{uid}/thread 评论上下文（祖先链、评论本身及其直接回复）
+	GetCommentThread(context.Context, *GetCommentThreadRequest) (*GetCommentThreadResponse, error)
+	// PATCH /api/v1/comments/{uid} 编辑评论
+	UpdateComment(context.Context, *UpdateCommentRequest) (*emptypb.Empty, error)
+	// GET /api/v1/comments/{uid}/revisions 评论编辑历史
+	ListCommentRevisions(context.Context, *ListCommentRevisionsRequest) (*ListCommentRevisionsResponse, error)
+	// DELETE /api/v1/comments/{uid} 软删评论（评论作者或帖子作者）
 	DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error)
+	// GET /api/v1/me/trash/comments 回收站中的评论
+	ListMyTrashedComments(context.Context, *ListMyTrashedCommentsRequest) (*ListMyTrashedCommentsResponse, error)
+	// POST /api/v1/comments/{uid}/restore 从回收站恢复评论
+	RestoreComment(context.Context, *RestoreCommentRequest) (*emptypb.Empty, error)
+	// POST /api/v1/comments/{uid}/pin 帖子作者置顶或取消置顶一级评论
+	PinComment(context.Context, *PinCommentRequest) (*emptypb.Empty, error)
 	// POST /api/v1/comments/{uid}/like 点赞或取消赞
 	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error)
 	mustEmbedUnimplementedCommentServiceServer()
@@ -171,12 +276,33 @@ func (UnimplementedCommentServiceServer) ListTopComments(context.Context, *ListT
 func (UnimplementedCommentServiceServer) ListReplies(context.Context, *ListRepliesRequest) (*ListRepliesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListReplies not implemented")
 }
+func (UnimplementedCommentServiceServer) SearchComments(context.Context, *SearchCommentsRequest) (*SearchCommentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchComments not implemented")
+}
 func (UnimplementedCommentServiceServer) GetComment(context.Context, *GetCommentRequest) (*GetCommentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetComment not implemented")
 }
+func (UnimplementedCommentServiceServer) GetCommentThread(context.Context, *GetCommentThreadRequest) (*GetCommentThreadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCommentThread not implemented")
+}
+func (UnimplementedCommentServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedCommentServiceServer) ListCommentRevisions(context.Context, *ListCommentRevisionsRequest) (*ListCommentRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCommentRevisions not implemented")
+}
 func (UnimplementedCommentServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteComment not implemented")
 }
+func (UnimplementedCommentServiceServer) ListMyTrashedComments(context.Context, *ListMyTrashedCommentsRequest) (*ListMyTrashedCommentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyTrashedComments not implemented")
+}
+func (UnimplementedCommentServiceServer) RestoreComment(context.Context, *RestoreCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreComment not implemented")
+}
+func (UnimplementedCommentServiceServer) PinComment(context.Context, *PinCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PinComment not implemented")
+}
 func (UnimplementedCommentServiceServer) LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LikeComment not implemented")
 }
@@ -273,6 +399,24 @@ func _CommentService_ListReplies_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_SearchComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).SearchComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_SearchComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).SearchComments(ctx, req.(*SearchCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommentService_GetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommentRequest)
 	if err := dec(in); err != nil {
@@ -291,6 +435,60 @@ func _CommentService_GetComment_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_GetCommentThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetCommentThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetCommentThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetCommentThread(ctx, req.(*GetCommentThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ListCommentRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ListCommentRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ListCommentRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ListCommentRevisions(ctx, req.(*ListCommentRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommentService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCommentRequest)
 	if err := dec(in); err != nil {
@@ -309,6 +507,60 @@ func _CommentService_DeleteComment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_ListMyTrashedComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyTrashedCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ListMyTrashedComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ListMyTrashedComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ListMyTrashedComments(ctx, req.(*ListMyTrashedCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_RestoreComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).RestoreComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_RestoreComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).RestoreComment(ctx, req.(*RestoreCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_PinComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).PinComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_PinComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).PinComment(ctx, req.(*PinCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommentService_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LikeCommentRequest)
 	if err := dec(in); err != nil {
@@ -350,14 +602,42 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReplies",
 			Handler:    _CommentService_ListReplies_Handler,
 		},
+		{
+			MethodName: "SearchComments",
+			Handler:    _CommentService_SearchComments_Handler,
+		},
 		{
 			MethodName: "GetComment",
 			Handler:    _CommentService_GetComment_Handler,
 		},
+		{
+			MethodName: "GetCommentThread",
+			Handler:    _CommentService_GetCommentThread_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _CommentService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "ListCommentRevisions",
+			Handler:    _CommentService_ListCommentRevisions_Handler,
+		},
 		{
 			MethodName: "DeleteComment",
 			Handler:    _CommentService_DeleteComment_Handler,
 		},
+		{
+			MethodName: "ListMyTrashedComments",
+			Handler:    _CommentService_ListMyTrashedComments_Handler,
+		},
+		{
+			MethodName: "RestoreComment",
+			Handler:    _CommentService_RestoreComment_Handler,
+		},
+		{
+			MethodName: "PinComment",
+			Handler:    _CommentService_PinComment_Handler,
+		},
 		{
 			MethodName: "LikeComment",
 			Handler:    _CommentService_LikeComment_Handler,
@@ -83,6 +83,7 @@ type User struct {
 	FollowingCount int32                  `protobuf:"varint,8,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
 	IsFollowing    bool                   `protobuf:"varint,9,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
 	Description    string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	FollowsYou     bool                   `protobuf:"varint,11,opt,name=follows_you,json=followsYou,proto3" json:"follows_you,omitempty"` // the user follows the viewer
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -187,11 +188,79 @@ func (x *User) GetDescription() string {
 	return ""
 }
 
+func (x *User) GetFollowsYou() bool {
+	if x != nil {
+		return x.FollowsYou
+	}
+	return false
+}
+
+// Reaction 单个表情的回应数，reacted 表示当前用户是否回应过
+type Reaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emoji         string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Reacted       bool                   `protobuf:"varint,3,opt,name=reacted,proto3" json:"reacted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	mi := &file_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Reaction) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *Reaction) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Reaction) GetReacted() bool {
+	if x != nil {
+		return x.Reacted
+	}
+	return false
+}
+
 var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
 	"\n" +
-	"\fcommon.proto\x12\x06common\x1a\x1fgoogle/api/field_behavior.proto\"\xce\x02\n" +
+	"\fcommon.proto\x12\x06common\x1a\x1fgoogle/api/field_behavior.proto\"\xef\x02\n" +
 	"\x04User\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x17\n" +
@@ -204,7 +273,13 @@ const file_common_proto_rawDesc = "" +
 	"\x0ffollowing_count\x18\b \x01(\x05B\x03\xe0A\x02R\x0efollowingCount\x12!\n" +
 	"\fis_following\x18\t \x01(\bR\visFollowing\x12 \n" +
 	"\vdescription\x18\n" +
-	" \x01(\tR\vdescription*?\n" +
+	" \x01(\tR\vdescription\x12\x1f\n" +
+	"\vfollows_you\x18\v \x01(\bR\n" +
+	"followsYou\"_\n" +
+	"\bReaction\x12\x19\n" +
+	"\x05emoji\x18\x01 \x01(\tB\x03\xe0A\x02R\x05emoji\x12\x19\n" +
+	"\x05count\x18\x02 \x01(\x05B\x03\xe0A\x02R\x05count\x12\x1d\n" +
+	"\areacted\x18\x03 \x01(\bB\x03\xe0A\x02R\areacted*?\n" +
 	"\fToggleAction\x12\x15\n" +
 	"\x11TOGGLE_ACTION_ADD\x10\x00\x12\x18\n" +
 	"\x14TOGGLE_ACTION_REMOVE\x10\x01B\x0fZ\raeibi/api;apib\x06proto3"
@@ -222,10 +297,11 @@ func file_common_proto_rawDescGZIP() []byte {
 }
 
 var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_common_proto_goTypes = []any{
 	(ToggleAction)(0), // 0: common.ToggleAction
 	(*User)(nil),      // 1: common.User
+	(*Reaction)(nil),  // 2: common.Reaction
 }
 var file_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -246,7 +322,7 @@ func file_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
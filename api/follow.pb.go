@@ -8,8 +8,10 @@ package api
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +24,108 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Import / export
+type FollowExportList int32
+
+const (
+	FollowExportList_FOLLOW_EXPORT_LIST_UNSPECIFIED FollowExportList = 0 // following
+	FollowExportList_FOLLOW_EXPORT_LIST_FOLLOWING   FollowExportList = 1
+	FollowExportList_FOLLOW_EXPORT_LIST_FOLLOWERS   FollowExportList = 2
+)
+
+// Enum value maps for FollowExportList.
+var (
+	FollowExportList_name = map[int32]string{
+		0: "FOLLOW_EXPORT_LIST_UNSPECIFIED",
+		1: "FOLLOW_EXPORT_LIST_FOLLOWING",
+		2: "FOLLOW_EXPORT_LIST_FOLLOWERS",
+	}
+	FollowExportList_value = map[string]int32{
+		"FOLLOW_EXPORT_LIST_UNSPECIFIED": 0,
+		"FOLLOW_EXPORT_LIST_FOLLOWING":   1,
+		"FOLLOW_EXPORT_LIST_FOLLOWERS":   2,
+	}
+)
+
+func (x FollowExportList) Enum() *FollowExportList {
+	p := new(FollowExportList)
+	*p = x
+	return p
+}
+
+func (x FollowExportList) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FollowExportList) Descriptor() protoreflect.EnumDescriptor {
+	return file_follow_proto_enumTypes[0].Descriptor()
+}
+
+func (FollowExportList) Type() protoreflect.EnumType {
+	return &file_follow_proto_enumTypes[0]
+}
+
+func (x FollowExportList) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FollowExportList.Descriptor instead.
+func (FollowExportList) EnumDescriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{0}
+}
+
+type FollowImportStatus int32
+
+const (
+	FollowImportStatus_FOLLOW_IMPORT_STATUS_UNSPECIFIED FollowImportStatus = 0
+	FollowImportStatus_FOLLOW_IMPORT_STATUS_RUNNING     FollowImportStatus = 1
+	FollowImportStatus_FOLLOW_IMPORT_STATUS_DONE        FollowImportStatus = 2
+	FollowImportStatus_FOLLOW_IMPORT_STATUS_FAILED      FollowImportStatus = 3
+)
+
+// Enum value maps for FollowImportStatus.
+var (
+	FollowImportStatus_name = map[int32]string{
+		0: "FOLLOW_IMPORT_STATUS_UNSPECIFIED",
+		1: "FOLLOW_IMPORT_STATUS_RUNNING",
+		2: "FOLLOW_IMPORT_STATUS_DONE",
+		3: "FOLLOW_IMPORT_STATUS_FAILED",
+	}
+	FollowImportStatus_value = map[string]int32{
+		"FOLLOW_IMPORT_STATUS_UNSPECIFIED": 0,
+		"FOLLOW_IMPORT_STATUS_RUNNING":     1,
+		"FOLLOW_IMPORT_STATUS_DONE":        2,
+		"FOLLOW_IMPORT_STATUS_FAILED":      3,
+	}
+)
+
+func (x FollowImportStatus) Enum() *FollowImportStatus {
+	p := new(FollowImportStatus)
+	*p = x
+	return p
+}
+
+func (x FollowImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FollowImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_follow_proto_enumTypes[1].Descriptor()
+}
+
+func (FollowImportStatus) Type() protoreflect.EnumType {
+	return &file_follow_proto_enumTypes[1]
+}
+
+func (x FollowImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FollowImportStatus.Descriptor instead.
+func (FollowImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{1}
+}
+
 // Follow
 type FollowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -336,35 +440,853 @@ func (x *ListMyFollowingResponse) GetNextPageToken() string {
 	return ""
 }
 
-var File_follow_proto protoreflect.FileDescriptor
+type ListUserFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // optional fuzzy search on nickname
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_follow_proto_rawDesc = "" +
-	"\n" +
-	"\ffollow.proto\x12\x06follow\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\fcommon.proto\"T\n" +
-	"\rFollowRequest\x12\x15\n" +
-	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12,\n" +
-	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"l\n" +
-	"\x0eFollowResponse\x12,\n" +
-	"\x0ffollowing_count\x18\x01 \x01(\x05B\x03\xe0A\x02R\x0efollowingCount\x12,\n" +
-	"\x0ffollowers_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\x0efollowersCount\"M\n" +
-	"\x16ListMyFollowersRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"o\n" +
-	"\x17ListMyFollowersResponse\x12'\n" +
-	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x12+\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"M\n" +
-	"\x16ListMyFollowingRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"o\n" +
-	"\x17ListMyFollowingResponse\x12'\n" +
+func (x *ListUserFollowersRequest) Reset() {
+	*x = ListUserFollowersRequest{}
+	mi := &file_follow_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserFollowersRequest) ProtoMessage() {}
+
+func (x *ListUserFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserFollowersRequest.ProtoReflect.Descriptor instead.
+func (*ListUserFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListUserFollowersRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ListUserFollowersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListUserFollowersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUserFollowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserFollowersResponse) Reset() {
+	*x = ListUserFollowersResponse{}
+	mi := &file_follow_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserFollowersResponse) ProtoMessage() {}
+
+func (x *ListUserFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserFollowersResponse.ProtoReflect.Descriptor instead.
+func (*ListUserFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListUserFollowersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUserFollowersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListUserFollowingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // optional fuzzy search on nickname
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserFollowingRequest) Reset() {
+	*x = ListUserFollowingRequest{}
+	mi := &file_follow_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserFollowingRequest) ProtoMessage() {}
+
+func (x *ListUserFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserFollowingRequest.ProtoReflect.Descriptor instead.
+func (*ListUserFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListUserFollowingRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ListUserFollowingRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListUserFollowingRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUserFollowingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserFollowingResponse) Reset() {
+	*x = ListUserFollowingResponse{}
+	mi := &file_follow_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserFollowingResponse) ProtoMessage() {}
+
+func (x *ListUserFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserFollowingResponse.ProtoReflect.Descriptor instead.
+func (*ListUserFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListUserFollowingResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUserFollowingResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListMutualFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMutualFollowersRequest) Reset() {
+	*x = ListMutualFollowersRequest{}
+	mi := &file_follow_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMutualFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutualFollowersRequest) ProtoMessage() {}
+
+func (x *ListMutualFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutualFollowersRequest.ProtoReflect.Descriptor instead.
+func (*ListMutualFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMutualFollowersRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ListMutualFollowersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMutualFollowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMutualFollowersResponse) Reset() {
+	*x = ListMutualFollowersResponse{}
+	mi := &file_follow_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMutualFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutualFollowersResponse) ProtoMessage() {}
+
+func (x *ListMutualFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutualFollowersResponse.ProtoReflect.Descriptor instead.
+func (*ListMutualFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMutualFollowersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListMutualFollowersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMutualFollowersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Suggestions
+type SuggestUsersToFollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestUsersToFollowRequest) Reset() {
+	*x = SuggestUsersToFollowRequest{}
+	mi := &file_follow_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestUsersToFollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestUsersToFollowRequest) ProtoMessage() {}
+
+func (x *SuggestUsersToFollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestUsersToFollowRequest.ProtoReflect.Descriptor instead.
+func (*SuggestUsersToFollowRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{12}
+}
+
+type FollowSuggestion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	MutualCount    int32                  `protobuf:"varint,2,opt,name=mutual_count,json=mutualCount,proto3" json:"mutual_count,omitempty"`            // people you follow who follow them
+	SharedTagCount int32                  `protobuf:"varint,3,opt,name=shared_tag_count,json=sharedTagCount,proto3" json:"shared_tag_count,omitempty"` // tags you follow they recently posted under
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FollowSuggestion) Reset() {
+	*x = FollowSuggestion{}
+	mi := &file_follow_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowSuggestion) ProtoMessage() {}
+
+func (x *FollowSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowSuggestion.ProtoReflect.Descriptor instead.
+func (*FollowSuggestion) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FollowSuggestion) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *FollowSuggestion) GetMutualCount() int32 {
+	if x != nil {
+		return x.MutualCount
+	}
+	return 0
+}
+
+func (x *FollowSuggestion) GetSharedTagCount() int32 {
+	if x != nil {
+		return x.SharedTagCount
+	}
+	return 0
+}
+
+type SuggestUsersToFollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*FollowSuggestion    `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestUsersToFollowResponse) Reset() {
+	*x = SuggestUsersToFollowResponse{}
+	mi := &file_follow_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestUsersToFollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestUsersToFollowResponse) ProtoMessage() {}
+
+func (x *SuggestUsersToFollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestUsersToFollowResponse.ProtoReflect.Descriptor instead.
+func (*SuggestUsersToFollowResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SuggestUsersToFollowResponse) GetSuggestions() []*FollowSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type DismissFollowSuggestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissFollowSuggestionRequest) Reset() {
+	*x = DismissFollowSuggestionRequest{}
+	mi := &file_follow_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissFollowSuggestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissFollowSuggestionRequest) ProtoMessage() {}
+
+func (x *DismissFollowSuggestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissFollowSuggestionRequest.ProtoReflect.Descriptor instead.
+func (*DismissFollowSuggestionRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DismissFollowSuggestionRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type ExportFollowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          FollowExportList       `protobuf:"varint,1,opt,name=list,proto3,enum=follow.FollowExportList" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFollowsRequest) Reset() {
+	*x = ExportFollowsRequest{}
+	mi := &file_follow_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportFollowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFollowsRequest) ProtoMessage() {}
+
+func (x *ExportFollowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFollowsRequest.ProtoReflect.Descriptor instead.
+func (*ExportFollowsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportFollowsRequest) GetList() FollowExportList {
+	if x != nil {
+		return x.List
+	}
+	return FollowExportList_FOLLOW_EXPORT_LIST_UNSPECIFIED
+}
+
+type ImportFollowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileUrl       string                 `protobuf:"bytes,1,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"` // url returned by UploadFile
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFollowsRequest) Reset() {
+	*x = ImportFollowsRequest{}
+	mi := &file_follow_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFollowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFollowsRequest) ProtoMessage() {}
+
+func (x *ImportFollowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFollowsRequest.ProtoReflect.Descriptor instead.
+func (*ImportFollowsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportFollowsRequest) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+type FollowImport struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Uid                   string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Status                FollowImportStatus     `protobuf:"varint,2,opt,name=status,proto3,enum=follow.FollowImportStatus" json:"status,omitempty"`
+	TotalCount            int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	FollowedCount         int32                  `protobuf:"varint,4,opt,name=followed_count,json=followedCount,proto3" json:"followed_count,omitempty"`
+	AlreadyFollowingCount int32                  `protobuf:"varint,5,opt,name=already_following_count,json=alreadyFollowingCount,proto3" json:"already_following_count,omitempty"`
+	NotFoundCount         int32                  `protobuf:"varint,6,opt,name=not_found_count,json=notFoundCount,proto3" json:"not_found_count,omitempty"`
+	SkippedCount          int32                  `protobuf:"varint,7,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"` // yourself, or rows over the import limit
+	Error                 string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *FollowImport) Reset() {
+	*x = FollowImport{}
+	mi := &file_follow_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowImport) ProtoMessage() {}
+
+func (x *FollowImport) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowImport.ProtoReflect.Descriptor instead.
+func (*FollowImport) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FollowImport) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *FollowImport) GetStatus() FollowImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FollowImportStatus_FOLLOW_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *FollowImport) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *FollowImport) GetFollowedCount() int32 {
+	if x != nil {
+		return x.FollowedCount
+	}
+	return 0
+}
+
+func (x *FollowImport) GetAlreadyFollowingCount() int32 {
+	if x != nil {
+		return x.AlreadyFollowingCount
+	}
+	return 0
+}
+
+func (x *FollowImport) GetNotFoundCount() int32 {
+	if x != nil {
+		return x.NotFoundCount
+	}
+	return 0
+}
+
+func (x *FollowImport) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *FollowImport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportFollowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Import        *FollowImport          `protobuf:"bytes,1,opt,name=import,proto3" json:"import,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFollowsResponse) Reset() {
+	*x = ImportFollowsResponse{}
+	mi := &file_follow_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFollowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFollowsResponse) ProtoMessage() {}
+
+func (x *ImportFollowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFollowsResponse.ProtoReflect.Descriptor instead.
+func (*ImportFollowsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportFollowsResponse) GetImport() *FollowImport {
+	if x != nil {
+		return x.Import
+	}
+	return nil
+}
+
+var File_follow_proto protoreflect.FileDescriptor
+
+const file_follow_proto_rawDesc = "" +
+	"\n" +
+	"\ffollow.proto\x12\x06follow\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\fcommon.proto\"T\n" +
+	"\rFollowRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12,\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"l\n" +
+	"\x0eFollowResponse\x12,\n" +
+	"\x0ffollowing_count\x18\x01 \x01(\x05B\x03\xe0A\x02R\x0efollowingCount\x12,\n" +
+	"\x0ffollowers_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\x0efollowersCount\"M\n" +
+	"\x16ListMyFollowersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"o\n" +
+	"\x17ListMyFollowersResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"M\n" +
+	"\x16ListMyFollowingRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"o\n" +
+	"\x17ListMyFollowingResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"f\n" +
+	"\x18ListUserFollowersRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"q\n" +
+	"\x19ListUserFollowersResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"f\n" +
+	"\x18ListUserFollowingRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"q\n" +
+	"\x19ListUserFollowingResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"R\n" +
+	"\x1aListMutualFollowersRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x8e\x01\n" +
+	"\x1bListMutualFollowersResponse\x12'\n" +
 	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x12+\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken2\xd3\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\x12\x19\n" +
+	"\x05total\x18\x03 \x01(\x05B\x03\xe0A\x02R\x05total\"\x1d\n" +
+	"\x1bSuggestUsersToFollowRequest\"\x90\x01\n" +
+	"\x10FollowSuggestion\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\f.common.UserB\x03\xe0A\x02R\x04user\x12&\n" +
+	"\fmutual_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\vmutualCount\x12-\n" +
+	"\x10shared_tag_count\x18\x03 \x01(\x05B\x03\xe0A\x02R\x0esharedTagCount\"_\n" +
+	"\x1cSuggestUsersToFollowResponse\x12?\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x18.follow.FollowSuggestionB\x03\xe0A\x02R\vsuggestions\"7\n" +
+	"\x1eDismissFollowSuggestionRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"D\n" +
+	"\x14ExportFollowsRequest\x12,\n" +
+	"\x04list\x18\x01 \x01(\x0e2\x18.follow.FollowExportListR\x04list\"6\n" +
+	"\x14ImportFollowsRequest\x12\x1e\n" +
+	"\bfile_url\x18\x01 \x01(\tB\x03\xe0A\x02R\afileUrl\"\xda\x02\n" +
+	"\fFollowImport\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.follow.FollowImportStatusB\x03\xe0A\x02R\x06status\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x05B\x03\xe0A\x02R\n" +
+	"totalCount\x12*\n" +
+	"\x0efollowed_count\x18\x04 \x01(\x05B\x03\xe0A\x02R\rfollowedCount\x12;\n" +
+	"\x17already_following_count\x18\x05 \x01(\x05B\x03\xe0A\x02R\x15alreadyFollowingCount\x12+\n" +
+	"\x0fnot_found_count\x18\x06 \x01(\x05B\x03\xe0A\x02R\rnotFoundCount\x12(\n" +
+	"\rskipped_count\x18\a \x01(\x05B\x03\xe0A\x02R\fskippedCount\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"J\n" +
+	"\x15ImportFollowsResponse\x121\n" +
+	"\x06import\x18\x01 \x01(\v2\x14.follow.FollowImportB\x03\xe0A\x02R\x06import*z\n" +
+	"\x10FollowExportList\x12\"\n" +
+	"\x1eFOLLOW_EXPORT_LIST_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cFOLLOW_EXPORT_LIST_FOLLOWING\x10\x01\x12 \n" +
+	"\x1cFOLLOW_EXPORT_LIST_FOLLOWERS\x10\x02*\x9c\x01\n" +
+	"\x12FollowImportStatus\x12$\n" +
+	" FOLLOW_IMPORT_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cFOLLOW_IMPORT_STATUS_RUNNING\x10\x01\x12\x1d\n" +
+	"\x19FOLLOW_IMPORT_STATUS_DONE\x10\x02\x12\x1f\n" +
+	"\x1bFOLLOW_IMPORT_STATUS_FAILED\x10\x032\xd4\t\n" +
 	"\rFollowService\x12^\n" +
 	"\x06Follow\x12\x15.follow.FollowRequest\x1a\x16.follow.FollowResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/users/{uid}/follow\x12p\n" +
 	"\x0fListMyFollowers\x12\x1e.follow.ListMyFollowersRequest\x1a\x1f.follow.ListMyFollowersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/me/followers\x12p\n" +
-	"\x0fListMyFollowing\x12\x1e.follow.ListMyFollowingRequest\x1a\x1f.follow.ListMyFollowingResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/me/followingB\x0fZ\raeibi/api;apib\x06proto3"
+	"\x0fListMyFollowing\x12\x1e.follow.ListMyFollowingRequest\x1a\x1f.follow.ListMyFollowingResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/me/following\x12\x7f\n" +
+	"\x11ListUserFollowers\x12 .follow.ListUserFollowersRequest\x1a!.follow.ListUserFollowersResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/users/{uid}/followers\x12\x7f\n" +
+	"\x11ListUserFollowing\x12 .follow.ListUserFollowingRequest\x1a!.follow.ListUserFollowingResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/users/{uid}/following\x12\x8c\x01\n" +
+	"\x13ListMutualFollowers\x12\".follow.ListMutualFollowersRequest\x1a#.follow.ListMutualFollowersResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/users/{uid}/mutual-followers\x12\x88\x01\n" +
+	"\x14SuggestUsersToFollow\x12#.follow.SuggestUsersToFollowRequest\x1a$.follow.SuggestUsersToFollowResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/me/follow-suggestions\x12\x86\x01\n" +
+	"\x17DismissFollowSuggestion\x12&.follow.DismissFollowSuggestionRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%*#/api/v1/me/follow-suggestions/{uid}\x12f\n" +
+	"\rExportFollows\x12\x1c.follow.ExportFollowsRequest\x1a\x14.google.api.HttpBody\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/me/follows/export\x12r\n" +
+	"\rImportFollows\x12\x1c.follow.ImportFollowsRequest\x1a\x1d.follow.ImportFollowsResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/me/follows/importB\x0fZ\raeibi/api;apib\x06proto3"
 
 var (
 	file_follow_proto_rawDescOnce sync.Once
@@ -378,32 +1300,73 @@ func file_follow_proto_rawDescGZIP() []byte {
 	return file_follow_proto_rawDescData
 }
 
-var file_follow_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_follow_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_follow_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_follow_proto_goTypes = []any{
-	(*FollowRequest)(nil),           // 0: follow.FollowRequest
-	(*FollowResponse)(nil),          // 1: follow.FollowResponse
-	(*ListMyFollowersRequest)(nil),  // 2: follow.ListMyFollowersRequest
-	(*ListMyFollowersResponse)(nil), // 3: follow.ListMyFollowersResponse
-	(*ListMyFollowingRequest)(nil),  // 4: follow.ListMyFollowingRequest
-	(*ListMyFollowingResponse)(nil), // 5: follow.ListMyFollowingResponse
-	(ToggleAction)(0),               // 6: common.ToggleAction
-	(*User)(nil),                    // 7: common.User
+	(FollowExportList)(0),                  // 0: follow.FollowExportList
+	(FollowImportStatus)(0),                // 1: follow.FollowImportStatus
+	(*FollowRequest)(nil),                  // 2: follow.FollowRequest
+	(*FollowResponse)(nil),                 // 3: follow.FollowResponse
+	(*ListMyFollowersRequest)(nil),         // 4: follow.ListMyFollowersRequest
+	(*ListMyFollowersResponse)(nil),        // 5: follow.ListMyFollowersResponse
+	(*ListMyFollowingRequest)(nil),         // 6: follow.ListMyFollowingRequest
+	(*ListMyFollowingResponse)(nil),        // 7: follow.ListMyFollowingResponse
+	(*ListUserFollowersRequest)(nil),       // 8: follow.ListUserFollowersRequest
+	(*ListUserFollowersResponse)(nil),      // 9: follow.ListUserFollowersResponse
+	(*ListUserFollowingRequest)(nil),       // 10: follow.ListUserFollowingRequest
+	(*ListUserFollowingResponse)(nil),      // 11: follow.ListUserFollowingResponse
+	(*ListMutualFollowersRequest)(nil),     // 12: follow.ListMutualFollowersRequest
+	(*ListMutualFollowersResponse)(nil),    // 13: follow.ListMutualFollowersResponse
+	(*SuggestUsersToFollowRequest)(nil),    // 14: follow.SuggestUsersToFollowRequest
+	(*FollowSuggestion)(nil),               // 15: follow.FollowSuggestion
+	(*SuggestUsersToFollowResponse)(nil),   // 16: follow.SuggestUsersToFollowResponse
+	(*DismissFollowSuggestionRequest)(nil), // 17: follow.DismissFollowSuggestionRequest
+	(*ExportFollowsRequest)(nil),           // 18: follow.ExportFollowsRequest
+	(*ImportFollowsRequest)(nil),           // 19: follow.ImportFollowsRequest
+	(*FollowImport)(nil),                   // 20: follow.FollowImport
+	(*ImportFollowsResponse)(nil),          // 21: follow.ImportFollowsResponse
+	(ToggleAction)(0),                      // 22: common.ToggleAction
+	(*User)(nil),                           // 23: common.User
+	(*emptypb.Empty)(nil),                  // 24: google.protobuf.Empty
+	(*httpbody.HttpBody)(nil),              // 25: google.api.HttpBody
 }
 var file_follow_proto_depIdxs = []int32{
-	6, // 0: follow.FollowRequest.action:type_name -> common.ToggleAction
-	7, // 1: follow.ListMyFollowersResponse.users:type_name -> common.User
-	7, // 2: follow.ListMyFollowingResponse.users:type_name -> common.User
-	0, // 3: follow.FollowService.Follow:input_type -> follow.FollowRequest
-	2, // 4: follow.FollowService.ListMyFollowers:input_type -> follow.ListMyFollowersRequest
-	4, // 5: follow.FollowService.ListMyFollowing:input_type -> follow.ListMyFollowingRequest
-	1, // 6: follow.FollowService.Follow:output_type -> follow.FollowResponse
-	3, // 7: follow.FollowService.ListMyFollowers:output_type -> follow.ListMyFollowersResponse
-	5, // 8: follow.FollowService.ListMyFollowing:output_type -> follow.ListMyFollowingResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	22, // 0: follow.FollowRequest.action:type_name -> common.ToggleAction
+	23, // 1: follow.ListMyFollowersResponse.users:type_name -> common.User
+	23, // 2: follow.ListMyFollowingResponse.users:type_name -> common.User
+	23, // 3: follow.ListUserFollowersResponse.users:type_name -> common.User
+	23, // 4: follow.ListUserFollowingResponse.users:type_name -> common.User
+	23, // 5: follow.ListMutualFollowersResponse.users:type_name -> common.User
+	23, // 6: follow.FollowSuggestion.user:type_name -> common.User
+	15, // 7: follow.SuggestUsersToFollowResponse.suggestions:type_name -> follow.FollowSuggestion
+	0,  // 8: follow.ExportFollowsRequest.list:type_name -> follow.FollowExportList
+	1,  // 9: follow.FollowImport.status:type_name -> follow.FollowImportStatus
+	20, // 10: follow.ImportFollowsResponse.import:type_name -> follow.FollowImport
+	2,  // 11: follow.FollowService.Follow:input_type -> follow.FollowRequest
+	4,  // 12: follow.FollowService.ListMyFollowers:input_type -> follow.ListMyFollowersRequest
+	6,  // 13: follow.FollowService.ListMyFollowing:input_type -> follow.ListMyFollowingRequest
+	8,  // 14: follow.FollowService.ListUserFollowers:input_type -> follow.ListUserFollowersRequest
+	10, // 15: follow.FollowService.ListUserFollowing:input_type -> follow.ListUserFollowingRequest
+	12, // 16: follow.FollowService.ListMutualFollowers:input_type -> follow.ListMutualFollowersRequest
+	14, // 17: follow.FollowService.SuggestUsersToFollow:input_type -> follow.SuggestUsersToFollowRequest
+	17, // 18: follow.FollowService.DismissFollowSuggestion:input_type -> follow.DismissFollowSuggestionRequest
+	18, // 19: follow.FollowService.ExportFollows:input_type -> follow.ExportFollowsRequest
+	19, // 20: follow.FollowService.ImportFollows:input_type -> follow.ImportFollowsRequest
+	3,  // 21: follow.FollowService.Follow:output_type -> follow.FollowResponse
+	5,  // 22: follow.FollowService.ListMyFollowers:output_type -> follow.ListMyFollowersResponse
+	7,  // 23: follow.FollowService.ListMyFollowing:output_type -> follow.ListMyFollowingResponse
+	9,  // 24: follow.FollowService.ListUserFollowers:output_type -> follow.ListUserFollowersResponse
+	11, // 25: follow.FollowService.ListUserFollowing:output_type -> follow.ListUserFollowingResponse
+	13, // 26: follow.FollowService.ListMutualFollowers:output_type -> follow.ListMutualFollowersResponse
+	16, // 27: follow.FollowService.SuggestUsersToFollow:output_type -> follow.SuggestUsersToFollowResponse
+	24, // 28: follow.FollowService.DismissFollowSuggestion:output_type -> google.protobuf.Empty
+	25, // 29: follow.FollowService.ExportFollows:output_type -> google.api.HttpBody
+	21, // 30: follow.FollowService.ImportFollows:output_type -> follow.ImportFollowsResponse
+	21, // [21:31] is the sub-list for method output_type
+	11, // [11:21] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_follow_proto_init() }
@@ -417,13 +1380,14 @@ func file_follow_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_follow_proto_rawDesc), len(file_follow_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_follow_proto_goTypes,
 		DependencyIndexes: file_follow_proto_depIdxs,
+		EnumInfos:         file_follow_proto_enumTypes,
 		MessageInfos:      file_follow_proto_msgTypes,
 	}.Build()
 	File_follow_proto = out.File
@@ -8,9 +8,11 @@ package api
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,9 +21,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FollowService_Follow_FullMethodName          = "/follow.FollowService/Follow"
-	FollowService_ListMyFollowers_FullMethodName = "/follow.FollowService/ListMyFollowers"
-	FollowService_ListMyFollowing_FullMethodName = "/follow.FollowService/ListMyFollowing"
+	FollowService_Follow_FullMethodName                  = "/follow.FollowService/Follow"
+	FollowService_ListMyFollowers_FullMethodName         = "/follow.FollowService/ListMyFollowers"
+	FollowService_ListMyFollowing_FullMethodName         = "/follow.FollowService/ListMyFollowing"
+	FollowService_ListUserFollowers_FullMethodName       = "/follow.FollowService/ListUserFollowers"
+	FollowService_ListUserFollowing_FullMethodName       = "/follow.FollowService/ListUserFollowing"
+	FollowService_ListMutualFollowers_FullMethodName     = "/follow.FollowService/ListMutualFollowers"
+	FollowService_SuggestUsersToFollow_FullMethodName    = "/follow.FollowService/SuggestUsersToFollow"
+	FollowService_DismissFollowSuggestion_FullMethodName = "/follow.FollowService/DismissFollowSuggestion"
+	FollowService_ExportFollows_FullMethodName           = "/follow.FollowService/ExportFollows"
+	FollowService_ImportFollows_FullMethodName           = "/follow.FollowService/ImportFollows"
 )
 
 // FollowServiceClient is the client API for FollowService service.
@@ -36,6 +45,20 @@ type FollowServiceClient interface {
 	ListMyFollowers(ctx context.Context, in *ListMyFollowersRequest, opts ...grpc.CallOption) (*ListMyFollowersResponse, error)
 	// GET /api/v1/me/following 关注列表
 	ListMyFollowing(ctx context.Context, in *ListMyFollowingRequest, opts ...grpc.CallOption) (*ListMyFollowingResponse, error)
+	// GET /api/v1/users/{uid}/followers 指定用户的粉丝列表
+	ListUserFollowers(ctx context.Context, in *ListUserFollowersRequest, opts ...grpc.CallOption) (*ListUserFollowersResponse, error)
+	// GET /api/v1/users/{uid}/following 指定用户的关注列表
+	ListUserFollowing(ctx context.Context, in *ListUserFollowingRequest, opts ...grpc.CallOption) (*ListUserFollowingResponse, error)
+	// GET /api/v1/users/{uid}/mutual-followers 我关注的人中也关注了该用户的人
+	ListMutualFollowers(ctx context.Context, in *ListMutualFollowersRequest, opts ...grpc.CallOption) (*ListMutualFollowersResponse, error)
+	// GET /api/v1/me/follow-suggestions 推荐关注
+	SuggestUsersToFollow(ctx context.Context, in *SuggestUsersToFollowRequest, opts ...grpc.CallOption) (*SuggestUsersToFollowResponse, error)
+	// DELETE /api/v1/me/follow-suggestions/{uid} 不再推荐该用户
+	DismissFollowSuggestion(ctx context.Context, in *DismissFollowSuggestionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GET /api/v1/me/follows/export 导出关注/粉丝列表 (CSV)
+	ExportFollows(ctx context.Context, in *ExportFollowsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// POST /api/v1/me/follows/import 从已上传的 CSV 文件导入关注列表
+	ImportFollows(ctx context.Context, in *ImportFollowsRequest, opts ...grpc.CallOption) (*ImportFollowsResponse, error)
 }
 
 type followServiceClient struct {
@@ -76,6 +99,76 @@ func (c *followServiceClient) ListMyFollowing(ctx context.Context, in *ListMyFol
 	return out, nil
 }
 
+func (c *followServiceClient) ListUserFollowers(ctx context.Context, in *ListUserFollowersRequest, opts ...grpc.CallOption) (*ListUserFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserFollowersResponse)
+	err := c.cc.Invoke(ctx, FollowService_ListUserFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) ListUserFollowing(ctx context.Context, in *ListUserFollowingRequest, opts ...grpc.CallOption) (*ListUserFollowingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserFollowingResponse)
+	err := c.cc.Invoke(ctx, FollowService_ListUserFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) ListMutualFollowers(ctx context.Context, in *ListMutualFollowersRequest, opts ...grpc.CallOption) (*ListMutualFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMutualFollowersResponse)
+	err := c.cc.Invoke(ctx, FollowService_ListMutualFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) SuggestUsersToFollow(ctx context.Context, in *SuggestUsersToFollowRequest, opts ...grpc.CallOption) (*SuggestUsersToFollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestUsersToFollowResponse)
+	err := c.cc.Invoke(ctx, FollowService_SuggestUsersToFollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) DismissFollowSuggestion(ctx context.Context, in *DismissFollowSuggestionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FollowService_DismissFollowSuggestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) ExportFollows(ctx context.Context, in *ExportFollowsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, FollowService_ExportFollows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) ImportFollows(ctx context.Context, in *ImportFollowsRequest, opts ...grpc.CallOption) (*ImportFollowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportFollowsResponse)
+	err := c.cc.Invoke(ctx, FollowService_ImportFollows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FollowServiceServer is the server API for FollowService service.
 // All implementations must embed UnimplementedFollowServiceServer
 // for forward compatibility.
@@ -88,6 +181,20 @@ type FollowServiceServer interface {
 	ListMyFollowers(context.Context, *ListMyFollowersRequest) (*ListMyFollowersResponse, error)
 	// GET /api/v1/me/following 关注列表
 	ListMyFollowing(context.Context, *ListMyFollowingRequest) (*ListMyFollowingResponse, error)
+	// GET /api/v1/users/{uid}/followers 指定用户的粉丝列表
+	ListUserFollowers(context.Context, *ListUserFollowersRequest) (*ListUserFollowersResponse, error)
+	// GET /api/v1/users/{uid}/following 指定用户的关注列表
+	ListUserFollowing(context.Context, *ListUserFollowingRequest) (*ListUserFollowingResponse, error)
+	// GET /api/v1/users/{uid}/mutual-followers 我关注的人中也关注了该用户的人
+	ListMutualFollowers(context.Context, *ListMutualFollowersRequest) (*ListMutualFollowersResponse, error)
+	// GET /api/v1/me/follow-suggestions 推荐关注
+	SuggestUsersToFollow(context.Context, *SuggestUsersToFollowRequest) (*SuggestUsersToFollowResponse, error)
+	// DELETE /api/v1/me/follow-suggestions/{uid} 不再推荐该用户
+	DismissFollowSuggestion(context.Context, *DismissFollowSuggestionRequest) (*emptypb.Empty, error)
+	// GET /api/v1/me/follows/export 导出关注/粉丝列表 (CSV)
+	ExportFollows(context.Context, *ExportFollowsRequest) (*httpbody.HttpBody, error)
+	// POST /api/v1/me/follows/import 从已上传的 CSV 文件导入关注列表
+	ImportFollows(context.Context, *ImportFollowsRequest) (*ImportFollowsResponse, error)
 	mustEmbedUnimplementedFollowServiceServer()
 }
 
@@ -107,6 +214,27 @@ func (UnimplementedFollowServiceServer) ListMyFollowers(context.Context, *ListMy
 func (UnimplementedFollowServiceServer) ListMyFollowing(context.Context, *ListMyFollowingRequest) (*ListMyFollowingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMyFollowing not implemented")
 }
+func (UnimplementedFollowServiceServer) ListUserFollowers(context.Context, *ListUserFollowersRequest) (*ListUserFollowersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserFollowers not implemented")
+}
+func (UnimplementedFollowServiceServer) ListUserFollowing(context.Context, *ListUserFollowingRequest) (*ListUserFollowingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserFollowing not implemented")
+}
+func (UnimplementedFollowServiceServer) ListMutualFollowers(context.Context, *ListMutualFollowersRequest) (*ListMutualFollowersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMutualFollowers not implemented")
+}
+func (UnimplementedFollowServiceServer) SuggestUsersToFollow(context.Context, *SuggestUsersToFollowRequest) (*SuggestUsersToFollowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestUsersToFollow not implemented")
+}
+func (UnimplementedFollowServiceServer) DismissFollowSuggestion(context.Context, *DismissFollowSuggestionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DismissFollowSuggestion not implemented")
+}
+func (UnimplementedFollowServiceServer) ExportFollows(context.Context, *ExportFollowsRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportFollows not implemented")
+}
+func (UnimplementedFollowServiceServer) ImportFollows(context.Context, *ImportFollowsRequest) (*ImportFollowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportFollows not implemented")
+}
 func (UnimplementedFollowServiceServer) mustEmbedUnimplementedFollowServiceServer() {}
 func (UnimplementedFollowServiceServer) testEmbeddedByValue()                       {}
 
@@ -182,6 +310,132 @@ func _FollowService_ListMyFollowing_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FollowService_ListUserFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).ListUserFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_ListUserFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).ListUserFollowers(ctx, req.(*ListUserFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_ListUserFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).ListUserFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_ListUserFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).ListUserFollowing(ctx, req.(*ListUserFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_ListMutualFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMutualFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).ListMutualFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_ListMutualFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).ListMutualFollowers(ctx, req.(*ListMutualFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_SuggestUsersToFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestUsersToFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).SuggestUsersToFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_SuggestUsersToFollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).SuggestUsersToFollow(ctx, req.(*SuggestUsersToFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_DismissFollowSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissFollowSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).DismissFollowSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_DismissFollowSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).DismissFollowSuggestion(ctx, req.(*DismissFollowSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_ExportFollows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).ExportFollows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_ExportFollows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).ExportFollows(ctx, req.(*ExportFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_ImportFollows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).ImportFollows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_ImportFollows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).ImportFollows(ctx, req.(*ImportFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FollowService_ServiceDesc is the grpc.ServiceDesc for FollowService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +455,34 @@ var FollowService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMyFollowing",
 			Handler:    _FollowService_ListMyFollowing_Handler,
 		},
+		{
+			MethodName: "ListUserFollowers",
+			Handler:    _FollowService_ListUserFollowers_Handler,
+		},
+		{
+			MethodName: "ListUserFollowing",
+			Handler:    _FollowService_ListUserFollowing_Handler,
+		},
+		{
+			MethodName: "ListMutualFollowers",
+			Handler:    _FollowService_ListMutualFollowers_Handler,
+		},
+		{
+			MethodName: "SuggestUsersToFollow",
+			Handler:    _FollowService_SuggestUsersToFollow_Handler,
+		},
+		{
+			MethodName: "DismissFollowSuggestion",
+			Handler:    _FollowService_DismissFollowSuggestion_Handler,
+		},
+		{
+			MethodName: "ExportFollows",
+			Handler:    _FollowService_ExportFollows_Handler,
+		},
+		{
+			MethodName: "ImportFollows",
+			Handler:    _FollowService_ImportFollows_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "follow.proto",
@@ -23,6 +23,104 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type LikeInboxMessageType int32
+
+const (
+	LikeInboxMessageType_LIKE_INBOX_MESSAGE_TYPE_UNSPECIFIED  LikeInboxMessageType = 0
+	LikeInboxMessageType_LIKE_INBOX_MESSAGE_TYPE_LIKE_POST    LikeInboxMessageType = 1
+	LikeInboxMessageType_LIKE_INBOX_MESSAGE_TYPE_COLLECT_POST LikeInboxMessageType = 2
+	LikeInboxMessageType_LIKE_INBOX_MESSAGE_TYPE_LIKE_COMMENT LikeInboxMessageType = 3
+)
+
+// Enum value maps for LikeInboxMessageType.
+var (
+	LikeInboxMessageType_name = map[int32]string{
+		0: "LIKE_INBOX_MESSAGE_TYPE_UNSPECIFIED",
+		1: "LIKE_INBOX_MESSAGE_TYPE_LIKE_POST",
+		2: "LIKE_INBOX_MESSAGE_TYPE_COLLECT_POST",
+		3: "LIKE_INBOX_MESSAGE_TYPE_LIKE_COMMENT",
+	}
+	LikeInboxMessageType_value = map[string]int32{
+		"LIKE_INBOX_MESSAGE_TYPE_UNSPECIFIED":  0,
+		"LIKE_INBOX_MESSAGE_TYPE_LIKE_POST":    1,
+		"LIKE_INBOX_MESSAGE_TYPE_COLLECT_POST": 2,
+		"LIKE_INBOX_MESSAGE_TYPE_LIKE_COMMENT": 3,
+	}
+)
+
+func (x LikeInboxMessageType) Enum() *LikeInboxMessageType {
+	p := new(LikeInboxMessageType)
+	*p = x
+	return p
+}
+
+func (x LikeInboxMessageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LikeInboxMessageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[0].Descriptor()
+}
+
+func (LikeInboxMessageType) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[0]
+}
+
+func (x LikeInboxMessageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LikeInboxMessageType.Descriptor instead.
+func (LikeInboxMessageType) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{0}
+}
+
+type SystemInboxMessageType int32
+
+const (
+	SystemInboxMessageType_SYSTEM_INBOX_MESSAGE_TYPE_UNSPECIFIED   SystemInboxMessageType = 0
+	SystemInboxMessageType_SYSTEM_INBOX_MESSAGE_TYPE_FOLLOW_IMPORT SystemInboxMessageType = 1
+)
+
+// Enum value maps for SystemInboxMessageType.
+var (
+	SystemInboxMessageType_name = map[int32]string{
+		0: "SYSTEM_INBOX_MESSAGE_TYPE_UNSPECIFIED",
+		1: "SYSTEM_INBOX_MESSAGE_TYPE_FOLLOW_IMPORT",
+	}
+	SystemInboxMessageType_value = map[string]int32{
+		"SYSTEM_INBOX_MESSAGE_TYPE_UNSPECIFIED":   0,
+		"SYSTEM_INBOX_MESSAGE_TYPE_FOLLOW_IMPORT": 1,
+	}
+)
+
+func (x SystemInboxMessageType) Enum() *SystemInboxMessageType {
+	p := new(SystemInboxMessageType)
+	*p = x
+	return p
+}
+
+func (x SystemInboxMessageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SystemInboxMessageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_proto_enumTypes[1].Descriptor()
+}
+
+func (SystemInboxMessageType) Type() protoreflect.EnumType {
+	return &file_message_proto_enumTypes[1]
+}
+
+func (x SystemInboxMessageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SystemInboxMessageType.Descriptor instead.
+func (SystemInboxMessageType) EnumDescriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{1}
+}
+
 type InboxMessageReadFilter int32
 
 const (
@@ -56,11 +154,11 @@ func (x InboxMessageReadFilter) String() string {
 }
 
 func (InboxMessageReadFilter) Descriptor() protoreflect.EnumDescriptor {
-	return file_message_proto_enumTypes[0].Descriptor()
+	return file_message_proto_enumTypes[2].Descriptor()
 }
 
 func (InboxMessageReadFilter) Type() protoreflect.EnumType {
-	return &file_message_proto_enumTypes[0]
+	return &file_message_proto_enumTypes[2]
 }
 
 func (x InboxMessageReadFilter) Number() protoreflect.EnumNumber {
@@ -69,7 +167,7 @@ func (x InboxMessageReadFilter) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InboxMessageReadFilter.Descriptor instead.
 func (InboxMessageReadFilter) EnumDescriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{0}
+	return file_message_proto_rawDescGZIP(), []int{2}
 }
 
 type InboxMessageActor struct {
@@ -133,18 +231,20 @@ func (x *InboxMessageActor) GetAvatarUrl() string {
 }
 
 type CommentInboxMessage struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Uid            string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	IsRead         bool                   `protobuf:"varint,2,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
-	Actor          *InboxMessageActor     `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
-	CreatedAt      int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CommentUid     string                 `protobuf:"bytes,5,opt,name=comment_uid,json=commentUid,proto3" json:"comment_uid,omitempty"`
-	CommentContent string                 `protobuf:"bytes,6,opt,name=comment_content,json=commentContent,proto3" json:"comment_content,omitempty"`
-	PostUid        string                 `protobuf:"bytes,7,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
-	ParentUid      string                 `protobuf:"bytes,8,opt,name=parent_uid,json=parentUid,proto3" json:"parent_uid,omitempty"`
-	ParentContent  string                 `protobuf:"bytes,9,opt,name=parent_content,json=parentContent,proto3" json:"parent_content,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Uid                string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	IsRead             bool                   `protobuf:"varint,2,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	Actor              *InboxMessageActor     `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	CreatedAt          int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CommentUid         string                 `protobuf:"bytes,5,opt,name=comment_uid,json=commentUid,proto3" json:"comment_uid,omitempty"`
+	CommentContent     string                 `protobuf:"bytes,6,opt,name=comment_content,json=commentContent,proto3" json:"comment_content,omitempty"`
+	PostUid            string                 `protobuf:"bytes,7,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	ParentUid          string                 `protobuf:"bytes,8,opt,name=parent_uid,json=parentUid,proto3" json:"parent_uid,omitempty"`
+	ParentContent      string                 `protobuf:"bytes,9,opt,name=parent_content,json=parentContent,proto3" json:"parent_content,omitempty"`
+	CommentImages      []string               `protobuf:"bytes,10,rep,name=comment_images,json=commentImages,proto3" json:"comment_images,omitempty"`
+	CommentAttachments []*Attachment          `protobuf:"bytes,11,rep,name=comment_attachments,json=commentAttachments,proto3" json:"comment_attachments,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CommentInboxMessage) Reset() {
@@ -240,6 +340,20 @@ func (x *CommentInboxMessage) GetParentContent() string {
 	return ""
 }
 
+func (x *CommentInboxMessage) GetCommentImages() []string {
+	if x != nil {
+		return x.CommentImages
+	}
+	return nil
+}
+
+func (x *CommentInboxMessage) GetCommentAttachments() []*Attachment {
+	if x != nil {
+		return x.CommentAttachments
+	}
+	return nil
+}
+
 type FollowInboxMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
@@ -308,6 +422,190 @@ func (x *FollowInboxMessage) GetCreatedAt() int64 {
 	return 0
 }
 
+type LikeInboxMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uid            string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	IsRead         bool                   `protobuf:"varint,2,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	Actor          *InboxMessageActor     `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Type           LikeInboxMessageType   `protobuf:"varint,5,opt,name=type,proto3,enum=message.LikeInboxMessageType" json:"type,omitempty"`
+	PostUid        string                 `protobuf:"bytes,6,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	PostText       string                 `protobuf:"bytes,7,opt,name=post_text,json=postText,proto3" json:"post_text,omitempty"`
+	CommentUid     string                 `protobuf:"bytes,8,opt,name=comment_uid,json=commentUid,proto3" json:"comment_uid,omitempty"`
+	CommentContent string                 `protobuf:"bytes,9,opt,name=comment_content,json=commentContent,proto3" json:"comment_content,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LikeInboxMessage) Reset() {
+	*x = LikeInboxMessage{}
+	mi := &file_message_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeInboxMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeInboxMessage) ProtoMessage() {}
+
+func (x *LikeInboxMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeInboxMessage.ProtoReflect.Descriptor instead.
+func (*LikeInboxMessage) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LikeInboxMessage) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *LikeInboxMessage) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *LikeInboxMessage) GetActor() *InboxMessageActor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *LikeInboxMessage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *LikeInboxMessage) GetType() LikeInboxMessageType {
+	if x != nil {
+		return x.Type
+	}
+	return LikeInboxMessageType_LIKE_INBOX_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *LikeInboxMessage) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+func (x *LikeInboxMessage) GetPostText() string {
+	if x != nil {
+		return x.PostText
+	}
+	return ""
+}
+
+func (x *LikeInboxMessage) GetCommentUid() string {
+	if x != nil {
+		return x.CommentUid
+	}
+	return ""
+}
+
+func (x *LikeInboxMessage) GetCommentContent() string {
+	if x != nil {
+		return x.CommentContent
+	}
+	return ""
+}
+
+type SystemInboxMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	IsRead        bool                   `protobuf:"varint,2,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Type          SystemInboxMessageType `protobuf:"varint,4,opt,name=type,proto3,enum=message.SystemInboxMessageType" json:"type,omitempty"`
+	FollowImport  *FollowImport          `protobuf:"bytes,5,opt,name=follow_import,json=followImport,proto3" json:"follow_import,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemInboxMessage) Reset() {
+	*x = SystemInboxMessage{}
+	mi := &file_message_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemInboxMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInboxMessage) ProtoMessage() {}
+
+func (x *SystemInboxMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInboxMessage.ProtoReflect.Descriptor instead.
+func (*SystemInboxMessage) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SystemInboxMessage) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SystemInboxMessage) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *SystemInboxMessage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SystemInboxMessage) GetType() SystemInboxMessageType {
+	if x != nil {
+		return x.Type
+	}
+	return SystemInboxMessageType_SYSTEM_INBOX_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *SystemInboxMessage) GetFollowImport() *FollowImport {
+	if x != nil {
+		return x.FollowImport
+	}
+	return nil
+}
+
 type ListCommentInboxMessagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReadFilter    InboxMessageReadFilter `protobuf:"varint,1,opt,name=read_filter,json=readFilter,proto3,enum=message.InboxMessageReadFilter" json:"read_filter,omitempty"`
@@ -318,7 +616,7 @@ type ListCommentInboxMessagesRequest struct {
 
 func (x *ListCommentInboxMessagesRequest) Reset() {
 	*x = ListCommentInboxMessagesRequest{}
-	mi := &file_message_proto_msgTypes[3]
+	mi := &file_message_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +628,7 @@ func (x *ListCommentInboxMessagesRequest) String() string {
 func (*ListCommentInboxMessagesRequest) ProtoMessage() {}
 
 func (x *ListCommentInboxMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[3]
+	mi := &file_message_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +641,7 @@ func (x *ListCommentInboxMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommentInboxMessagesRequest.ProtoReflect.Descriptor instead.
 func (*ListCommentInboxMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{3}
+	return file_message_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListCommentInboxMessagesRequest) GetReadFilter() InboxMessageReadFilter {
@@ -370,7 +668,7 @@ type ListCommentInboxMessagesResponse struct {
 
 func (x *ListCommentInboxMessagesResponse) Reset() {
 	*x = ListCommentInboxMessagesResponse{}
-	mi := &file_message_proto_msgTypes[4]
+	mi := &file_message_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -382,7 +680,7 @@ func (x *ListCommentInboxMessagesResponse) String() string {
 func (*ListCommentInboxMessagesResponse) ProtoMessage() {}
 
 func (x *ListCommentInboxMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[4]
+	mi := &file_message_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +693,7 @@ func (x *ListCommentInboxMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommentInboxMessagesResponse.ProtoReflect.Descriptor instead.
 func (*ListCommentInboxMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{4}
+	return file_message_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListCommentInboxMessagesResponse) GetMessages() []*CommentInboxMessage {
@@ -422,7 +720,7 @@ type ListFollowInboxMessagesRequest struct {
 
 func (x *ListFollowInboxMessagesRequest) Reset() {
 	*x = ListFollowInboxMessagesRequest{}
-	mi := &file_message_proto_msgTypes[5]
+	mi := &file_message_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -434,7 +732,7 @@ func (x *ListFollowInboxMessagesRequest) String() string {
 func (*ListFollowInboxMessagesRequest) ProtoMessage() {}
 
 func (x *ListFollowInboxMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[5]
+	mi := &file_message_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +745,7 @@ func (x *ListFollowInboxMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFollowInboxMessagesRequest.ProtoReflect.Descriptor instead.
 func (*ListFollowInboxMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{5}
+	return file_message_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListFollowInboxMessagesRequest) GetReadFilter() InboxMessageReadFilter {
@@ -474,7 +772,7 @@ type ListFollowInboxMessagesResponse struct {
 
 func (x *ListFollowInboxMessagesResponse) Reset() {
 	*x = ListFollowInboxMessagesResponse{}
-	mi := &file_message_proto_msgTypes[6]
+	mi := &file_message_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -486,7 +784,7 @@ func (x *ListFollowInboxMessagesResponse) String() string {
 func (*ListFollowInboxMessagesResponse) ProtoMessage() {}
 
 func (x *ListFollowInboxMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[6]
+	mi := &file_message_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +797,7 @@ func (x *ListFollowInboxMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFollowInboxMessagesResponse.ProtoReflect.Descriptor instead.
 func (*ListFollowInboxMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{6}
+	return file_message_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListFollowInboxMessagesResponse) GetMessages() []*FollowInboxMessage {
@@ -516,6 +814,214 @@ func (x *ListFollowInboxMessagesResponse) GetNextPageToken() string {
 	return ""
 }
 
+type ListLikeInboxMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReadFilter    InboxMessageReadFilter `protobuf:"varint,1,opt,name=read_filter,json=readFilter,proto3,enum=message.InboxMessageReadFilter" json:"read_filter,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLikeInboxMessagesRequest) Reset() {
+	*x = ListLikeInboxMessagesRequest{}
+	mi := &file_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLikeInboxMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLikeInboxMessagesRequest) ProtoMessage() {}
+
+func (x *ListLikeInboxMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLikeInboxMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListLikeInboxMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListLikeInboxMessagesRequest) GetReadFilter() InboxMessageReadFilter {
+	if x != nil {
+		return x.ReadFilter
+	}
+	return InboxMessageReadFilter_INBOX_MESSAGE_READ_FILTER_UNSPECIFIED
+}
+
+func (x *ListLikeInboxMessagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListLikeInboxMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*LikeInboxMessage    `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLikeInboxMessagesResponse) Reset() {
+	*x = ListLikeInboxMessagesResponse{}
+	mi := &file_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLikeInboxMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLikeInboxMessagesResponse) ProtoMessage() {}
+
+func (x *ListLikeInboxMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLikeInboxMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListLikeInboxMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListLikeInboxMessagesResponse) GetMessages() []*LikeInboxMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListLikeInboxMessagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListSystemInboxMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReadFilter    InboxMessageReadFilter `protobuf:"varint,1,opt,name=read_filter,json=readFilter,proto3,enum=message.InboxMessageReadFilter" json:"read_filter,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSystemInboxMessagesRequest) Reset() {
+	*x = ListSystemInboxMessagesRequest{}
+	mi := &file_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSystemInboxMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSystemInboxMessagesRequest) ProtoMessage() {}
+
+func (x *ListSystemInboxMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSystemInboxMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListSystemInboxMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSystemInboxMessagesRequest) GetReadFilter() InboxMessageReadFilter {
+	if x != nil {
+		return x.ReadFilter
+	}
+	return InboxMessageReadFilter_INBOX_MESSAGE_READ_FILTER_UNSPECIFIED
+}
+
+func (x *ListSystemInboxMessagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSystemInboxMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*SystemInboxMessage  `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSystemInboxMessagesResponse) Reset() {
+	*x = ListSystemInboxMessagesResponse{}
+	mi := &file_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSystemInboxMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSystemInboxMessagesResponse) ProtoMessage() {}
+
+func (x *ListSystemInboxMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSystemInboxMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListSystemInboxMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListSystemInboxMessagesResponse) GetMessages() []*SystemInboxMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListSystemInboxMessagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type DeleteInboxMessageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
@@ -525,7 +1031,7 @@ type DeleteInboxMessageRequest struct {
 
 func (x *DeleteInboxMessageRequest) Reset() {
 	*x = DeleteInboxMessageRequest{}
-	mi := &file_message_proto_msgTypes[7]
+	mi := &file_message_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -537,7 +1043,7 @@ func (x *DeleteInboxMessageRequest) String() string {
 func (*DeleteInboxMessageRequest) ProtoMessage() {}
 
 func (x *DeleteInboxMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[7]
+	mi := &file_message_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +1056,7 @@ func (x *DeleteInboxMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteInboxMessageRequest.ProtoReflect.Descriptor instead.
 func (*DeleteInboxMessageRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{7}
+	return file_message_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteInboxMessageRequest) GetUid() string {
@@ -569,7 +1075,7 @@ type MarkAllInboxMessagesReadResponse struct {
 
 func (x *MarkAllInboxMessagesReadResponse) Reset() {
 	*x = MarkAllInboxMessagesReadResponse{}
-	mi := &file_message_proto_msgTypes[8]
+	mi := &file_message_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -581,7 +1087,7 @@ func (x *MarkAllInboxMessagesReadResponse) String() string {
 func (*MarkAllInboxMessagesReadResponse) ProtoMessage() {}
 
 func (x *MarkAllInboxMessagesReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[8]
+	mi := &file_message_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +1100,7 @@ func (x *MarkAllInboxMessagesReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkAllInboxMessagesReadResponse.ProtoReflect.Descriptor instead.
 func (*MarkAllInboxMessagesReadResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{8}
+	return file_message_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MarkAllInboxMessagesReadResponse) GetUpdatedCount() int32 {
@@ -609,13 +1115,15 @@ type CountUnreadInboxMessagesResponse struct {
 	UnreadCount        int32                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
 	FollowUnreadCount  int32                  `protobuf:"varint,2,opt,name=follow_unread_count,json=followUnreadCount,proto3" json:"follow_unread_count,omitempty"`
 	CommentUnreadCount int32                  `protobuf:"varint,3,opt,name=comment_unread_count,json=commentUnreadCount,proto3" json:"comment_unread_count,omitempty"`
+	LikeUnreadCount    int32                  `protobuf:"varint,4,opt,name=like_unread_count,json=likeUnreadCount,proto3" json:"like_unread_count,omitempty"`
+	SystemUnreadCount  int32                  `protobuf:"varint,5,opt,name=system_unread_count,json=systemUnreadCount,proto3" json:"system_unread_count,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CountUnreadInboxMessagesResponse) Reset() {
 	*x = CountUnreadInboxMessagesResponse{}
-	mi := &file_message_proto_msgTypes[9]
+	mi := &file_message_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -627,7 +1135,7 @@ func (x *CountUnreadInboxMessagesResponse) String() string {
 func (*CountUnreadInboxMessagesResponse) ProtoMessage() {}
 
 func (x *CountUnreadInboxMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[9]
+	mi := &file_message_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +1148,7 @@ func (x *CountUnreadInboxMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountUnreadInboxMessagesResponse.ProtoReflect.Descriptor instead.
 func (*CountUnreadInboxMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{9}
+	return file_message_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CountUnreadInboxMessagesResponse) GetUnreadCount() int32 {
@@ -664,16 +1172,31 @@ func (x *CountUnreadInboxMessagesResponse) GetCommentUnreadCount() int32 {
 	return 0
 }
 
+func (x *CountUnreadInboxMessagesResponse) GetLikeUnreadCount() int32 {
+	if x != nil {
+		return x.LikeUnreadCount
+	}
+	return 0
+}
+
+func (x *CountUnreadInboxMessagesResponse) GetSystemUnreadCount() int32 {
+	if x != nil {
+		return x.SystemUnreadCount
+	}
+	return 0
+}
+
 var File_message_proto protoreflect.FileDescriptor
 
 const file_message_proto_rawDesc = "" +
 	"\n" +
-	"\rmessage.proto\x12\amessage\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\"o\n" +
+	"\rmessage.proto\x12\amessage\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\ffollow.proto\x1a\n" +
+	"post.proto\"o\n" +
 	"\x11InboxMessageActor\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1f\n" +
 	"\bnickname\x18\x02 \x01(\tB\x03\xe0A\x02R\bnickname\x12\"\n" +
 	"\n" +
-	"avatar_url\x18\x03 \x01(\tB\x03\xe0A\x02R\tavatarUrl\"\xd0\x02\n" +
+	"avatar_url\x18\x03 \x01(\tB\x03\xe0A\x02R\tavatarUrl\"\xba\x03\n" +
 	"\x13CommentInboxMessage\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1c\n" +
 	"\ais_read\x18\x02 \x01(\bB\x03\xe0A\x02R\x06isRead\x125\n" +
@@ -686,13 +1209,35 @@ const file_message_proto_rawDesc = "" +
 	"\bpost_uid\x18\a \x01(\tR\apostUid\x12\x1d\n" +
 	"\n" +
 	"parent_uid\x18\b \x01(\tR\tparentUid\x12%\n" +
-	"\x0eparent_content\x18\t \x01(\tR\rparentContent\"\xa4\x01\n" +
+	"\x0eparent_content\x18\t \x01(\tR\rparentContent\x12%\n" +
+	"\x0ecomment_images\x18\n" +
+	" \x03(\tR\rcommentImages\x12A\n" +
+	"\x13comment_attachments\x18\v \x03(\v2\x10.post.AttachmentR\x12commentAttachments\"\xa4\x01\n" +
 	"\x12FollowInboxMessage\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1c\n" +
 	"\ais_read\x18\x02 \x01(\bB\x03\xe0A\x02R\x06isRead\x125\n" +
 	"\x05actor\x18\x03 \x01(\v2\x1a.message.InboxMessageActorB\x03\xe0A\x02R\x05actor\x12\"\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\x03B\x03\xe0A\x02R\tcreatedAt\"\x82\x01\n" +
+	"created_at\x18\x04 \x01(\x03B\x03\xe0A\x02R\tcreatedAt\"\xdc\x02\n" +
+	"\x10LikeInboxMessage\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1c\n" +
+	"\ais_read\x18\x02 \x01(\bB\x03\xe0A\x02R\x06isRead\x125\n" +
+	"\x05actor\x18\x03 \x01(\v2\x1a.message.InboxMessageActorB\x03\xe0A\x02R\x05actor\x12\"\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x126\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x1d.message.LikeInboxMessageTypeB\x03\xe0A\x02R\x04type\x12\x19\n" +
+	"\bpost_uid\x18\x06 \x01(\tR\apostUid\x12\x1b\n" +
+	"\tpost_text\x18\a \x01(\tR\bpostText\x12\x1f\n" +
+	"\vcomment_uid\x18\b \x01(\tR\n" +
+	"commentUid\x12'\n" +
+	"\x0fcomment_content\x18\t \x01(\tR\x0ecommentContent\"\xe2\x01\n" +
+	"\x12SystemInboxMessage\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1c\n" +
+	"\ais_read\x18\x02 \x01(\bB\x03\xe0A\x02R\x06isRead\x12\"\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x128\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1f.message.SystemInboxMessageTypeB\x03\xe0A\x02R\x04type\x129\n" +
+	"\rfollow_import\x18\x05 \x01(\v2\x14.follow.FollowImportR\ffollowImport\"\x82\x01\n" +
 	"\x1fListCommentInboxMessagesRequest\x12@\n" +
 	"\vread_filter\x18\x01 \x01(\x0e2\x1f.message.InboxMessageReadFilterR\n" +
 	"readFilter\x12\x1d\n" +
@@ -708,22 +1253,50 @@ const file_message_proto_rawDesc = "" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\"\x8c\x01\n" +
 	"\x1fListFollowInboxMessagesResponse\x12<\n" +
 	"\bmessages\x18\x01 \x03(\v2\x1b.message.FollowInboxMessageB\x03\xe0A\x02R\bmessages\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"\x7f\n" +
+	"\x1cListLikeInboxMessagesRequest\x12@\n" +
+	"\vread_filter\x18\x01 \x01(\x0e2\x1f.message.InboxMessageReadFilterR\n" +
+	"readFilter\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x88\x01\n" +
+	"\x1dListLikeInboxMessagesResponse\x12:\n" +
+	"\bmessages\x18\x01 \x03(\v2\x19.message.LikeInboxMessageB\x03\xe0A\x02R\bmessages\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"\x81\x01\n" +
+	"\x1eListSystemInboxMessagesRequest\x12@\n" +
+	"\vread_filter\x18\x01 \x01(\x0e2\x1f.message.InboxMessageReadFilterR\n" +
+	"readFilter\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x8c\x01\n" +
+	"\x1fListSystemInboxMessagesResponse\x12<\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1b.message.SystemInboxMessageB\x03\xe0A\x02R\bmessages\x12+\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"2\n" +
 	"\x19DeleteInboxMessageRequest\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"L\n" +
 	" MarkAllInboxMessagesReadResponse\x12(\n" +
-	"\rupdated_count\x18\x01 \x01(\x05B\x03\xe0A\x02R\fupdatedCount\"\xb6\x01\n" +
+	"\rupdated_count\x18\x01 \x01(\x05B\x03\xe0A\x02R\fupdatedCount\"\x9c\x02\n" +
 	" CountUnreadInboxMessagesResponse\x12&\n" +
 	"\funread_count\x18\x01 \x01(\x05B\x03\xe0A\x02R\vunreadCount\x123\n" +
 	"\x13follow_unread_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\x11followUnreadCount\x125\n" +
-	"\x14comment_unread_count\x18\x03 \x01(\x05B\x03\xe0A\x02R\x12commentUnreadCount*\x8d\x01\n" +
+	"\x14comment_unread_count\x18\x03 \x01(\x05B\x03\xe0A\x02R\x12commentUnreadCount\x12/\n" +
+	"\x11like_unread_count\x18\x04 \x01(\x05B\x03\xe0A\x02R\x0flikeUnreadCount\x123\n" +
+	"\x13system_unread_count\x18\x05 \x01(\x05B\x03\xe0A\x02R\x11systemUnreadCount*\xba\x01\n" +
+	"\x14LikeInboxMessageType\x12'\n" +
+	"#LIKE_INBOX_MESSAGE_TYPE_UNSPECIFIED\x10\x00\x12%\n" +
+	"!LIKE_INBOX_MESSAGE_TYPE_LIKE_POST\x10\x01\x12(\n" +
+	"$LIKE_INBOX_MESSAGE_TYPE_COLLECT_POST\x10\x02\x12(\n" +
+	"$LIKE_INBOX_MESSAGE_TYPE_LIKE_COMMENT\x10\x03*p\n" +
+	"\x16SystemInboxMessageType\x12)\n" +
+	"%SYSTEM_INBOX_MESSAGE_TYPE_UNSPECIFIED\x10\x00\x12+\n" +
+	"'SYSTEM_INBOX_MESSAGE_TYPE_FOLLOW_IMPORT\x10\x01*\x8d\x01\n" +
 	"\x16InboxMessageReadFilter\x12)\n" +
 	"%INBOX_MESSAGE_READ_FILTER_UNSPECIFIED\x10\x00\x12$\n" +
 	" INBOX_MESSAGE_READ_FILTER_UNREAD\x10\x01\x12\"\n" +
-	"\x1eINBOX_MESSAGE_READ_FILTER_READ\x10\x022\xdb\x05\n" +
+	"\x1eINBOX_MESSAGE_READ_FILTER_READ\x10\x022\x86\b\n" +
 	"\x0eMessageService\x12\x9b\x01\n" +
 	"\x18ListCommentInboxMessages\x12(.message.ListCommentInboxMessagesRequest\x1a).message.ListCommentInboxMessagesResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/me/inbox/messages/comments\x12\x97\x01\n" +
-	"\x17ListFollowInboxMessages\x12'.message.ListFollowInboxMessagesRequest\x1a(.message.ListFollowInboxMessagesResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/me/inbox/messages/follows\x12y\n" +
+	"\x17ListFollowInboxMessages\x12'.message.ListFollowInboxMessagesRequest\x1a(.message.ListFollowInboxMessagesResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/me/inbox/messages/follows\x12\x8f\x01\n" +
+	"\x15ListLikeInboxMessages\x12%.message.ListLikeInboxMessagesRequest\x1a&.message.ListLikeInboxMessagesResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/me/inbox/messages/likes\x12\x96\x01\n" +
+	"\x17ListSystemInboxMessages\x12'.message.ListSystemInboxMessagesRequest\x1a(.message.ListSystemInboxMessagesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/me/inbox/messages/system\x12y\n" +
 	"\x12DeleteInboxMessage\x12\".message.DeleteInboxMessageRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!*\x1f/api/v1/me/inbox/messages/{uid}\x12\x85\x01\n" +
 	"\x18MarkAllInboxMessagesRead\x12\x16.google.protobuf.Empty\x1a).message.MarkAllInboxMessagesReadResponse\"&\x82\xd3\xe4\x93\x02 2\x1e/api/v1/me/inbox/messages/read\x12\x8d\x01\n" +
 	"\x18CountUnreadInboxMessages\x12\x16.google.protobuf.Empty\x1a).message.CountUnreadInboxMessagesResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/me/inbox/messages/unread/countB\x0fZ\raeibi/api;apib\x06proto3"
@@ -740,44 +1313,67 @@ func file_message_proto_rawDescGZIP() []byte {
 	return file_message_proto_rawDescData
 }
 
-var file_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_message_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_message_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_message_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_message_proto_goTypes = []any{
-	(InboxMessageReadFilter)(0),              // 0: message.InboxMessageReadFilter
-	(*InboxMessageActor)(nil),                // 1: message.InboxMessageActor
-	(*CommentInboxMessage)(nil),              // 2: message.CommentInboxMessage
-	(*FollowInboxMessage)(nil),               // 3: message.FollowInboxMessage
-	(*ListCommentInboxMessagesRequest)(nil),  // 4: message.ListCommentInboxMessagesRequest
-	(*ListCommentInboxMessagesResponse)(nil), // 5: message.ListCommentInboxMessagesResponse
-	(*ListFollowInboxMessagesRequest)(nil),   // 6: message.ListFollowInboxMessagesRequest
-	(*ListFollowInboxMessagesResponse)(nil),  // 7: message.ListFollowInboxMessagesResponse
-	(*DeleteInboxMessageRequest)(nil),        // 8: message.DeleteInboxMessageRequest
-	(*MarkAllInboxMessagesReadResponse)(nil), // 9: message.MarkAllInboxMessagesReadResponse
-	(*CountUnreadInboxMessagesResponse)(nil), // 10: message.CountUnreadInboxMessagesResponse
-	(*emptypb.Empty)(nil),                    // 11: google.protobuf.Empty
+	(LikeInboxMessageType)(0),                // 0: message.LikeInboxMessageType
+	(SystemInboxMessageType)(0),              // 1: message.SystemInboxMessageType
+	(InboxMessageReadFilter)(0),              // 2: message.InboxMessageReadFilter
+	(*InboxMessageActor)(nil),                // 3: message.InboxMessageActor
+	(*CommentInboxMessage)(nil),              // 4: message.CommentInboxMessage
+	(*FollowInboxMessage)(nil),               // 5: message.FollowInboxMessage
+	(*LikeInboxMessage)(nil),                 // 6: message.LikeInboxMessage
+	(*SystemInboxMessage)(nil),               // 7: message.SystemInboxMessage
+	(*ListCommentInboxMessagesRequest)(nil),  // 8: message.ListCommentInboxMessagesRequest
+	(*ListCommentInboxMessagesResponse)(nil), // 9: message.ListCommentInboxMessagesResponse
+	(*ListFollowInboxMessagesRequest)(nil),   // 10: message.ListFollowInboxMessagesRequest
+	(*ListFollowInboxMessagesResponse)(nil),  // 11: message.ListFollowInboxMessagesResponse
+	(*ListLikeInboxMessagesRequest)(nil),     // 12: message.ListLikeInboxMessagesRequest
+	(*ListLikeInboxMessagesResponse)(nil),    // 13: message.ListLikeInboxMessagesResponse
+	(*ListSystemInboxMessagesRequest)(nil),   // 14: message.ListSystemInboxMessagesRequest
+	(*ListSystemInboxMessagesResponse)(nil),  // 15: message.ListSystemInboxMessagesResponse
+	(*DeleteInboxMessageRequest)(nil),        // 16: message.DeleteInboxMessageRequest
+	(*MarkAllInboxMessagesReadResponse)(nil), // 17: message.MarkAllInboxMessagesReadResponse
+	(*CountUnreadInboxMessagesResponse)(nil), // 18: message.CountUnreadInboxMessagesResponse
+	(*Attachment)(nil),                       // 19: post.Attachment
+	(*FollowImport)(nil),                     // 20: follow.FollowImport
+	(*emptypb.Empty)(nil),                    // 21: google.protobuf.Empty
 }
 var file_message_proto_depIdxs = []int32{
-	1,  // 0: message.CommentInboxMessage.actor:type_name -> message.InboxMessageActor
-	1,  // 1: message.FollowInboxMessage.actor:type_name -> message.InboxMessageActor
-	0,  // 2: message.ListCommentInboxMessagesRequest.read_filter:type_name -> message.InboxMessageReadFilter
-	2,  // 3: message.ListCommentInboxMessagesResponse.messages:type_name -> message.CommentInboxMessage
-	0,  // 4: message.ListFollowInboxMessagesRequest.read_filter:type_name -> message.InboxMessageReadFilter
-	3,  // 5: message.ListFollowInboxMessagesResponse.messages:type_name -> message.FollowInboxMessage
-	4,  // 6: message.MessageService.ListCommentInboxMessages:input_type -> message.ListCommentInboxMessagesRequest
-	6,  // 7: message.MessageService.ListFollowInboxMessages:input_type -> message.ListFollowInboxMessagesRequest
-	8,  // 8: message.MessageService.DeleteInboxMessage:input_type -> message.DeleteInboxMessageRequest
-	11, // 9: message.MessageService.MarkAllInboxMessagesRead:input_type -> google.protobuf.Empty
-	11, // 10: message.MessageService.CountUnreadInboxMessages:input_type -> google.protobuf.Empty
-	5,  // 11: message.MessageService.ListCommentInboxMessages:output_type -> message.ListCommentInboxMessagesResponse
-	7,  // 12: message.MessageService.ListFollowInboxMessages:output_type -> message.ListFollowInboxMessagesResponse
-	11, // 13: message.MessageService.DeleteInboxMessage:output_type -> google.protobuf.Empty
-	9,  // 14: message.MessageService.MarkAllInboxMessagesRead:output_type -> message.MarkAllInboxMessagesReadResponse
-	10, // 15: message.MessageService.CountUnreadInboxMessages:output_type -> message.CountUnreadInboxMessagesResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	3,  // 0: message.CommentInboxMessage.actor:type_name -> message.InboxMessageActor
+	19, // 1: message.CommentInboxMessage.comment_attachments:type_name -> post.Attachment
+	3,  // 2: message.FollowInboxMessage.actor:type_name -> message.InboxMessageActor
+	3,  // 3: message.LikeInboxMessage.actor:type_name -> message.InboxMessageActor
+	0,  // 4: message.LikeInboxMessage.type:type_name -> message.LikeInboxMessageType
+	1,  // 5: message.SystemInboxMessage.type:type_name -> message.SystemInboxMessageType
+	20, // 6: message.SystemInboxMessage.follow_import:type_name -> follow.FollowImport
+	2,  // 7: message.ListCommentInboxMessagesRequest.read_filter:type_name -> message.InboxMessageReadFilter
+	4,  // 8: message.ListCommentInboxMessagesResponse.messages:type_name -> message.CommentInboxMessage
+	2,  // 9: message.ListFollowInboxMessagesRequest.read_filter:type_name -> message.InboxMessageReadFilter
+	5,  // 10: message.ListFollowInboxMessagesResponse.messages:type_name -> message.FollowInboxMessage
+	2,  // 11: message.ListLikeInboxMessagesRequest.read_filter:type_name -> message.InboxMessageReadFilter
+	6,  // 12: message.ListLikeInboxMessagesResponse.messages:type_name -> message.LikeInboxMessage
+	2,  // 13: message.ListSystemInboxMessagesRequest.read_filter:type_name -> message.InboxMessageReadFilter
+	7,  // 14: message.ListSystemInboxMessagesResponse.messages:type_name -> message.SystemInboxMessage
+	8,  // 15: message.MessageService.ListCommentInboxMessages:input_type -> message.ListCommentInboxMessagesRequest
+	10, // 16: message.MessageService.ListFollowInboxMessages:input_type -> message.ListFollowInboxMessagesRequest
+	12, // 17: message.MessageService.ListLikeInboxMessages:input_type -> message.ListLikeInboxMessagesRequest
+	14, // 18: message.MessageService.ListSystemInboxMessages:input_type -> message.ListSystemInboxMessagesRequest
+	16, // 19: message.MessageService.DeleteInboxMessage:input_type -> message.DeleteInboxMessageRequest
+	21, // 20: message.MessageService.MarkAllInboxMessagesRead:input_type -> google.protobuf.Empty
+	21, // 21: message.MessageService.CountUnreadInboxMessages:input_type -> google.protobuf.Empty
+	9,  // 22: message.MessageService.ListCommentInboxMessages:output_type -> message.ListCommentInboxMessagesResponse
+	11, // 23: message.MessageService.ListFollowInboxMessages:output_type -> message.ListFollowInboxMessagesResponse
+	13, // 24: message.MessageService.ListLikeInboxMessages:output_type -> message.ListLikeInboxMessagesResponse
+	15, // 25: message.MessageService.ListSystemInboxMessages:output_type -> message.ListSystemInboxMessagesResponse
+	21, // 26: message.MessageService.DeleteInboxMessage:output_type -> google.protobuf.Empty
+	17, // 27: message.MessageService.MarkAllInboxMessagesRead:output_type -> message.MarkAllInboxMessagesReadResponse
+	18, // 28: message.MessageService.CountUnreadInboxMessages:output_type -> message.CountUnreadInboxMessagesResponse
+	22, // [22:29] is the sub-list for method output_type
+	15, // [15:22] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_message_proto_init() }
@@ -785,13 +1381,15 @@ func file_message_proto_init() {
 	if File_message_proto != nil {
 		return
 	}
+	file_follow_proto_init()
+	file_post_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_message_proto_rawDesc), len(file_message_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,8 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	MessageService_ListCommentInboxMessages_FullMethodName = "/message.MessageService/ListCommentInboxMessages"
 	MessageService_ListFollowInboxMessages_FullMethodName  = "/message.MessageService/ListFollowInboxMessages"
+	MessageService_ListLikeInboxMessages_FullMethodName    = "/message.MessageService/ListLikeInboxMessages"
+	MessageService_ListSystemInboxMessages_FullMethodName  = "/message.MessageService/ListSystemInboxMessages"
 	MessageService_DeleteInboxMessage_FullMethodName       = "/message.MessageService/DeleteInboxMessage"
 	MessageService_MarkAllInboxMessagesRead_FullMethodName = "/message.MessageService/MarkAllInboxMessagesRead"
 	MessageService_CountUnreadInboxMessages_FullMethodName = "/message.MessageService/CountUnreadInboxMessages"
@@ -37,6 +39,10 @@ type MessageServiceClient interface {
 	ListCommentInboxMessages(ctx context.Context, in *ListCommentInboxMessagesRequest, opts ...grpc.CallOption) (*ListCommentInboxMessagesResponse, error)
 	// GET /api/v1/me/inbox/messages/follows 当前用户关注消息列表
 	ListFollowInboxMessages(ctx context.Context, in *ListFollowInboxMessagesRequest, opts ...grpc.CallOption) (*ListFollowInboxMessagesResponse, error)
+	// GET /api/v1/me/inbox/messages/likes 当前用户点赞/收藏消息列表
+	ListLikeInboxMessages(ctx context.Context, in *ListLikeInboxMessagesRequest, opts ...grpc.CallOption) (*ListLikeInboxMessagesResponse, error)
+	// GET /api/v1/me/inbox/messages/system 当前用户系统消息列表
+	ListSystemInboxMessages(ctx context.Context, in *ListSystemInboxMessagesRequest, opts ...grpc.CallOption) (*ListSystemInboxMessagesResponse, error)
 	// DELETE /api/v1/me/inbox/messages/{uid} 归档一条消息
 	DeleteInboxMessage(ctx context.Context, in *DeleteInboxMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// PATCH /api/v1/me/inbox/messages/read 全部标记为已读
@@ -73,6 +79,26 @@ func (c *messageServiceClient) ListFollowInboxMessages(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *messageServiceClient) ListLikeInboxMessages(ctx context.Context, in *ListLikeInboxMessagesRequest, opts ...grpc.CallOption) (*ListLikeInboxMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLikeInboxMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_ListLikeInboxMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ListSystemInboxMessages(ctx context.Context, in *ListSystemInboxMessagesRequest, opts ...grpc.CallOption) (*ListSystemInboxMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSystemInboxMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_ListSystemInboxMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *messageServiceClient) DeleteInboxMessage(ctx context.Context, in *DeleteInboxMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -113,6 +139,10 @@ type MessageServiceServer interface {
 	ListCommentInboxMessages(context.Context, *ListCommentInboxMessagesRequest) (*ListCommentInboxMessagesResponse, error)
 	// GET /api/v1/me/inbox/messages/follows 当前用户关注消息列表
 	ListFollowInboxMessages(context.Context, *ListFollowInboxMessagesRequest) (*ListFollowInboxMessagesResponse, error)
+	// GET /api/v1/me/inbox/messages/likes 当前用户点赞/收藏消息列表
+	ListLikeInboxMessages(context.Context, *ListLikeInboxMessagesRequest) (*ListLikeInboxMessagesResponse, error)
+	// GET /api/v1/me/inbox/messages/system 当前用户系统消息列表
+	ListSystemInboxMessages(context.Context, *ListSystemInboxMessagesRequest) (*ListSystemInboxMessagesResponse, error)
 	// DELETE /api/v1/me/inbox/messages/{uid} 归档一条消息
 	DeleteInboxMessage(context.Context, *DeleteInboxMessageRequest) (*emptypb.Empty, error)
 	// PATCH /api/v1/me/inbox/messages/read 全部标记为已读
@@ -135,6 +165,12 @@ func (UnimplementedMessageServiceServer) ListCommentInboxMessages(context.Contex
 func (UnimplementedMessageServiceServer) ListFollowInboxMessages(context.Context, *ListFollowInboxMessagesRequest) (*ListFollowInboxMessagesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListFollowInboxMessages not implemented")
 }
+func (UnimplementedMessageServiceServer) ListLikeInboxMessages(context.Context, *ListLikeInboxMessagesRequest) (*ListLikeInboxMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLikeInboxMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) ListSystemInboxMessages(context.Context, *ListSystemInboxMessagesRequest) (*ListSystemInboxMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSystemInboxMessages not implemented")
+}
 func (UnimplementedMessageServiceServer) DeleteInboxMessage(context.Context, *DeleteInboxMessageRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteInboxMessage not implemented")
 }
@@ -201,6 +237,42 @@ func _MessageService_ListFollowInboxMessages_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_ListLikeInboxMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLikeInboxMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ListLikeInboxMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ListLikeInboxMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ListLikeInboxMessages(ctx, req.(*ListLikeInboxMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ListSystemInboxMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSystemInboxMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ListSystemInboxMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ListSystemInboxMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ListSystemInboxMessages(ctx, req.(*ListSystemInboxMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MessageService_DeleteInboxMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteInboxMessageRequest)
 	if err := dec(in); err != nil {
@@ -270,6 +342,14 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListFollowInboxMessages",
 			Handler:    _MessageService_ListFollowInboxMessages_Handler,
 		},
+		{
+			MethodName: "ListLikeInboxMessages",
+			Handler:    _MessageService_ListLikeInboxMessages_Handler,
+		},
+		{
+			MethodName: "ListSystemInboxMessages",
+			Handler:    _MessageService_ListSystemInboxMessages_Handler,
+		},
 		{
 			MethodName: "DeleteInboxMessage",
 			Handler:    _MessageService_DeleteInboxMessage_Handler,
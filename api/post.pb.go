@@ -24,6 +24,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TagTrendWindow int32
+
+const (
+	TagTrendWindow_TAG_TREND_WINDOW_UNSPECIFIED TagTrendWindow = 0 // defaults to 24h
+	TagTrendWindow_TAG_TREND_WINDOW_1H          TagTrendWindow = 1
+	TagTrendWindow_TAG_TREND_WINDOW_24H         TagTrendWindow = 2
+	TagTrendWindow_TAG_TREND_WINDOW_7D          TagTrendWindow = 3
+)
+
+// Enum value maps for TagTrendWindow.
+var (
+	TagTrendWindow_name = map[int32]string{
+		0: "TAG_TREND_WINDOW_UNSPECIFIED",
+		1: "TAG_TREND_WINDOW_1H",
+		2: "TAG_TREND_WINDOW_24H",
+		3: "TAG_TREND_WINDOW_7D",
+	}
+	TagTrendWindow_value = map[string]int32{
+		"TAG_TREND_WINDOW_UNSPECIFIED": 0,
+		"TAG_TREND_WINDOW_1H":          1,
+		"TAG_TREND_WINDOW_24H":         2,
+		"TAG_TREND_WINDOW_7D":          3,
+	}
+)
+
+func (x TagTrendWindow) Enum() *TagTrendWindow {
+	p := new(TagTrendWindow)
+	*p = x
+	return p
+}
+
+func (x TagTrendWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagTrendWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[0].Descriptor()
+}
+
+func (TagTrendWindow) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[0]
+}
+
+func (x TagTrendWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagTrendWindow.Descriptor instead.
+func (TagTrendWindow) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{0}
+}
+
 // Models
 type PostAuthor struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -31,6 +83,7 @@ type PostAuthor struct {
 	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
 	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
 	IsFollowing   bool                   `protobuf:"varint,4,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
+	FollowsYou    bool                   `protobuf:"varint,5,opt,name=follows_you,json=followsYou,proto3" json:"follows_you,omitempty"` // the author follows the viewer
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -93,6 +146,13 @@ func (x *PostAuthor) GetIsFollowing() bool {
 	return false
 }
 
+func (x *PostAuthor) GetFollowsYou() bool {
+	if x != nil {
+		return x.FollowsYou
+	}
+	return false
+}
+
 type Attachment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
@@ -182,12 +242,19 @@ type Post struct {
 	LikeCount       int32                  `protobuf:"varint,9,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
 	Visibility      string                 `protobuf:"bytes,10,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	LatestRepliedOn int64                  `protobuf:"varint,11,opt,name=latest_replied_on,json=latestRepliedOn,proto3" json:"latest_replied_on,omitempty"`
-	Ip              string                 `protobuf:"bytes,12,opt,name=ip,proto3" json:"ip,omitempty"`
+	Ip              string                 `protobuf:"bytes,12,opt,name=ip,proto3" json:"ip,omitempty"` // raw client IP, only returned to admins
 	Pinned          bool                   `protobuf:"varint,13,opt,name=pinned,proto3" json:"pinned,omitempty"`
 	Liked           bool                   `protobuf:"varint,14,opt,name=liked,proto3" json:"liked,omitempty"`
 	Collected       bool                   `protobuf:"varint,15,opt,name=collected,proto3" json:"collected,omitempty"`
 	CreatedAt       int64                  `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       int64                  `protobuf:"varint,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ContentWarning  string                 `protobuf:"bytes,18,opt,name=content_warning,json=contentWarning,proto3" json:"content_warning,omitempty"` // empty when none
+	SensitiveMedia  bool                   `protobuf:"varint,19,opt,name=sensitive_media,json=sensitiveMedia,proto3" json:"sensitive_media,omitempty"`
+	LinkPreview     *LinkPreview           `protobuf:"bytes,20,opt,name=link_preview,json=linkPreview,proto3" json:"link_preview,omitempty"` // preview card for the first URL in text, when available
+	ViewCount       int64                  `protobuf:"varint,21,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	IpRegion        string                 `protobuf:"bytes,22,opt,name=ip_region,json=ipRegion,proto3" json:"ip_region,omitempty"`                // coarse GeoIP region, empty when unknown
+	Reactions       []*Reaction            `protobuf:"bytes,23,rep,name=reactions,proto3" json:"reactions,omitempty"`                              // in configured order, zero counts omitted
+	CommentPolicy   string                 `protobuf:"bytes,24,opt,name=comment_policy,json=commentPolicy,proto3" json:"comment_policy,omitempty"` // "EVERYONE", "FOLLOWERS" or "LOCKED"
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -341,21 +408,149 @@ func (x *Post) GetUpdatedAt() int64 {
 	return 0
 }
 
-type CreatePostRequest struct {
+func (x *Post) GetContentWarning() string {
+	if x != nil {
+		return x.ContentWarning
+	}
+	return ""
+}
+
+func (x *Post) GetSensitiveMedia() bool {
+	if x != nil {
+		return x.SensitiveMedia
+	}
+	return false
+}
+
+func (x *Post) GetLinkPreview() *LinkPreview {
+	if x != nil {
+		return x.LinkPreview
+	}
+	return nil
+}
+
+func (x *Post) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *Post) GetIpRegion() string {
+	if x != nil {
+		return x.IpRegion
+	}
+	return ""
+}
+
+func (x *Post) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Post) GetCommentPolicy() string {
+	if x != nil {
+		return x.CommentPolicy
+	}
+	return ""
+}
+
+type LinkPreview struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Images        []string               `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
-	Attachments   []string               `protobuf:"bytes,3,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Visibility    string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
-	Pinned        bool                   `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	SiteName      string                 `protobuf:"bytes,4,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"` // stored in OSS, empty when none
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
+	mi := &file_post_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkPreview) ProtoMessage() {}
+
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LinkPreview) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+type CreatePostRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Text           string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Images         []string               `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
+	Attachments    []string               `protobuf:"bytes,3,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Tags           []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Visibility     string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Pinned         bool                   `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	ContentWarning string                 `protobuf:"bytes,7,opt,name=content_warning,json=contentWarning,proto3" json:"content_warning,omitempty"`
+	SensitiveMedia bool                   `protobuf:"varint,8,opt,name=sensitive_media,json=sensitiveMedia,proto3" json:"sensitive_media,omitempty"`
+	CommentPolicy  string                 `protobuf:"bytes,9,opt,name=comment_policy,json=commentPolicy,proto3" json:"comment_policy,omitempty"` // "EVERYONE" (default), "FOLLOWERS" or "LOCKED"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *CreatePostRequest) Reset() {
 	*x = CreatePostRequest{}
-	mi := &file_post_proto_msgTypes[3]
+	mi := &file_post_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -367,7 +562,7 @@ func (x *CreatePostRequest) String() string {
 func (*CreatePostRequest) ProtoMessage() {}
 
 func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[3]
+	mi := &file_post_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,7 +575,7 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
 func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{3}
+	return file_post_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreatePostRequest) GetText() string {
@@ -425,6 +620,27 @@ func (x *CreatePostRequest) GetPinned() bool {
 	return false
 }
 
+func (x *CreatePostRequest) GetContentWarning() string {
+	if x != nil {
+		return x.ContentWarning
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetSensitiveMedia() bool {
+	if x != nil {
+		return x.SensitiveMedia
+	}
+	return false
+}
+
+func (x *CreatePostRequest) GetCommentPolicy() string {
+	if x != nil {
+		return x.CommentPolicy
+	}
+	return ""
+}
+
 type CreatePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
@@ -434,7 +650,7 @@ type CreatePostResponse struct {
 
 func (x *CreatePostResponse) Reset() {
 	*x = CreatePostResponse{}
-	mi := &file_post_proto_msgTypes[4]
+	mi := &file_post_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -446,7 +662,7 @@ func (x *CreatePostResponse) String() string {
 func (*CreatePostResponse) ProtoMessage() {}
 
 func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[4]
+	mi := &file_post_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +675,7 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
 func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{4}
+	return file_post_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreatePostResponse) GetUid() string {
@@ -475,13 +691,14 @@ type ListPostsRequest struct {
 	AuthorUid     string                 `protobuf:"bytes,2,opt,name=author_uid,json=authorUid,proto3" json:"author_uid,omitempty"`
 	TagName       string                 `protobuf:"bytes,3,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
 	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Sort          string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"` // "latest" (default), "active", "hot"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListPostsRequest) Reset() {
 	*x = ListPostsRequest{}
-	mi := &file_post_proto_msgTypes[5]
+	mi := &file_post_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -493,7 +710,7 @@ func (x *ListPostsRequest) String() string {
 func (*ListPostsRequest) ProtoMessage() {}
 
 func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[5]
+	mi := &file_post_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,7 +723,7 @@ func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPostsRequest.ProtoReflect.Descriptor instead.
 func (*ListPostsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{5}
+	return file_post_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListPostsRequest) GetQuery() string {
@@ -537,19 +754,27 @@ func (x *ListPostsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListPostsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
 type SearchPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	AuthorUid     string                 `protobuf:"bytes,2,opt,name=author_uid,json=authorUid,proto3" json:"author_uid,omitempty"`
 	TagName       string                 `protobuf:"bytes,3,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
 	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Sort          string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"` // "" (relevance), "latest", "active", "hot", "views"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchPostsRequest) Reset() {
 	*x = SearchPostsRequest{}
-	mi := &file_post_proto_msgTypes[6]
+	mi := &file_post_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -561,7 +786,7 @@ func (x *SearchPostsRequest) String() string {
 func (*SearchPostsRequest) ProtoMessage() {}
 
 func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[6]
+	mi := &file_post_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +799,7 @@ func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
 func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{6}
+	return file_post_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SearchPostsRequest) GetQuery() string {
@@ -605,6 +830,13 @@ func (x *SearchPostsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *SearchPostsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
 type ListPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
@@ -615,7 +847,7 @@ type ListPostsResponse struct {
 
 func (x *ListPostsResponse) Reset() {
 	*x = ListPostsResponse{}
-	mi := &file_post_proto_msgTypes[7]
+	mi := &file_post_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -627,7 +859,7 @@ func (x *ListPostsResponse) String() string {
 func (*ListPostsResponse) ProtoMessage() {}
 
 func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[7]
+	mi := &file_post_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +872,7 @@ func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPostsResponse.ProtoReflect.Descriptor instead.
 func (*ListPostsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{7}
+	return file_post_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListPostsResponse) GetPosts() []*Post {
@@ -657,28 +889,29 @@ func (x *ListPostsResponse) GetNextPageToken() string {
 	return ""
 }
 
-type SearchTag struct {
+type ListMyCollectionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FolderUid     string                 `protobuf:"bytes,1,opt,name=folder_uid,json=folderUid,proto3" json:"folder_uid,omitempty"` // optional, defaults to every collected post
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchTag) Reset() {
-	*x = SearchTag{}
-	mi := &file_post_proto_msgTypes[8]
+func (x *ListMyCollectionsRequest) Reset() {
+	*x = ListMyCollectionsRequest{}
+	mi := &file_post_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchTag) String() string {
+func (x *ListMyCollectionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchTag) ProtoMessage() {}
+func (*ListMyCollectionsRequest) ProtoMessage() {}
 
-func (x *SearchTag) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[8]
+func (x *ListMyCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,40 +922,48 @@ func (x *SearchTag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchTag.ProtoReflect.Descriptor instead.
-func (*SearchTag) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListMyCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SearchTag) GetName() string {
+func (x *ListMyCollectionsRequest) GetFolderUid() string {
 	if x != nil {
-		return x.Name
+		return x.FolderUid
 	}
 	return ""
 }
 
-type SearchTagsRequest struct {
+func (x *ListMyCollectionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCollectionFolderPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchTagsRequest) Reset() {
-	*x = SearchTagsRequest{}
-	mi := &file_post_proto_msgTypes[9]
+func (x *ListCollectionFolderPostsRequest) Reset() {
+	*x = ListCollectionFolderPostsRequest{}
+	mi := &file_post_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchTagsRequest) String() string {
+func (x *ListCollectionFolderPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchTagsRequest) ProtoMessage() {}
+func (*ListCollectionFolderPostsRequest) ProtoMessage() {}
 
-func (x *SearchTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[9]
+func (x *ListCollectionFolderPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,40 +974,48 @@ func (x *SearchTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchTagsRequest.ProtoReflect.Descriptor instead.
-func (*SearchTagsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListCollectionFolderPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionFolderPostsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SearchTagsRequest) GetQuery() string {
+func (x *ListCollectionFolderPostsRequest) GetUid() string {
 	if x != nil {
-		return x.Query
+		return x.Uid
 	}
 	return ""
 }
 
-type SearchTagsResponse struct {
+func (x *ListCollectionFolderPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchTag struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []*SearchTag           `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PostCount     int32                  `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchTagsResponse) Reset() {
-	*x = SearchTagsResponse{}
-	mi := &file_post_proto_msgTypes[10]
+func (x *SearchTag) Reset() {
+	*x = SearchTag{}
+	mi := &file_post_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchTagsResponse) String() string {
+func (x *SearchTag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchTagsResponse) ProtoMessage() {}
+func (*SearchTag) ProtoMessage() {}
 
-func (x *SearchTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[10]
+func (x *SearchTag) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,40 +1026,47 @@ func (x *SearchTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchTagsResponse.ProtoReflect.Descriptor instead.
-func (*SearchTagsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SearchTag.ProtoReflect.Descriptor instead.
+func (*SearchTag) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SearchTagsResponse) GetTags() []*SearchTag {
+func (x *SearchTag) GetName() string {
 	if x != nil {
-		return x.Tags
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type SuggestTagsByPrefixRequest struct {
+func (x *SearchTag) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+type SearchTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuggestTagsByPrefixRequest) Reset() {
-	*x = SuggestTagsByPrefixRequest{}
-	mi := &file_post_proto_msgTypes[11]
+func (x *SearchTagsRequest) Reset() {
+	*x = SearchTagsRequest{}
+	mi := &file_post_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuggestTagsByPrefixRequest) String() string {
+func (x *SearchTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestTagsByPrefixRequest) ProtoMessage() {}
+func (*SearchTagsRequest) ProtoMessage() {}
 
-func (x *SuggestTagsByPrefixRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[11]
+func (x *SearchTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,40 +1077,40 @@ func (x *SuggestTagsByPrefixRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestTagsByPrefixRequest.ProtoReflect.Descriptor instead.
-func (*SuggestTagsByPrefixRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SearchTagsRequest.ProtoReflect.Descriptor instead.
+func (*SearchTagsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SuggestTagsByPrefixRequest) GetPrefix() string {
+func (x *SearchTagsRequest) GetQuery() string {
 	if x != nil {
-		return x.Prefix
+		return x.Query
 	}
 	return ""
 }
 
-type SuggestTagsByPrefixResponse struct {
+type SearchTagsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tags          []*SearchTag           `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuggestTagsByPrefixResponse) Reset() {
-	*x = SuggestTagsByPrefixResponse{}
-	mi := &file_post_proto_msgTypes[12]
+func (x *SearchTagsResponse) Reset() {
+	*x = SearchTagsResponse{}
+	mi := &file_post_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuggestTagsByPrefixResponse) String() string {
+func (x *SearchTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestTagsByPrefixResponse) ProtoMessage() {}
+func (*SearchTagsResponse) ProtoMessage() {}
 
-func (x *SuggestTagsByPrefixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[12]
+func (x *SearchTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -865,40 +1121,100 @@ func (x *SuggestTagsByPrefixResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestTagsByPrefixResponse.ProtoReflect.Descriptor instead.
-func (*SuggestTagsByPrefixResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SearchTagsResponse.ProtoReflect.Descriptor instead.
+func (*SearchTagsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SuggestTagsByPrefixResponse) GetTags() []*SearchTag {
+func (x *SearchTagsResponse) GetTags() []*SearchTag {
 	if x != nil {
 		return x.Tags
 	}
 	return nil
 }
 
-type GetPostRequest struct {
+type TrendingTag struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PostCount       int32                  `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	EngagementCount int32                  `protobuf:"varint,3,opt,name=engagement_count,json=engagementCount,proto3" json:"engagement_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TrendingTag) Reset() {
+	*x = TrendingTag{}
+	mi := &file_post_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingTag) ProtoMessage() {}
+
+func (x *TrendingTag) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingTag.ProtoReflect.Descriptor instead.
+func (*TrendingTag) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TrendingTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrendingTag) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *TrendingTag) GetEngagementCount() int32 {
+	if x != nil {
+		return x.EngagementCount
+	}
+	return 0
+}
+
+type ListTrendingTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Window        TagTrendWindow         `protobuf:"varint,1,opt,name=window,proto3,enum=post.TagTrendWindow" json:"window,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostRequest) Reset() {
-	*x = GetPostRequest{}
-	mi := &file_post_proto_msgTypes[13]
+func (x *ListTrendingTagsRequest) Reset() {
+	*x = ListTrendingTagsRequest{}
+	mi := &file_post_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostRequest) String() string {
+func (x *ListTrendingTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostRequest) ProtoMessage() {}
+func (*ListTrendingTagsRequest) ProtoMessage() {}
 
-func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[13]
+func (x *ListTrendingTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,40 +1225,1132 @@ func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
-func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListTrendingTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingTagsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetPostRequest) GetUid() string {
+func (x *ListTrendingTagsRequest) GetWindow() TagTrendWindow {
 	if x != nil {
-		return x.Uid
+		return x.Window
+	}
+	return TagTrendWindow_TAG_TREND_WINDOW_UNSPECIFIED
+}
+
+type ListTrendingTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TrendingTag         `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingTagsResponse) Reset() {
+	*x = ListTrendingTagsResponse{}
+	mi := &file_post_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingTagsResponse) ProtoMessage() {}
+
+func (x *ListTrendingTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrendingTagsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListTrendingTagsResponse) GetTags() []*TrendingTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PostCount     int32                  `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	FollowerCount int32                  `protobuf:"varint,3,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	IsFollowing   bool                   `protobuf:"varint,4,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_post_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type GetPostResponse struct {
+func (x *Tag) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *Tag) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *Tag) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+type GetTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagRequest) Reset() {
+	*x = GetTagRequest{}
+	mi := &file_post_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagRequest) ProtoMessage() {}
+
+func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagRequest.ProtoReflect.Descriptor instead.
+func (*GetTagRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagResponse) Reset() {
+	*x = GetTagResponse{}
+	mi := &file_post_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagResponse) ProtoMessage() {}
+
+func (x *GetTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagResponse.ProtoReflect.Descriptor instead.
+func (*GetTagResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type FollowTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowTagRequest) Reset() {
+	*x = FollowTagRequest{}
+	mi := &file_post_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowTagRequest) ProtoMessage() {}
+
+func (x *FollowTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowTagRequest.ProtoReflect.Descriptor instead.
+func (*FollowTagRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FollowTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FollowTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerCount int32                  `protobuf:"varint,1,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowTagResponse) Reset() {
+	*x = FollowTagResponse{}
+	mi := &file_post_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowTagResponse) ProtoMessage() {}
+
+func (x *FollowTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowTagResponse.ProtoReflect.Descriptor instead.
+func (*FollowTagResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FollowTagResponse) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+type UnfollowTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowTagRequest) Reset() {
+	*x = UnfollowTagRequest{}
+	mi := &file_post_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowTagRequest) ProtoMessage() {}
+
+func (x *UnfollowTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowTagRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowTagRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnfollowTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UnfollowTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerCount int32                  `protobuf:"varint,1,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowTagResponse) Reset() {
+	*x = UnfollowTagResponse{}
+	mi := &file_post_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowTagResponse) ProtoMessage() {}
+
+func (x *UnfollowTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowTagResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowTagResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UnfollowTagResponse) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+type ListMyFollowedTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageToken     string                 `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyFollowedTagsRequest) Reset() {
+	*x = ListMyFollowedTagsRequest{}
+	mi := &file_post_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyFollowedTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyFollowedTagsRequest) ProtoMessage() {}
+
+func (x *ListMyFollowedTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyFollowedTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyFollowedTagsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListMyFollowedTagsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMyFollowedTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyFollowedTagsResponse) Reset() {
+	*x = ListMyFollowedTagsResponse{}
+	mi := &file_post_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyFollowedTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyFollowedTagsResponse) ProtoMessage() {}
+
+func (x *ListMyFollowedTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyFollowedTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyFollowedTagsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListMyFollowedTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListMyFollowedTagsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListMyFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageToken     string                 `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyFeedRequest) Reset() {
+	*x = ListMyFeedRequest{}
+	mi := &file_post_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyFeedRequest) ProtoMessage() {}
+
+func (x *ListMyFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyFeedRequest.ProtoReflect.Descriptor instead.
+func (*ListMyFeedRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListMyFeedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SuggestTagsByPrefixRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTagsByPrefixRequest) Reset() {
+	*x = SuggestTagsByPrefixRequest{}
+	mi := &file_post_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagsByPrefixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagsByPrefixRequest) ProtoMessage() {}
+
+func (x *SuggestTagsByPrefixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagsByPrefixRequest.ProtoReflect.Descriptor instead.
+func (*SuggestTagsByPrefixRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SuggestTagsByPrefixRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type SuggestTagsByPrefixResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*SearchTag           `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTagsByPrefixResponse) Reset() {
+	*x = SuggestTagsByPrefixResponse{}
+	mi := &file_post_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagsByPrefixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagsByPrefixResponse) ProtoMessage() {}
+
+func (x *SuggestTagsByPrefixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagsByPrefixResponse.ProtoReflect.Descriptor instead.
+func (*SuggestTagsByPrefixResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SuggestTagsByPrefixResponse) GetTags() []*SearchTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostRequest) Reset() {
+	*x = GetPostRequest{}
+	mi := &file_post_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRequest) ProtoMessage() {}
+
+func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPostRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type GetPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostResponse) Reset() {
+	*x = GetPostResponse{}
+	mi := &file_post_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostResponse) ProtoMessage() {}
+
+func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
+func (*GetPostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type UpdatePostBody struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Text           string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Images         []string               `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
+	Attachments    []string               `protobuf:"bytes,3,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Tags           []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Visibility     string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Pinned         bool                   `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	ContentWarning string                 `protobuf:"bytes,7,opt,name=content_warning,json=contentWarning,proto3" json:"content_warning,omitempty"`
+	SensitiveMedia bool                   `protobuf:"varint,8,opt,name=sensitive_media,json=sensitiveMedia,proto3" json:"sensitive_media,omitempty"`
+	CommentPolicy  string                 `protobuf:"bytes,9,opt,name=comment_policy,json=commentPolicy,proto3" json:"comment_policy,omitempty"` // "EVERYONE" (default), "FOLLOWERS" or "LOCKED"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdatePostBody) Reset() {
+	*x = UpdatePostBody{}
+	mi := &file_post_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostBody) ProtoMessage() {}
+
+func (x *UpdatePostBody) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostBody.ProtoReflect.Descriptor instead.
+func (*UpdatePostBody) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdatePostBody) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *UpdatePostBody) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *UpdatePostBody) GetAttachments() []string {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *UpdatePostBody) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdatePostBody) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *UpdatePostBody) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *UpdatePostBody) GetContentWarning() string {
+	if x != nil {
+		return x.ContentWarning
+	}
+	return ""
+}
+
+func (x *UpdatePostBody) GetSensitiveMedia() bool {
+	if x != nil {
+		return x.SensitiveMedia
+	}
+	return false
+}
+
+func (x *UpdatePostBody) GetCommentPolicy() string {
+	if x != nil {
+		return x.CommentPolicy
+	}
+	return ""
+}
+
+type UpdatePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Post          *UpdatePostBody        `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
+	mi := &file_post_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostRequest) ProtoMessage() {}
+
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdatePostRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetPost() *UpdatePostBody {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *UpdatePostRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_post_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeletePostRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type TrashedPost struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Images        []string               `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeletedAt     int64                  `protobuf:"varint,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // purged for good after this
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashedPost) Reset() {
+	*x = TrashedPost{}
+	mi := &file_post_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashedPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashedPost) ProtoMessage() {}
+
+func (x *TrashedPost) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashedPost.ProtoReflect.Descriptor instead.
+func (*TrashedPost) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TrashedPost) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *TrashedPost) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TrashedPost) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *TrashedPost) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TrashedPost) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TrashedPost) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *TrashedPost) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ListMyTrashedPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageToken     string                 `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTrashedPostsRequest) Reset() {
+	*x = ListMyTrashedPostsRequest{}
+	mi := &file_post_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTrashedPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTrashedPostsRequest) ProtoMessage() {}
+
+func (x *ListMyTrashedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTrashedPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTrashedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListMyTrashedPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMyTrashedPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*TrashedPost         `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTrashedPostsResponse) Reset() {
+	*x = ListMyTrashedPostsResponse{}
+	mi := &file_post_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTrashedPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTrashedPostsResponse) ProtoMessage() {}
+
+func (x *ListMyTrashedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTrashedPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyTrashedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListMyTrashedPostsResponse) GetPosts() []*TrashedPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListMyTrashedPostsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestorePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostResponse) Reset() {
-	*x = GetPostResponse{}
-	mi := &file_post_proto_msgTypes[14]
+func (x *RestorePostRequest) Reset() {
+	*x = RestorePostRequest{}
+	mi := &file_post_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostResponse) String() string {
+func (x *RestorePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostResponse) ProtoMessage() {}
+func (*RestorePostRequest) ProtoMessage() {}
 
-func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[14]
+func (x *RestorePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -953,45 +2361,41 @@ func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
-func (*GetPostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RestorePostRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetPostResponse) GetPost() *Post {
+func (x *RestorePostRequest) GetUid() string {
 	if x != nil {
-		return x.Post
+		return x.Uid
 	}
-	return nil
+	return ""
 }
 
-type UpdatePostBody struct {
+type LikePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Images        []string               `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
-	Attachments   []string               `protobuf:"bytes,3,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Visibility    string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
-	Pinned        bool                   `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Action        ToggleAction           `protobuf:"varint,2,opt,name=action,proto3,enum=common.ToggleAction" json:"action,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePostBody) Reset() {
-	*x = UpdatePostBody{}
-	mi := &file_post_proto_msgTypes[15]
+func (x *LikePostRequest) Reset() {
+	*x = LikePostRequest{}
+	mi := &file_post_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostBody) String() string {
+func (x *LikePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostBody) ProtoMessage() {}
+func (*LikePostRequest) ProtoMessage() {}
 
-func (x *UpdatePostBody) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[15]
+func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,77 +2406,92 @@ func (x *UpdatePostBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostBody.ProtoReflect.Descriptor instead.
-func (*UpdatePostBody) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
+func (*LikePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *UpdatePostBody) GetText() string {
+func (x *LikePostRequest) GetUid() string {
 	if x != nil {
-		return x.Text
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *UpdatePostBody) GetImages() []string {
+func (x *LikePostRequest) GetAction() ToggleAction {
 	if x != nil {
-		return x.Images
+		return x.Action
 	}
-	return nil
+	return ToggleAction_TOGGLE_ACTION_ADD
 }
 
-func (x *UpdatePostBody) GetAttachments() []string {
-	if x != nil {
-		return x.Attachments
-	}
-	return nil
+type LikePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePostBody) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+func (x *LikePostResponse) Reset() {
+	*x = LikePostResponse{}
+	mi := &file_post_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostBody) GetVisibility() string {
+func (x *LikePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostResponse) ProtoMessage() {}
+
+func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[39]
 	if x != nil {
-		return x.Visibility
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdatePostBody) GetPinned() bool {
+// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
+func (*LikePostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LikePostResponse) GetCount() int32 {
 	if x != nil {
-		return x.Pinned
+		return x.Count
 	}
-	return false
+	return 0
 }
 
-type UpdatePostRequest struct {
+type CollectPostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Post          *UpdatePostBody        `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Action        ToggleAction           `protobuf:"varint,2,opt,name=action,proto3,enum=common.ToggleAction" json:"action,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePostRequest) Reset() {
-	*x = UpdatePostRequest{}
-	mi := &file_post_proto_msgTypes[16]
+func (x *CollectPostRequest) Reset() {
+	*x = CollectPostRequest{}
+	mi := &file_post_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostRequest) String() string {
+func (x *CollectPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostRequest) ProtoMessage() {}
+func (*CollectPostRequest) ProtoMessage() {}
 
-func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[16]
+func (x *CollectPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,54 +2502,47 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CollectPostRequest.ProtoReflect.Descriptor instead.
+func (*CollectPostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *UpdatePostRequest) GetUid() string {
+func (x *CollectPostRequest) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *UpdatePostRequest) GetPost() *UpdatePostBody {
-	if x != nil {
-		return x.Post
-	}
-	return nil
-}
-
-func (x *UpdatePostRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *CollectPostRequest) GetAction() ToggleAction {
 	if x != nil {
-		return x.UpdateMask
+		return x.Action
 	}
-	return nil
+	return ToggleAction_TOGGLE_ACTION_ADD
 }
 
-type DeletePostRequest struct {
+type CollectPostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
-	mi := &file_post_proto_msgTypes[17]
+func (x *CollectPostResponse) Reset() {
+	*x = CollectPostResponse{}
+	mi := &file_post_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostRequest) String() string {
+func (x *CollectPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (*CollectPostResponse) ProtoMessage() {}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[17]
+func (x *CollectPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1141,41 +2553,41 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CollectPostResponse.ProtoReflect.Descriptor instead.
+func (*CollectPostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DeletePostRequest) GetUid() string {
+func (x *CollectPostResponse) GetCount() int32 {
 	if x != nil {
-		return x.Uid
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type LikePostRequest struct {
+type ListPostLikersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Action        ToggleAction           `protobuf:"varint,2,opt,name=action,proto3,enum=common.ToggleAction" json:"action,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikePostRequest) Reset() {
-	*x = LikePostRequest{}
-	mi := &file_post_proto_msgTypes[18]
+func (x *ListPostLikersRequest) Reset() {
+	*x = ListPostLikersRequest{}
+	mi := &file_post_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikePostRequest) String() string {
+func (x *ListPostLikersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostRequest) ProtoMessage() {}
+func (*ListPostLikersRequest) ProtoMessage() {}
 
-func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[18]
+func (x *ListPostLikersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1186,47 +2598,48 @@ func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
-func (*LikePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListPostLikersRequest.ProtoReflect.Descriptor instead.
+func (*ListPostLikersRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *LikePostRequest) GetUid() string {
+func (x *ListPostLikersRequest) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *LikePostRequest) GetAction() ToggleAction {
+func (x *ListPostLikersRequest) GetPageToken() string {
 	if x != nil {
-		return x.Action
+		return x.PageToken
 	}
-	return ToggleAction_TOGGLE_ACTION_ADD
+	return ""
 }
 
-type LikePostResponse struct {
+type ListPostLikersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikePostResponse) Reset() {
-	*x = LikePostResponse{}
-	mi := &file_post_proto_msgTypes[19]
+func (x *ListPostLikersResponse) Reset() {
+	*x = ListPostLikersResponse{}
+	mi := &file_post_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikePostResponse) String() string {
+func (x *ListPostLikersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostResponse) ProtoMessage() {}
+func (*ListPostLikersResponse) ProtoMessage() {}
 
-func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[19]
+func (x *ListPostLikersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,41 +2650,48 @@ func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
-func (*LikePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListPostLikersResponse.ProtoReflect.Descriptor instead.
+func (*ListPostLikersResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *LikePostResponse) GetCount() int32 {
+func (x *ListPostLikersResponse) GetUsers() []*User {
 	if x != nil {
-		return x.Count
+		return x.Users
 	}
-	return 0
+	return nil
 }
 
-type CollectPostRequest struct {
+func (x *ListPostLikersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListPostCollectorsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Action        ToggleAction           `protobuf:"varint,2,opt,name=action,proto3,enum=common.ToggleAction" json:"action,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollectPostRequest) Reset() {
-	*x = CollectPostRequest{}
-	mi := &file_post_proto_msgTypes[20]
+func (x *ListPostCollectorsRequest) Reset() {
+	*x = ListPostCollectorsRequest{}
+	mi := &file_post_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollectPostRequest) String() string {
+func (x *ListPostCollectorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectPostRequest) ProtoMessage() {}
+func (*ListPostCollectorsRequest) ProtoMessage() {}
 
-func (x *CollectPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[20]
+func (x *ListPostCollectorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,47 +2702,48 @@ func (x *CollectPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectPostRequest.ProtoReflect.Descriptor instead.
-func (*CollectPostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListPostCollectorsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostCollectorsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CollectPostRequest) GetUid() string {
+func (x *ListPostCollectorsRequest) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *CollectPostRequest) GetAction() ToggleAction {
+func (x *ListPostCollectorsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Action
+		return x.PageToken
 	}
-	return ToggleAction_TOGGLE_ACTION_ADD
+	return ""
 }
 
-type CollectPostResponse struct {
+type ListPostCollectorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollectPostResponse) Reset() {
-	*x = CollectPostResponse{}
-	mi := &file_post_proto_msgTypes[21]
+func (x *ListPostCollectorsResponse) Reset() {
+	*x = ListPostCollectorsResponse{}
+	mi := &file_post_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollectPostResponse) String() string {
+func (x *ListPostCollectorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectPostResponse) ProtoMessage() {}
+func (*ListPostCollectorsResponse) ProtoMessage() {}
 
-func (x *CollectPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[21]
+func (x *ListPostCollectorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,16 +2754,23 @@ func (x *CollectPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectPostResponse.ProtoReflect.Descriptor instead.
-func (*CollectPostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListPostCollectorsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostCollectorsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CollectPostResponse) GetCount() int32 {
+func (x *ListPostCollectorsResponse) GetUsers() []*User {
 	if x != nil {
-		return x.Count
+		return x.Users
 	}
-	return 0
+	return nil
+}
+
+func (x *ListPostCollectorsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
 var File_post_proto protoreflect.FileDescriptor
@@ -1350,21 +2778,23 @@ var File_post_proto protoreflect.FileDescriptor
 const file_post_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"post.proto\x12\x04post\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\fcommon.proto\"\x90\x01\n" +
+	"post.proto\x12\x04post\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\fcommon.proto\"\xb1\x01\n" +
 	"\n" +
 	"PostAuthor\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1f\n" +
 	"\bnickname\x18\x02 \x01(\tB\x03\xe0A\x02R\bnickname\x12\"\n" +
 	"\n" +
 	"avatar_url\x18\x03 \x01(\tB\x03\xe0A\x02R\tavatarUrl\x12&\n" +
-	"\fis_following\x18\x04 \x01(\bB\x03\xe0A\x02R\visFollowing\"\x9e\x01\n" +
+	"\fis_following\x18\x04 \x01(\bB\x03\xe0A\x02R\visFollowing\x12\x1f\n" +
+	"\vfollows_you\x18\x05 \x01(\bR\n" +
+	"followsYou\"\x9e\x01\n" +
 	"\n" +
 	"Attachment\x12\x15\n" +
 	"\x03url\x18\x01 \x01(\tB\x03\xe0A\x02R\x03url\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12\x17\n" +
 	"\x04size\x18\x03 \x01(\x03B\x03\xe0A\x02R\x04size\x12&\n" +
 	"\fcontent_type\x18\x04 \x01(\tB\x03\xe0A\x02R\vcontentType\x12\x1f\n" +
-	"\bchecksum\x18\x05 \x01(\tB\x03\xe0A\x02R\bchecksum\"\xe0\x04\n" +
+	"\bchecksum\x18\x05 \x01(\tB\x03\xe0A\x02R\bchecksum\"\x99\a\n" +
 	"\x04Post\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12-\n" +
 	"\x06author\x18\x02 \x01(\v2\x10.post.PostAuthorB\x03\xe0A\x02R\x06author\x12\x17\n" +
@@ -1388,7 +2818,21 @@ const file_post_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x10 \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x12\"\n" +
 	"\n" +
-	"updated_at\x18\x11 \x01(\x03B\x03\xe0A\x02R\tupdatedAt\"\xb2\x01\n" +
+	"updated_at\x18\x11 \x01(\x03B\x03\xe0A\x02R\tupdatedAt\x12,\n" +
+	"\x0fcontent_warning\x18\x12 \x01(\tB\x03\xe0A\x02R\x0econtentWarning\x12,\n" +
+	"\x0fsensitive_media\x18\x13 \x01(\bB\x03\xe0A\x02R\x0esensitiveMedia\x124\n" +
+	"\flink_preview\x18\x14 \x01(\v2\x11.post.LinkPreviewR\vlinkPreview\x12\"\n" +
+	"\n" +
+	"view_count\x18\x15 \x01(\x03B\x03\xe0A\x02R\tviewCount\x12 \n" +
+	"\tip_region\x18\x16 \x01(\tB\x03\xe0A\x02R\bipRegion\x123\n" +
+	"\treactions\x18\x17 \x03(\v2\x10.common.ReactionB\x03\xe0A\x02R\treactions\x12*\n" +
+	"\x0ecomment_policy\x18\x18 \x01(\tB\x03\xe0A\x02R\rcommentPolicy\"\xaa\x01\n" +
+	"\vLinkPreview\x12\x15\n" +
+	"\x03url\x18\x01 \x01(\tB\x03\xe0A\x02R\x03url\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tB\x03\xe0A\x02R\vdescription\x12 \n" +
+	"\tsite_name\x18\x04 \x01(\tB\x03\xe0A\x02R\bsiteName\x12 \n" +
+	"\timage_url\x18\x05 \x01(\tB\x03\xe0A\x02R\bimageUrl\"\xab\x02\n" +
 	"\x11CreatePostRequest\x12\x17\n" +
 	"\x04text\x18\x01 \x01(\tB\x03\xe0A\x02R\x04text\x12\x16\n" +
 	"\x06images\x18\x02 \x03(\tR\x06images\x12 \n" +
@@ -1397,33 +2841,85 @@ const file_post_proto_rawDesc = "" +
 	"\n" +
 	"visibility\x18\x05 \x01(\tR\n" +
 	"visibility\x12\x16\n" +
-	"\x06pinned\x18\x06 \x01(\bR\x06pinned\"+\n" +
+	"\x06pinned\x18\x06 \x01(\bR\x06pinned\x12'\n" +
+	"\x0fcontent_warning\x18\a \x01(\tR\x0econtentWarning\x12'\n" +
+	"\x0fsensitive_media\x18\b \x01(\bR\x0esensitiveMedia\x12%\n" +
+	"\x0ecomment_policy\x18\t \x01(\tR\rcommentPolicy\"+\n" +
 	"\x12CreatePostResponse\x12\x15\n" +
-	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"\x81\x01\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"\x95\x01\n" +
 	"\x10ListPostsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
 	"\n" +
 	"author_uid\x18\x02 \x01(\tR\tauthorUid\x12\x19\n" +
 	"\btag_name\x18\x03 \x01(\tR\atagName\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x04 \x01(\tR\tpageToken\"\x83\x01\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\"\x97\x01\n" +
 	"\x12SearchPostsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
 	"\n" +
 	"author_uid\x18\x02 \x01(\tR\tauthorUid\x12\x19\n" +
 	"\btag_name\x18\x03 \x01(\tR\atagName\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x04 \x01(\tR\tpageToken\"g\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\"g\n" +
 	"\x11ListPostsResponse\x12%\n" +
 	"\x05posts\x18\x01 \x03(\v2\n" +
 	".post.PostB\x03\xe0A\x02R\x05posts\x12+\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"$\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"X\n" +
+	"\x18ListMyCollectionsRequest\x12\x1d\n" +
+	"\n" +
+	"folder_uid\x18\x01 \x01(\tR\tfolderUid\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"X\n" +
+	" ListCollectionFolderPostsRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"H\n" +
 	"\tSearchTag\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\".\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\"\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\tpostCount\".\n" +
 	"\x11SearchTagsRequest\x12\x19\n" +
 	"\x05query\x18\x01 \x01(\tB\x03\xe0A\x02R\x05query\">\n" +
 	"\x12SearchTagsResponse\x12(\n" +
-	"\x04tags\x18\x01 \x03(\v2\x0f.post.SearchTagB\x03\xe0A\x02R\x04tags\"9\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0f.post.SearchTagB\x03\xe0A\x02R\x04tags\"z\n" +
+	"\vTrendingTag\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\"\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\tpostCount\x12.\n" +
+	"\x10engagement_count\x18\x03 \x01(\x05B\x03\xe0A\x02R\x0fengagementCount\"G\n" +
+	"\x17ListTrendingTagsRequest\x12,\n" +
+	"\x06window\x18\x01 \x01(\x0e2\x14.post.TagTrendWindowR\x06window\"F\n" +
+	"\x18ListTrendingTagsResponse\x12*\n" +
+	"\x04tags\x18\x01 \x03(\v2\x11.post.TrendingTagB\x03\xe0A\x02R\x04tags\"\x96\x01\n" +
+	"\x03Tag\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\"\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\tpostCount\x12*\n" +
+	"\x0efollower_count\x18\x03 \x01(\x05B\x03\xe0A\x02R\rfollowerCount\x12&\n" +
+	"\fis_following\x18\x04 \x01(\bB\x03\xe0A\x02R\visFollowing\"(\n" +
+	"\rGetTagRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"2\n" +
+	"\x0eGetTagResponse\x12 \n" +
+	"\x03tag\x18\x01 \x01(\v2\t.post.TagB\x03\xe0A\x02R\x03tag\"+\n" +
+	"\x10FollowTagRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"?\n" +
+	"\x11FollowTagResponse\x12*\n" +
+	"\x0efollower_count\x18\x01 \x01(\x05B\x03\xe0A\x02R\rfollowerCount\"-\n" +
+	"\x12UnfollowTagRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"A\n" +
+	"\x13UnfollowTagResponse\x12*\n" +
+	"\x0efollower_count\x18\x01 \x01(\x05B\x03\xe0A\x02R\rfollowerCount\":\n" +
+	"\x19ListMyFollowedTagsRequest\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x01 \x01(\tR\tpageToken\"m\n" +
+	"\x1aListMyFollowedTagsResponse\x12\"\n" +
+	"\x04tags\x18\x01 \x03(\v2\t.post.TagB\x03\xe0A\x02R\x04tags\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"2\n" +
+	"\x11ListMyFeedRequest\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x01 \x01(\tR\tpageToken\"9\n" +
 	"\x1aSuggestTagsByPrefixRequest\x12\x1b\n" +
 	"\x06prefix\x18\x01 \x01(\tB\x03\xe0A\x02R\x06prefix\"G\n" +
 	"\x1bSuggestTagsByPrefixResponse\x12(\n" +
@@ -1432,7 +2928,7 @@ const file_post_proto_rawDesc = "" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"6\n" +
 	"\x0fGetPostResponse\x12#\n" +
 	"\x04post\x18\x01 \x01(\v2\n" +
-	".post.PostB\x03\xe0A\x02R\x04post\"\xaa\x01\n" +
+	".post.PostB\x03\xe0A\x02R\x04post\"\xa3\x02\n" +
 	"\x0eUpdatePostBody\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
 	"\x06images\x18\x02 \x03(\tR\x06images\x12 \n" +
@@ -1441,13 +2937,35 @@ const file_post_proto_rawDesc = "" +
 	"\n" +
 	"visibility\x18\x05 \x01(\tR\n" +
 	"visibility\x12\x16\n" +
-	"\x06pinned\x18\x06 \x01(\bR\x06pinned\"\x9b\x01\n" +
+	"\x06pinned\x18\x06 \x01(\bR\x06pinned\x12'\n" +
+	"\x0fcontent_warning\x18\a \x01(\tR\x0econtentWarning\x12'\n" +
+	"\x0fsensitive_media\x18\b \x01(\bR\x0esensitiveMedia\x12%\n" +
+	"\x0ecomment_policy\x18\t \x01(\tR\rcommentPolicy\"\x9b\x01\n" +
 	"\x11UpdatePostRequest\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12-\n" +
 	"\x04post\x18\x02 \x01(\v2\x14.post.UpdatePostBodyB\x03\xe0A\x02R\x04post\x12@\n" +
 	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
 	"updateMask\"*\n" +
 	"\x11DeletePostRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"\xdf\x01\n" +
+	"\vTrashedPost\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x17\n" +
+	"\x04text\x18\x02 \x01(\tB\x03\xe0A\x02R\x04text\x12\x1b\n" +
+	"\x06images\x18\x03 \x03(\tB\x03\xe0A\x02R\x06images\x12\x17\n" +
+	"\x04tags\x18\x04 \x03(\tB\x03\xe0A\x02R\x04tags\x12\"\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x12\"\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\x03B\x03\xe0A\x02R\tdeletedAt\x12\"\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\x03B\x03\xe0A\x02R\texpiresAt\":\n" +
+	"\x19ListMyTrashedPostsRequest\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x01 \x01(\tR\tpageToken\"w\n" +
+	"\x1aListMyTrashedPostsResponse\x12,\n" +
+	"\x05posts\x18\x01 \x03(\v2\x11.post.TrashedPostB\x03\xe0A\x02R\x05posts\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"+\n" +
+	"\x12RestorePostRequest\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"V\n" +
 	"\x0fLikePostRequest\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12,\n" +
@@ -1458,23 +2976,54 @@ const file_post_proto_rawDesc = "" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12,\n" +
 	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"0\n" +
 	"\x13CollectPostResponse\x12\x19\n" +
-	"\x05count\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05count2\xbe\b\n" +
+	"\x05count\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05count\"M\n" +
+	"\x15ListPostLikersRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"n\n" +
+	"\x16ListPostLikersResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken\"Q\n" +
+	"\x19ListPostCollectorsRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"r\n" +
+	"\x1aListPostCollectorsResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken*~\n" +
+	"\x0eTagTrendWindow\x12 \n" +
+	"\x1cTAG_TREND_WINDOW_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TAG_TREND_WINDOW_1H\x10\x01\x12\x18\n" +
+	"\x14TAG_TREND_WINDOW_24H\x10\x02\x12\x17\n" +
+	"\x13TAG_TREND_WINDOW_7D\x10\x032\x8e\x12\n" +
 	"\vPostService\x12Y\n" +
 	"\n" +
 	"CreatePost\x12\x17.post.CreatePostRequest\x1a\x18.post.CreatePostResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/posts\x12S\n" +
 	"\tListPosts\x12\x16.post.ListPostsRequest\x1a\x17.post.ListPostsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/posts\x12^\n" +
-	"\vSearchPosts\x12\x18.post.SearchPostsRequest\x1a\x17.post.ListPostsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/search/posts\x12d\n" +
-	"\x11ListMyCollections\x12\x16.post.ListPostsRequest\x1a\x17.post.ListPostsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/me/collections\x12\\\n" +
+	"\vSearchPosts\x12\x18.post.SearchPostsRequest\x1a\x17.post.ListPostsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/search/posts\x12l\n" +
+	"\x11ListMyCollections\x12\x1e.post.ListMyCollectionsRequest\x1a\x17.post.ListPostsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/me/collections\x12\x8c\x01\n" +
+	"\x19ListCollectionFolderPosts\x12&.post.ListCollectionFolderPostsRequest\x1a\x17.post.ListPostsResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/collection-folders/{uid}/posts\x12\\\n" +
+	"\n" +
+	"SearchTags\x12\x17.post.SearchTagsRequest\x1a\x18.post.SearchTagsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/search/tags\x12P\n" +
+	"\x06GetTag\x12\x13.post.GetTagRequest\x1a\x14.post.GetTagResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/tags/{name}\x12p\n" +
+	"\x10ListTrendingTags\x12\x1d.post.ListTrendingTagsRequest\x1a\x1e.post.ListTrendingTagsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/tags/trending\x12c\n" +
+	"\tFollowTag\x12\x16.post.FollowTagRequest\x1a\x17.post.FollowTagResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/tags/{name}/follow\x12f\n" +
+	"\vUnfollowTag\x12\x18.post.UnfollowTagRequest\x1a\x19.post.UnfollowTagResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/tags/{name}/follow\x12y\n" +
+	"\x12ListMyFollowedTags\x12\x1f.post.ListMyFollowedTagsRequest\x1a .post.ListMyFollowedTagsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/me/followed-tags\x12W\n" +
 	"\n" +
-	"SearchTags\x12\x17.post.SearchTagsRequest\x1a\x18.post.SearchTagsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/search/tags\x12|\n" +
+	"ListMyFeed\x12\x17.post.ListMyFeedRequest\x1a\x17.post.ListPostsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/me/feed\x12|\n" +
 	"\x13SuggestTagsByPrefix\x12 .post.SuggestTagsByPrefixRequest\x1a!.post.SuggestTagsByPrefixResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/suggestions/tags\x12S\n" +
 	"\aGetPost\x12\x14.post.GetPostRequest\x1a\x15.post.GetPostResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/posts/{uid}\x12`\n" +
 	"\n" +
 	"UpdatePost\x12\x17.post.UpdatePostRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x04post2\x13/api/v1/posts/{uid}\x12Z\n" +
 	"\n" +
-	"DeletePost\x12\x17.post.DeletePostRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/posts/{uid}\x12^\n" +
+	"DeletePost\x12\x17.post.DeletePostRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/posts/{uid}\x12w\n" +
+	"\x12ListMyTrashedPosts\x12\x1f.post.ListMyTrashedPostsRequest\x1a .post.ListMyTrashedPostsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/me/trash/posts\x12g\n" +
+	"\vRestorePost\x12\x18.post.RestorePostRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/posts/{uid}/restore\x12^\n" +
 	"\bLikePost\x12\x15.post.LikePostRequest\x1a\x16.post.LikePostResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/posts/{uid}/like\x12j\n" +
-	"\vCollectPost\x12\x18.post.CollectPostRequest\x1a\x19.post.CollectPostResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/posts/{uid}/collectB\x0fZ\raeibi/api;apib\x06proto3"
+	"\vCollectPost\x12\x18.post.CollectPostRequest\x1a\x19.post.CollectPostResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/posts/{uid}/collect\x12o\n" +
+	"\x0eListPostLikers\x12\x1b.post.ListPostLikersRequest\x1a\x1c.post.ListPostLikersResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/posts/{uid}/likers\x12\x7f\n" +
+	"\x12ListPostCollectors\x12\x1f.post.ListPostCollectorsRequest\x1a .post.ListPostCollectorsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/posts/{uid}/collectorsB\x0fZ\raeibi/api;apib\x06proto3"
 
 var (
 	file_post_proto_rawDescOnce sync.Once
@@ -1488,72 +3037,131 @@ func file_post_proto_rawDescGZIP() []byte {
 	return file_post_proto_rawDescData
 }
 
-var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_post_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_post_proto_goTypes = []any{
-	(*PostAuthor)(nil),                  // 0: post.PostAuthor
-	(*Attachment)(nil),                  // 1: post.Attachment
-	(*Post)(nil),                        // 2: post.Post
-	(*CreatePostRequest)(nil),           // 3: post.CreatePostRequest
-	(*CreatePostResponse)(nil),          // 4: post.CreatePostResponse
-	(*ListPostsRequest)(nil),            // 5: post.ListPostsRequest
-	(*SearchPostsRequest)(nil),          // 6: post.SearchPostsRequest
-	(*ListPostsResponse)(nil),           // 7: post.ListPostsResponse
-	(*SearchTag)(nil),                   // 8: post.SearchTag
-	(*SearchTagsRequest)(nil),           // 9: post.SearchTagsRequest
-	(*SearchTagsResponse)(nil),          // 10: post.SearchTagsResponse
-	(*SuggestTagsByPrefixRequest)(nil),  // 11: post.SuggestTagsByPrefixRequest
-	(*SuggestTagsByPrefixResponse)(nil), // 12: post.SuggestTagsByPrefixResponse
-	(*GetPostRequest)(nil),              // 13: post.GetPostRequest
-	(*GetPostResponse)(nil),             // 14: post.GetPostResponse
-	(*UpdatePostBody)(nil),              // 15: post.UpdatePostBody
-	(*UpdatePostRequest)(nil),           // 16: post.UpdatePostRequest
-	(*DeletePostRequest)(nil),           // 17: post.DeletePostRequest
-	(*LikePostRequest)(nil),             // 18: post.LikePostRequest
-	(*LikePostResponse)(nil),            // 19: post.LikePostResponse
-	(*CollectPostRequest)(nil),          // 20: post.CollectPostRequest
-	(*CollectPostResponse)(nil),         // 21: post.CollectPostResponse
-	(*fieldmaskpb.FieldMask)(nil),       // 22: google.protobuf.FieldMask
-	(ToggleAction)(0),                   // 23: common.ToggleAction
-	(*emptypb.Empty)(nil),               // 24: google.protobuf.Empty
+	(TagTrendWindow)(0),                      // 0: post.TagTrendWindow
+	(*PostAuthor)(nil),                       // 1: post.PostAuthor
+	(*Attachment)(nil),                       // 2: post.Attachment
+	(*Post)(nil),                             // 3: post.Post
+	(*LinkPreview)(nil),                      // 4: post.LinkPreview
+	(*CreatePostRequest)(nil),                // 5: post.CreatePostRequest
+	(*CreatePostResponse)(nil),               // 6: post.CreatePostResponse
+	(*ListPostsRequest)(nil),                 // 7: post.ListPostsRequest
+	(*SearchPostsRequest)(nil),               // 8: post.SearchPostsRequest
+	(*ListPostsResponse)(nil),                // 9: post.ListPostsResponse
+	(*ListMyCollectionsRequest)(nil),         // 10: post.ListMyCollectionsRequest
+	(*ListCollectionFolderPostsRequest)(nil), // 11: post.ListCollectionFolderPostsRequest
+	(*SearchTag)(nil),                        // 12: post.SearchTag
+	(*SearchTagsRequest)(nil),                // 13: post.SearchTagsRequest
+	(*SearchTagsResponse)(nil),               // 14: post.SearchTagsResponse
+	(*TrendingTag)(nil),                      // 15: post.TrendingTag
+	(*ListTrendingTagsRequest)(nil),          // 16: post.ListTrendingTagsRequest
+	(*ListTrendingTagsResponse)(nil),         // 17: post.ListTrendingTagsResponse
+	(*Tag)(nil),                              // 18: post.Tag
+	(*GetTagRequest)(nil),                    // 19: post.GetTagRequest
+	(*GetTagResponse)(nil),                   // 20: post.GetTagResponse
+	(*FollowTagRequest)(nil),                 // 21: post.FollowTagRequest
+	(*FollowTagResponse)(nil),                // 22: post.FollowTagResponse
+	(*UnfollowTagRequest)(nil),               // 23: post.UnfollowTagRequest
+	(*UnfollowTagResponse)(nil),              // 24: post.UnfollowTagResponse
+	(*ListMyFollowedTagsRequest)(nil),        // 25: post.ListMyFollowedTagsRequest
+	(*ListMyFollowedTagsResponse)(nil),       // 26: post.ListMyFollowedTagsResponse
+	(*ListMyFeedRequest)(nil),                // 27: post.ListMyFeedRequest
+	(*SuggestTagsByPrefixRequest)(nil),       // 28: post.SuggestTagsByPrefixRequest
+	(*SuggestTagsByPrefixResponse)(nil),      // 29: post.SuggestTagsByPrefixResponse
+	(*GetPostRequest)(nil),                   // 30: post.GetPostRequest
+	(*GetPostResponse)(nil),                  // 31: post.GetPostResponse
+	(*UpdatePostBody)(nil),                   // 32: post.UpdatePostBody
+	(*UpdatePostRequest)(nil),                // 33: post.UpdatePostRequest
+	(*DeletePostRequest)(nil),                // 34: post.DeletePostRequest
+	(*TrashedPost)(nil),                      // 35: post.TrashedPost
+	(*ListMyTrashedPostsRequest)(nil),        // 36: post.ListMyTrashedPostsRequest
+	(*ListMyTrashedPostsResponse)(nil),       // 37: post.ListMyTrashedPostsResponse
+	(*RestorePostRequest)(nil),               // 38: post.RestorePostRequest
+	(*LikePostRequest)(nil),                  // 39: post.LikePostRequest
+	(*LikePostResponse)(nil),                 // 40: post.LikePostResponse
+	(*CollectPostRequest)(nil),               // 41: post.CollectPostRequest
+	(*CollectPostResponse)(nil),              // 42: post.CollectPostResponse
+	(*ListPostLikersRequest)(nil),            // 43: post.ListPostLikersRequest
+	(*ListPostLikersResponse)(nil),           // 44: post.ListPostLikersResponse
+	(*ListPostCollectorsRequest)(nil),        // 45: post.ListPostCollectorsRequest
+	(*ListPostCollectorsResponse)(nil),       // 46: post.ListPostCollectorsResponse
+	(*Reaction)(nil),                         // 47: common.Reaction
+	(*fieldmaskpb.FieldMask)(nil),            // 48: google.protobuf.FieldMask
+	(ToggleAction)(0),                        // 49: common.ToggleAction
+	(*User)(nil),                             // 50: common.User
+	(*emptypb.Empty)(nil),                    // 51: google.protobuf.Empty
 }
 var file_post_proto_depIdxs = []int32{
-	0,  // 0: post.Post.author:type_name -> post.PostAuthor
-	1,  // 1: post.Post.attachments:type_name -> post.Attachment
-	2,  // 2: post.ListPostsResponse.posts:type_name -> post.Post
-	8,  // 3: post.SearchTagsResponse.tags:type_name -> post.SearchTag
-	8,  // 4: post.SuggestTagsByPrefixResponse.tags:type_name -> post.SearchTag
-	2,  // 5: post.GetPostResponse.post:type_name -> post.Post
-	15, // 6: post.UpdatePostRequest.post:type_name -> post.UpdatePostBody
-	22, // 7: post.UpdatePostRequest.update_mask:type_name -> google.protobuf.FieldMask
-	23, // 8: post.LikePostRequest.action:type_name -> common.ToggleAction
-	23, // 9: post.CollectPostRequest.action:type_name -> common.ToggleAction
-	3,  // 10: post.PostService.CreatePost:input_type -> post.CreatePostRequest
-	5,  // 11: post.PostService.ListPosts:input_type -> post.ListPostsRequest
-	6,  // 12: post.PostService.SearchPosts:input_type -> post.SearchPostsRequest
-	5,  // 13: post.PostService.ListMyCollections:input_type -> post.ListPostsRequest
-	9,  // 14: post.PostService.SearchTags:input_type -> post.SearchTagsRequest
-	11, // 15: post.PostService.SuggestTagsByPrefix:input_type -> post.SuggestTagsByPrefixRequest
-	13, // 16: post.PostService.GetPost:input_type -> post.GetPostRequest
-	16, // 17: post.PostService.UpdatePost:input_type -> post.UpdatePostRequest
-	17, // 18: post.PostService.DeletePost:input_type -> post.DeletePostRequest
-	18, // 19: post.PostService.LikePost:input_type -> post.LikePostRequest
-	20, // 20: post.PostService.CollectPost:input_type -> post.CollectPostRequest
-	4,  // 21: post.PostService.CreatePost:output_type -> post.CreatePostResponse
-	7,  // 22: post.PostService.ListPosts:output_type -> post.ListPostsResponse
-	7,  // 23: post.PostService.SearchPosts:output_type -> post.ListPostsResponse
-	7,  // 24: post.PostService.ListMyCollections:output_type -> post.ListPostsResponse
-	10, // 25: post.PostService.SearchTags:output_type -> post.SearchTagsResponse
-	12, // 26: post.PostService.SuggestTagsByPrefix:output_type -> post.SuggestTagsByPrefixResponse
-	14, // 27: post.PostService.GetPost:output_type -> post.GetPostResponse
-	24, // 28: post.PostService.UpdatePost:output_type -> google.protobuf.Empty
-	24, // 29: post.PostService.DeletePost:output_type -> google.protobuf.Empty
-	19, // 30: post.PostService.LikePost:output_type -> post.LikePostResponse
-	21, // 31: post.PostService.CollectPost:output_type -> post.CollectPostResponse
-	21, // [21:32] is the sub-list for method output_type
-	10, // [10:21] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	1,  // 0: post.Post.author:type_name -> post.PostAuthor
+	2,  // 1: post.Post.attachments:type_name -> post.Attachment
+	4,  // 2: post.Post.link_preview:type_name -> post.LinkPreview
+	47, // 3: post.Post.reactions:type_name -> common.Reaction
+	3,  // 4: post.ListPostsResponse.posts:type_name -> post.Post
+	12, // 5: post.SearchTagsResponse.tags:type_name -> post.SearchTag
+	0,  // 6: post.ListTrendingTagsRequest.window:type_name -> post.TagTrendWindow
+	15, // 7: post.ListTrendingTagsResponse.tags:type_name -> post.TrendingTag
+	18, // 8: post.GetTagResponse.tag:type_name -> post.Tag
+	18, // 9: post.ListMyFollowedTagsResponse.tags:type_name -> post.Tag
+	12, // 10: post.SuggestTagsByPrefixResponse.tags:type_name -> post.SearchTag
+	3,  // 11: post.GetPostResponse.post:type_name -> post.Post
+	32, // 12: post.UpdatePostRequest.post:type_name -> post.UpdatePostBody
+	48, // 13: post.UpdatePostRequest.update_mask:type_name -> google.protobuf.FieldMask
+	35, // 14: post.ListMyTrashedPostsResponse.posts:type_name -> post.TrashedPost
+	49, // 15: post.LikePostRequest.action:type_name -> common.ToggleAction
+	49, // 16: post.CollectPostRequest.action:type_name -> common.ToggleAction
+	50, // 17: post.ListPostLikersResponse.users:type_name -> common.User
+	50, // 18: post.ListPostCollectorsResponse.users:type_name -> common.User
+	5,  // 19: post.PostService.CreatePost:input_type -> post.CreatePostRequest
+	7,  // 20: post.PostService.ListPosts:input_type -> post.ListPostsRequest
+	8,  // 21: post.PostService.SearchPosts:input_type -> post.SearchPostsRequest
+	10, // 22: post.PostService.ListMyCollections:input_type -> post.ListMyCollectionsRequest
+	11, // 23: post.PostService.ListCollectionFolderPosts:input_type -> post.ListCollectionFolderPostsRequest
+	13, // 24: post.PostService.SearchTags:input_type -> post.SearchTagsRequest
+	19, // 25: post.PostService.GetTag:input_type -> post.GetTagRequest
+	16, // 26: post.PostService.ListTrendingTags:input_type -> post.ListTrendingTagsRequest
+	21, // 27: post.PostService.FollowTag:input_type -> post.FollowTagRequest
+	23, // 28: post.PostService.UnfollowTag:input_type -> post.UnfollowTagRequest
+	25, // 29: post.PostService.ListMyFollowedTags:input_type -> post.ListMyFollowedTagsRequest
+	27, // 30: post.PostService.ListMyFeed:input_type -> post.ListMyFeedRequest
+	28, // 31: post.PostService.SuggestTagsByPrefix:input_type -> post.SuggestTagsByPrefixRequest
+	30, // 32: post.PostService.GetPost:input_type -> post.GetPostRequest
+	33, // 33: post.PostService.UpdatePost:input_type -> post.UpdatePostRequest
+	34, // 34: post.PostService.DeletePost:input_type -> post.DeletePostRequest
+	36, // 35: post.PostService.ListMyTrashedPosts:input_type -> post.ListMyTrashedPostsRequest
+	38, // 36: post.PostService.RestorePost:input_type -> post.RestorePostRequest
+	39, // 37: post.PostService.LikePost:input_type -> post.LikePostRequest
+	41, // 38: post.PostService.CollectPost:input_type -> post.CollectPostRequest
+	43, // 39: post.PostService.ListPostLikers:input_type -> post.ListPostLikersRequest
+	45, // 40: post.PostService.ListPostCollectors:input_type -> post.ListPostCollectorsRequest
+	6,  // 41: post.PostService.CreatePost:output_type -> post.CreatePostResponse
+	9,  // 42: post.PostService.ListPosts:output_type -> post.ListPostsResponse
+	9,  // 43: post.PostService.SearchPosts:output_type -> post.ListPostsResponse
+	9,  // 44: post.PostService.ListMyCollections:output_type -> post.ListPostsResponse
+	9,  // 45: post.PostService.ListCollectionFolderPosts:output_type -> post.ListPostsResponse
+	14, // 46: post.PostService.SearchTags:output_type -> post.SearchTagsResponse
+	20, // 47: post.PostService.GetTag:output_type -> post.GetTagResponse
+	17, // 48: post.PostService.ListTrendingTags:output_type -> post.ListTrendingTagsResponse
+	22, // 49: post.PostService.FollowTag:output_type -> post.FollowTagResponse
+	24, // 50: post.PostService.UnfollowTag:output_type -> post.UnfollowTagResponse
+	26, // 51: post.PostService.ListMyFollowedTags:output_type -> post.ListMyFollowedTagsResponse
+	9,  // 52: post.PostService.ListMyFeed:output_type -> post.ListPostsResponse
+	29, // 53: post.PostService.SuggestTagsByPrefix:output_type -> post.SuggestTagsByPrefixResponse
+	31, // 54: post.PostService.GetPost:output_type -> post.GetPostResponse
+	51, // 55: post.PostService.UpdatePost:output_type -> google.protobuf.Empty
+	51, // 56: post.PostService.DeletePost:output_type -> google.protobuf.Empty
+	37, // 57: post.PostService.ListMyTrashedPosts:output_type -> post.ListMyTrashedPostsResponse
+	51, // 58: post.PostService.RestorePost:output_type -> google.protobuf.Empty
+	40, // 59: post.PostService.LikePost:output_type -> post.LikePostResponse
+	42, // 60: post.PostService.CollectPost:output_type -> post.CollectPostResponse
+	44, // 61: post.PostService.ListPostLikers:output_type -> post.ListPostLikersResponse
+	46, // 62: post.PostService.ListPostCollectors:output_type -> post.ListPostCollectorsResponse
+	41, // [41:63] is the sub-list for method output_type
+	19, // [19:41] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_post_proto_init() }
@@ -1567,13 +3175,14 @@ func file_post_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_post_proto_rawDesc), len(file_post_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   22,
+			NumEnums:      1,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_post_proto_goTypes,
 		DependencyIndexes: file_post_proto_depIdxs,
+		EnumInfos:         file_post_proto_enumTypes,
 		MessageInfos:      file_post_proto_msgTypes,
 	}.Build()
 	File_post_proto = out.File
@@ -20,17 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PostService_CreatePost_FullMethodName          = "/post.PostService/CreatePost"
-	PostService_ListPosts_FullMethodName           = "/post.PostService/ListPosts"
-	PostService_SearchPosts_FullMethodName         = "/post.PostService/SearchPosts"
-	PostService_ListMyCollections_FullMethodName   = "/post.PostService/ListMyCollections"
-	PostService_SearchTags_FullMethodName          = "/post.PostService/SearchTags"
-	PostService_SuggestTagsByPrefix_FullMethodName = "/post.PostService/SuggestTagsByPrefix"
-	PostService_GetPost_FullMethodName             = "/post.PostService/GetPost"
-	PostService_UpdatePost_FullMethodName          = "/post.PostService/UpdatePost"
-	PostService_DeletePost_FullMethodName          = "/post.PostService/DeletePost"
-	PostService_LikePost_FullMethodName            = "/post.PostService/LikePost"
-	PostService_CollectPost_FullMethodName         = "/post.PostService/CollectPost"
+	PostService_CreatePost_FullMethodName                = "/post.PostService/CreatePost"
+	PostService_ListPosts_FullMethodName                 = "/post.PostService/ListPosts"
+	PostService_SearchPosts_FullMethodName               = "/post.PostService/SearchPosts"
+	PostService_ListMyCollections_FullMethodName         = "/post.PostService/ListMyCollections"
+	PostService_ListCollectionFolderPosts_FullMethodName = "/post.PostService/ListCollectionFolderPosts"
+	PostService_SearchTags_FullMethodName                = "/post.PostService/SearchTags"
+	PostService_GetTag_FullMethodName                    = "/post.PostService/GetTag"
+	PostService_ListTrendingTags_FullMethodName          = "/post.PostService/ListTrendingTags"
+	PostService_FollowTag_FullMethodName                 = "/post.PostService/FollowTag"
+	PostService_UnfollowTag_FullMethodName               = "/post.PostService/UnfollowTag"
+	PostService_ListMyFollowedTags_FullMethodName        = "/post.PostService/ListMyFollowedTags"
+	PostService_ListMyFeed_FullMethodName                = "/post.PostService/ListMyFeed"
+	PostService_SuggestTagsByPrefix_FullMethodName       = "/post.PostService/SuggestTagsByPrefix"
+	PostService_GetPost_FullMethodName                   = "/post.PostService/GetPost"
+	PostService_UpdatePost_FullMethodName                = "/post.PostService/UpdatePost"
+	PostService_DeletePost_FullMethodName                = "/post.PostService/DeletePost"
+	PostService_ListMyTrashedPosts_FullMethodName        = "/post.PostService/ListMyTrashedPosts"
+	PostService_RestorePost_FullMethodName               = "/post.PostService/RestorePost"
+	PostService_LikePost_FullMethodName                  = "/post.PostService/LikePost"
+	PostService_CollectPost_FullMethodName               = "/post.PostService/CollectPost"
+	PostService_ListPostLikers_FullMethodName            = "/post.PostService/ListPostLikers"
+	PostService_ListPostCollectors_FullMethodName        = "/post.PostService/ListPostCollectors"
 )
 
 // PostServiceClient is the client API for PostService service.
@@ -46,9 +57,24 @@ type PostServiceClient interface {
 	// GET /api/v1/search/posts 帖子搜索
 	SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
 	// GET /api/v1/me/collections 当前用户收藏的帖子列表
-	ListMyCollections(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
+	ListMyCollections(ctx context.Context, in *ListMyCollectionsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
+	// GET /api/v1/collection-folders/{uid}/posts 公开收藏夹中的帖子列表
+	ListCollectionFolderPosts(ctx context.Context, in *ListCollectionFolderPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
 	// GET /api/v1/search/tags 标签搜索
 	SearchTags(ctx context.Context, in *SearchTagsRequest, opts ...grpc.CallOption) (*SearchTagsResponse, error)
+	// GET /api/v1/tags/{name} 标签详情
+	// 须声明在 /api/v1/tags/trending 之前：网关优先匹配后注册的路由
+	GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error)
+	// GET /api/v1/tags/trending 热门标签
+	ListTrendingTags(ctx context.Context, in *ListTrendingTagsRequest, opts ...grpc.CallOption) (*ListTrendingTagsResponse, error)
+	// POST /api/v1/tags/{name}/follow 关注标签
+	FollowTag(ctx context.Context, in *FollowTagRequest, opts ...grpc.CallOption) (*FollowTagResponse, error)
+	// DELETE /api/v1/tags/{name}/follow 取消关注标签
+	UnfollowTag(ctx context.Context, in *UnfollowTagRequest, opts ...grpc.CallOption) (*UnfollowTagResponse, error)
+	// GET /api/v1/me/followed-tags 当前用户关注的标签列表
+	ListMyFollowedTags(ctx context.Context, in *ListMyFollowedTagsRequest, opts ...grpc.CallOption) (*ListMyFollowedTagsResponse, error)
+	// GET /api/v1/me/feed 关注的用户与标签的帖子时间线
+	ListMyFeed(ctx context.Context, in *ListMyFeedRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
 	// GET /api/v1/suggestions/tags 标签前缀推荐
 	SuggestTagsByPrefix(ctx context.Context, in *SuggestTagsByPrefixRequest, opts ...grpc.CallOption) (*SuggestTagsByPrefixResponse, error)
 	// GET /api/v1/posts/{uid} 详情
@@ -57,10 +83,18 @@ type PostServiceClient interface {
 	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// DELETE /api/v1/posts/{uid} 软删
 	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GET /api/v1/me/trash/posts 回收站中的帖子
+	ListMyTrashedPosts(ctx context.Context, in *ListMyTrashedPostsRequest, opts ...grpc.CallOption) (*ListMyTrashedPostsResponse, error)
+	// POST /api/v1/posts/{uid}/restore 从回收站恢复
+	RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// POST /api/v1/posts/{uid}/like 点赞或取消赞
 	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error)
 	// POST /api/v1/posts/{uid}/collect 收藏或取消收藏
 	CollectPost(ctx context.Context, in *CollectPostRequest, opts ...grpc.CallOption) (*CollectPostResponse, error)
+	// GET /api/v1/posts/{uid}/likers 点赞用户列表
+	ListPostLikers(ctx context.Context, in *ListPostLikersRequest, opts ...grpc.CallOption) (*ListPostLikersResponse, error)
+	// GET /api/v1/posts/{uid}/collectors 收藏用户列表
+	ListPostCollectors(ctx context.Context, in *ListPostCollectorsRequest, opts ...grpc.CallOption) (*ListPostCollectorsResponse, error)
 }
 
 type postServiceClient struct {
@@ -101,7 +135,7 @@ func (c *postServiceClient) SearchPosts(ctx context.Context, in *SearchPostsRequ
 	return out, nil
 }
 
-func (c *postServiceClient) ListMyCollections(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
+func (c *postServiceClient) ListMyCollections(ctx context.Context, in *ListMyCollectionsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPostsResponse)
 	err := c.cc.Invoke(ctx, PostService_ListMyCollections_FullMethodName, in, out, cOpts...)
@@ -111,6 +145,16 @@ func (c *postServiceClient) ListMyCollections(ctx context.Context, in *ListPosts
 	return out, nil
 }
 
+func (c *postServiceClient) ListCollectionFolderPosts(ctx context.Context, in *ListCollectionFolderPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListCollectionFolderPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) SearchTags(ctx context.Context, in *SearchTagsRequest, opts ...grpc.CallOption) (*SearchTagsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchTagsResponse)
@@ -121,6 +165,66 @@ func (c *postServiceClient) SearchTags(ctx context.Context, in *SearchTagsReques
 	return out, nil
 }
 
+func (c *postServiceClient) GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagResponse)
+	err := c.cc.Invoke(ctx, PostService_GetTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListTrendingTags(ctx context.Context, in *ListTrendingTagsRequest, opts ...grpc.CallOption) (*ListTrendingTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrendingTagsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListTrendingTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) FollowTag(ctx context.Context, in *FollowTagRequest, opts ...grpc.CallOption) (*FollowTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowTagResponse)
+	err := c.cc.Invoke(ctx, PostService_FollowTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UnfollowTag(ctx context.Context, in *UnfollowTagRequest, opts ...grpc.CallOption) (*UnfollowTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowTagResponse)
+	err := c.cc.Invoke(ctx, PostService_UnfollowTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListMyFollowedTags(ctx context.Context, in *ListMyFollowedTagsRequest, opts ...grpc.CallOption) (*ListMyFollowedTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyFollowedTagsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListMyFollowedTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListMyFeed(ctx context.Context, in *ListMyFeedRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListMyFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) SuggestTagsByPrefix(ctx context.Context, in *SuggestTagsByPrefixRequest, opts ...grpc.CallOption) (*SuggestTagsByPrefixResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SuggestTagsByPrefixResponse)
@@ -161,6 +265,26 @@ func (c *postServiceClient) DeletePost(ctx context.Context, in *DeletePostReques
 	return out, nil
 }
 
+func (c *postServiceClient) ListMyTrashedPosts(ctx context.Context, in *ListMyTrashedPostsRequest, opts ...grpc.CallOption) (*ListMyTrashedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyTrashedPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListMyTrashedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_RestorePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LikePostResponse)
@@ -181,6 +305,26 @@ func (c *postServiceClient) CollectPost(ctx context.Context, in *CollectPostRequ
 	return out, nil
 }
 
+func (c *postServiceClient) ListPostLikers(ctx context.Context, in *ListPostLikersRequest, opts ...grpc.CallOption) (*ListPostLikersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostLikersResponse)
+	err := c.cc.Invoke(ctx, PostService_ListPostLikers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListPostCollectors(ctx context.Context, in *ListPostCollectorsRequest, opts ...grpc.CallOption) (*ListPostCollectorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostCollectorsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListPostCollectors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostServiceServer is the server API for PostService service.
 // All implementations must embed UnimplementedPostServiceServer
 // for forward compatibility.
@@ -194,9 +338,24 @@ type PostServiceServer interface {
 	// GET /api/v1/search/posts 帖子搜索
 	SearchPosts(context.Context, *SearchPostsRequest) (*ListPostsResponse, error)
 	// GET /api/v1/me/collections 当前用户收藏的帖子列表
-	ListMyCollections(context.Context, *ListPostsRequest) (*ListPostsResponse, error)
+	ListMyCollections(context.Context, *ListMyCollectionsRequest) (*ListPostsResponse, error)
+	// GET /api/v1/collection-folders/{uid}/posts 公开收藏夹中的帖子列表
+	ListCollectionFolderPosts(context.Context, *ListCollectionFolderPostsRequest) (*ListPostsResponse, error)
 	// GET /api/v1/search/tags 标签搜索
 	SearchTags(context.Context, *SearchTagsRequest) (*SearchTagsResponse, error)
+	// GET /api/v1/tags/{name} 标签详情
+	// 须声明在 /api/v1/tags/trending 之前：网关优先匹配后注册的路由
+	GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error)
+	// GET /api/v1/tags/trending 热门标签
+	ListTrendingTags(context.Context, *ListTrendingTagsRequest) (*ListTrendingTagsResponse, error)
+	// POST /api/v1/tags/{name}/follow 关注标签
+	FollowTag(context.Context, *FollowTagRequest) (*FollowTagResponse, error)
+	// DELETE /api/v1/tags/{name}/follow 取消关注标签
+	UnfollowTag(context.Context, *UnfollowTagRequest) (*UnfollowTagResponse, error)
+	// GET /api/v1/me/followed-tags 当前用户关注的标签列表
+	ListMyFollowedTags(context.Context, *ListMyFollowedTagsRequest) (*ListMyFollowedTagsResponse, error)
+	// GET /api/v1/me/feed 关注的用户与标签的帖子时间线
+	ListMyFeed(context.Context, *ListMyFeedRequest) (*ListPostsResponse, error)
 	// GET /api/v1/suggestions/tags 标签前缀推荐
 	SuggestTagsByPrefix(context.Context, *SuggestTagsByPrefixRequest) (*SuggestTagsByPrefixResponse, error)
 	// GET /api/v1/posts/{uid} 详情
@@ -205,10 +364,18 @@ type PostServiceServer interface {
 	UpdatePost(context.Context, *UpdatePostRequest) (*emptypb.Empty, error)
 	// DELETE /api/v1/posts/{uid} 软删
 	DeletePost(context.Context, *DeletePostRequest) (*emptypb.Empty, error)
+	// GET /api/v1/me/trash/posts 回收站中的帖子
+	ListMyTrashedPosts(context.Context, *ListMyTrashedPostsRequest) (*ListMyTrashedPostsResponse, error)
+	// POST /api/v1/posts/{uid}/restore 从回收站恢复
+	RestorePost(context.Context, *RestorePostRequest) (*emptypb.Empty, error)
 	// POST /api/v1/posts/{uid}/like 点赞或取消赞
 	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
 	// POST /api/v1/posts/{uid}/collect 收藏或取消收藏
 	CollectPost(context.Context, *CollectPostRequest) (*CollectPostResponse, error)
+	// GET /api/v1/posts/{uid}/likers 点赞用户列表
+	ListPostLikers(context.Context, *ListPostLikersRequest) (*ListPostLikersResponse, error)
+	// GET /api/v1/posts/{uid}/collectors 收藏用户列表
+	ListPostCollectors(context.Context, *ListPostCollectorsRequest) (*ListPostCollectorsResponse, error)
 	mustEmbedUnimplementedPostServiceServer()
 }
 
@@ -228,12 +395,33 @@ func (UnimplementedPostServiceServer) ListPosts(context.Context, *ListPostsReque
 func (UnimplementedPostServiceServer) SearchPosts(context.Context, *SearchPostsRequest) (*ListPostsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SearchPosts not implemented")
 }
-func (UnimplementedPostServiceServer) ListMyCollections(context.Context, *ListPostsRequest) (*ListPostsResponse, error) {
+func (UnimplementedPostServiceServer) ListMyCollections(context.Context, *ListMyCollectionsRequest) (*ListPostsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMyCollections not implemented")
 }
+func (UnimplementedPostServiceServer) ListCollectionFolderPosts(context.Context, *ListCollectionFolderPostsRequest) (*ListPostsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollectionFolderPosts not implemented")
+}
 func (UnimplementedPostServiceServer) SearchTags(context.Context, *SearchTagsRequest) (*SearchTagsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SearchTags not implemented")
 }
+func (UnimplementedPostServiceServer) GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTag not implemented")
+}
+func (UnimplementedPostServiceServer) ListTrendingTags(context.Context, *ListTrendingTagsRequest) (*ListTrendingTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrendingTags not implemented")
+}
+func (UnimplementedPostServiceServer) FollowTag(context.Context, *FollowTagRequest) (*FollowTagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FollowTag not implemented")
+}
+func (UnimplementedPostServiceServer) UnfollowTag(context.Context, *UnfollowTagRequest) (*UnfollowTagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnfollowTag not implemented")
+}
+func (UnimplementedPostServiceServer) ListMyFollowedTags(context.Context, *ListMyFollowedTagsRequest) (*ListMyFollowedTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyFollowedTags not implemented")
+}
+func (UnimplementedPostServiceServer) ListMyFeed(context.Context, *ListMyFeedRequest) (*ListPostsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyFeed not implemented")
+}
 func (UnimplementedPostServiceServer) SuggestTagsByPrefix(context.Context, *SuggestTagsByPrefixRequest) (*SuggestTagsByPrefixResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SuggestTagsByPrefix not implemented")
 }
@@ -246,12 +434,24 @@ func (UnimplementedPostServiceServer) UpdatePost(context.Context, *UpdatePostReq
 func (UnimplementedPostServiceServer) DeletePost(context.Context, *DeletePostRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeletePost not implemented")
 }
+func (UnimplementedPostServiceServer) ListMyTrashedPosts(context.Context, *ListMyTrashedPostsRequest) (*ListMyTrashedPostsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyTrashedPosts not implemented")
+}
+func (UnimplementedPostServiceServer) RestorePost(context.Context, *RestorePostRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestorePost not implemented")
+}
 func (UnimplementedPostServiceServer) LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LikePost not implemented")
 }
 func (UnimplementedPostServiceServer) CollectPost(context.Context, *CollectPostRequest) (*CollectPostResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CollectPost not implemented")
 }
+func (UnimplementedPostServiceServer) ListPostLikers(context.Context, *ListPostLikersRequest) (*ListPostLikersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPostLikers not implemented")
+}
+func (UnimplementedPostServiceServer) ListPostCollectors(context.Context, *ListPostCollectorsRequest) (*ListPostCollectorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPostCollectors not implemented")
+}
 func (UnimplementedPostServiceServer) mustEmbedUnimplementedPostServiceServer() {}
 func (UnimplementedPostServiceServer) testEmbeddedByValue()                     {}
 
@@ -328,7 +528,7 @@ func _PostService_SearchPosts_Handler(srv interface{}, ctx context.Context, dec
 }
 
 func _PostService_ListMyCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPostsRequest)
+	in := new(ListMyCollectionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -340,7 +540,25 @@ func _PostService_ListMyCollections_Handler(srv interface{}, ctx context.Context
 		FullMethod: PostService_ListMyCollections_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).ListMyCollections(ctx, req.(*ListPostsRequest))
+		return srv.(PostServiceServer).ListMyCollections(ctx, req.(*ListMyCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListCollectionFolderPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionFolderPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListCollectionFolderPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListCollectionFolderPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListCollectionFolderPosts(ctx, req.(*ListCollectionFolderPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -363,6 +581,114 @@ func _PostService_SearchTags_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetTag(ctx, req.(*GetTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListTrendingTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListTrendingTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListTrendingTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListTrendingTags(ctx, req.(*ListTrendingTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_FollowTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).FollowTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_FollowTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).FollowTag(ctx, req.(*FollowTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UnfollowTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UnfollowTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UnfollowTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UnfollowTag(ctx, req.(*UnfollowTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListMyFollowedTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyFollowedTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListMyFollowedTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListMyFollowedTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListMyFollowedTags(ctx, req.(*ListMyFollowedTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListMyFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListMyFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListMyFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListMyFeed(ctx, req.(*ListMyFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_SuggestTagsByPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SuggestTagsByPrefixRequest)
 	if err := dec(in); err != nil {
@@ -435,6 +761,42 @@ func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_ListMyTrashedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyTrashedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListMyTrashedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListMyTrashedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListMyTrashedPosts(ctx, req.(*ListMyTrashedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_RestorePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).RestorePost(ctx, req.(*RestorePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LikePostRequest)
 	if err := dec(in); err != nil {
@@ -471,6 +833,42 @@ func _PostService_CollectPost_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_ListPostLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostLikersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListPostLikers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListPostLikers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListPostLikers(ctx, req.(*ListPostLikersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListPostCollectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostCollectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListPostCollectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListPostCollectors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListPostCollectors(ctx, req.(*ListPostCollectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PostService_ServiceDesc is the grpc.ServiceDesc for PostService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -494,10 +892,38 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMyCollections",
 			Handler:    _PostService_ListMyCollections_Handler,
 		},
+		{
+			MethodName: "ListCollectionFolderPosts",
+			Handler:    _PostService_ListCollectionFolderPosts_Handler,
+		},
 		{
 			MethodName: "SearchTags",
 			Handler:    _PostService_SearchTags_Handler,
 		},
+		{
+			MethodName: "GetTag",
+			Handler:    _PostService_GetTag_Handler,
+		},
+		{
+			MethodName: "ListTrendingTags",
+			Handler:    _PostService_ListTrendingTags_Handler,
+		},
+		{
+			MethodName: "FollowTag",
+			Handler:    _PostService_FollowTag_Handler,
+		},
+		{
+			MethodName: "UnfollowTag",
+			Handler:    _PostService_UnfollowTag_Handler,
+		},
+		{
+			MethodName: "ListMyFollowedTags",
+			Handler:    _PostService_ListMyFollowedTags_Handler,
+		},
+		{
+			MethodName: "ListMyFeed",
+			Handler:    _PostService_ListMyFeed_Handler,
+		},
 		{
 			MethodName: "SuggestTagsByPrefix",
 			Handler:    _PostService_SuggestTagsByPrefix_Handler,
@@ -514,6 +940,14 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePost",
 			Handler:    _PostService_DeletePost_Handler,
 		},
+		{
+			MethodName: "ListMyTrashedPosts",
+			Handler:    _PostService_ListMyTrashedPosts_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _PostService_RestorePost_Handler,
+		},
 		{
 			MethodName: "LikePost",
 			Handler:    _PostService_LikePost_Handler,
@@ -522,6 +956,14 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CollectPost",
 			Handler:    _PostService_CollectPost_Handler,
 		},
+		{
+			MethodName: "ListPostLikers",
+			Handler:    _PostService_ListPostLikers_Handler,
+		},
+		{
+			MethodName: "ListPostCollectors",
+			Handler:    _PostService_ListPostCollectors_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "post.proto",
@@ -0,0 +1,499 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: reaction.proto
+
+package api
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListReactionEmojisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactionEmojisRequest) Reset() {
+	*x = ListReactionEmojisRequest{}
+	mi := &file_reaction_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactionEmojisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactionEmojisRequest) ProtoMessage() {}
+
+func (x *ListReactionEmojisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reaction_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactionEmojisRequest.ProtoReflect.Descriptor instead.
+func (*ListReactionEmojisRequest) Descriptor() ([]byte, []int) {
+	return file_reaction_proto_rawDescGZIP(), []int{0}
+}
+
+type ListReactionEmojisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emojis        []string               `protobuf:"bytes,1,rep,name=emojis,proto3" json:"emojis,omitempty"`
+	DefaultEmoji  string                 `protobuf:"bytes,2,opt,name=default_emoji,json=defaultEmoji,proto3" json:"default_emoji,omitempty"` // same as a like
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactionEmojisResponse) Reset() {
+	*x = ListReactionEmojisResponse{}
+	mi := &file_reaction_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactionEmojisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactionEmojisResponse) ProtoMessage() {}
+
+func (x *ListReactionEmojisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reaction_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactionEmojisResponse.ProtoReflect.Descriptor instead.
+func (*ListReactionEmojisResponse) Descriptor() ([]byte, []int) {
+	return file_reaction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListReactionEmojisResponse) GetEmojis() []string {
+	if x != nil {
+		return x.Emojis
+	}
+	return nil
+}
+
+func (x *ListReactionEmojisResponse) GetDefaultEmoji() string {
+	if x != nil {
+		return x.DefaultEmoji
+	}
+	return ""
+}
+
+type ReactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostUid       string                 `protobuf:"bytes,1,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	CommentUid    string                 `protobuf:"bytes,2,opt,name=comment_uid,json=commentUid,proto3" json:"comment_uid,omitempty"`
+	Emoji         string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactRequest) Reset() {
+	*x = ReactRequest{}
+	mi := &file_reaction_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactRequest) ProtoMessage() {}
+
+func (x *ReactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reaction_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactRequest.ProtoReflect.Descriptor instead.
+func (*ReactRequest) Descriptor() ([]byte, []int) {
+	return file_reaction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReactRequest) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+func (x *ReactRequest) GetCommentUid() string {
+	if x != nil {
+		return x.CommentUid
+	}
+	return ""
+}
+
+func (x *ReactRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type UnreactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostUid       string                 `protobuf:"bytes,1,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	CommentUid    string                 `protobuf:"bytes,2,opt,name=comment_uid,json=commentUid,proto3" json:"comment_uid,omitempty"`
+	Emoji         string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreactRequest) Reset() {
+	*x = UnreactRequest{}
+	mi := &file_reaction_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreactRequest) ProtoMessage() {}
+
+func (x *UnreactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reaction_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreactRequest.ProtoReflect.Descriptor instead.
+func (*UnreactRequest) Descriptor() ([]byte, []int) {
+	return file_reaction_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnreactRequest) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+func (x *UnreactRequest) GetCommentUid() string {
+	if x != nil {
+		return x.CommentUid
+	}
+	return ""
+}
+
+func (x *UnreactRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type ReactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reactions     []*Reaction            `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactResponse) Reset() {
+	*x = ReactResponse{}
+	mi := &file_reaction_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactResponse) ProtoMessage() {}
+
+func (x *ReactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reaction_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactResponse.ProtoReflect.Descriptor instead.
+func (*ReactResponse) Descriptor() ([]byte, []int) {
+	return file_reaction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReactResponse) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type ListReactorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostUid       string                 `protobuf:"bytes,1,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	CommentUid    string                 `protobuf:"bytes,2,opt,name=comment_uid,json=commentUid,proto3" json:"comment_uid,omitempty"`
+	Emoji         string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactorsRequest) Reset() {
+	*x = ListReactorsRequest{}
+	mi := &file_reaction_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactorsRequest) ProtoMessage() {}
+
+func (x *ListReactorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reaction_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactorsRequest.ProtoReflect.Descriptor instead.
+func (*ListReactorsRequest) Descriptor() ([]byte, []int) {
+	return file_reaction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListReactorsRequest) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+func (x *ListReactorsRequest) GetCommentUid() string {
+	if x != nil {
+		return x.CommentUid
+	}
+	return ""
+}
+
+func (x *ListReactorsRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ListReactorsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListReactorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactorsResponse) Reset() {
+	*x = ListReactorsResponse{}
+	mi := &file_reaction_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactorsResponse) ProtoMessage() {}
+
+func (x *ListReactorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reaction_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactorsResponse.ProtoReflect.Descriptor instead.
+func (*ListReactorsResponse) Descriptor() ([]byte, []int) {
+	return file_reaction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListReactorsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListReactorsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_reaction_proto protoreflect.FileDescriptor
+
+const file_reaction_proto_rawDesc = "" +
+	"\n" +
+	"\x0ereaction.proto\x12\breaction\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\fcommon.proto\"\x1b\n" +
+	"\x19ListReactionEmojisRequest\"c\n" +
+	"\x1aListReactionEmojisResponse\x12\x1b\n" +
+	"\x06emojis\x18\x01 \x03(\tB\x03\xe0A\x02R\x06emojis\x12(\n" +
+	"\rdefault_emoji\x18\x02 \x01(\tB\x03\xe0A\x02R\fdefaultEmoji\"e\n" +
+	"\fReactRequest\x12\x19\n" +
+	"\bpost_uid\x18\x01 \x01(\tR\apostUid\x12\x1f\n" +
+	"\vcomment_uid\x18\x02 \x01(\tR\n" +
+	"commentUid\x12\x19\n" +
+	"\x05emoji\x18\x03 \x01(\tB\x03\xe0A\x02R\x05emoji\"g\n" +
+	"\x0eUnreactRequest\x12\x19\n" +
+	"\bpost_uid\x18\x01 \x01(\tR\apostUid\x12\x1f\n" +
+	"\vcomment_uid\x18\x02 \x01(\tR\n" +
+	"commentUid\x12\x19\n" +
+	"\x05emoji\x18\x03 \x01(\tB\x03\xe0A\x02R\x05emoji\"D\n" +
+	"\rReactResponse\x123\n" +
+	"\treactions\x18\x01 \x03(\v2\x10.common.ReactionB\x03\xe0A\x02R\treactions\"\x8b\x01\n" +
+	"\x13ListReactorsRequest\x12\x19\n" +
+	"\bpost_uid\x18\x01 \x01(\tR\apostUid\x12\x1f\n" +
+	"\vcomment_uid\x18\x02 \x01(\tR\n" +
+	"commentUid\x12\x19\n" +
+	"\x05emoji\x18\x03 \x01(\tB\x03\xe0A\x02R\x05emoji\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"l\n" +
+	"\x14ListReactorsResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\x03\xe0A\x02R\rnextPageToken2\x91\x05\n" +
+	"\x0fReactionService\x12z\n" +
+	"\x12ListReactionEmojis\x12#.reaction.ListReactionEmojisRequest\x1a$.reaction.ListReactionEmojisResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/reactions\x12\x96\x01\n" +
+	"\x05React\x12\x16.reaction.ReactRequest\x1a\x17.reaction.ReactResponse\"\\\x82\xd3\xe4\x93\x02V:\x01*Z-:\x01*\"(/api/v1/comments/{comment_uid}/reactions\"\"/api/v1/posts/{post_uid}/reactions\x12\xa4\x01\n" +
+	"\aUnreact\x12\x18.reaction.UnreactRequest\x1a\x17.reaction.ReactResponse\"f\x82\xd3\xe4\x93\x02`Z2*0/api/v1/comments/{comment_uid}/reactions/{emoji}**/api/v1/posts/{post_uid}/reactions/{emoji}\x12\xc1\x01\n" +
+	"\fListReactors\x12\x1d.reaction.ListReactorsRequest\x1a\x1e.reaction.ListReactorsResponse\"r\x82\xd3\xe4\x93\x02lZ8\x126/api/v1/comments/{comment_uid}/reactions/{emoji}/users\x120/api/v1/posts/{post_uid}/reactions/{emoji}/usersB\x0fZ\raeibi/api;apib\x06proto3"
+
+var (
+	file_reaction_proto_rawDescOnce sync.Once
+	file_reaction_proto_rawDescData []byte
+)
+
+func file_reaction_proto_rawDescGZIP() []byte {
+	file_reaction_proto_rawDescOnce.Do(func() {
+		file_reaction_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reaction_proto_rawDesc), len(file_reaction_proto_rawDesc)))
+	})
+	return file_reaction_proto_rawDescData
+}
+
+var file_reaction_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_reaction_proto_goTypes = []any{
+	(*ListReactionEmojisRequest)(nil),  // 0: reaction.ListReactionEmojisRequest
+	(*ListReactionEmojisResponse)(nil), // 1: reaction.ListReactionEmojisResponse
+	(*ReactRequest)(nil),               // 2: reaction.ReactRequest
+	(*UnreactRequest)(nil),             // 3: reaction.UnreactRequest
+	(*ReactResponse)(nil),              // 4: reaction.ReactResponse
+	(*ListReactorsRequest)(nil),        // 5: reaction.ListReactorsRequest
+	(*ListReactorsResponse)(nil),       // 6: reaction.ListReactorsResponse
+	(*Reaction)(nil),                   // 7: common.Reaction
+	(*User)(nil),                       // 8: common.User
+}
+var file_reaction_proto_depIdxs = []int32{
+	7, // 0: reaction.ReactResponse.reactions:type_name -> common.Reaction
+	8, // 1: reaction.ListReactorsResponse.users:type_name -> common.User
+	0, // 2: reaction.ReactionService.ListReactionEmojis:input_type -> reaction.ListReactionEmojisRequest
+	2, // 3: reaction.ReactionService.React:input_type -> reaction.ReactRequest
+	3, // 4: reaction.ReactionService.Unreact:input_type -> reaction.UnreactRequest
+	5, // 5: reaction.ReactionService.ListReactors:input_type -> reaction.ListReactorsRequest
+	1, // 6: reaction.ReactionService.ListReactionEmojis:output_type -> reaction.ListReactionEmojisResponse
+	4, // 7: reaction.ReactionService.React:output_type -> reaction.ReactResponse
+	4, // 8: reaction.ReactionService.Unreact:output_type -> reaction.ReactResponse
+	6, // 9: reaction.ReactionService.ListReactors:output_type -> reaction.ListReactorsResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_reaction_proto_init() }
+func file_reaction_proto_init() {
+	if File_reaction_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reaction_proto_rawDesc), len(file_reaction_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reaction_proto_goTypes,
+		DependencyIndexes: file_reaction_proto_depIdxs,
+		MessageInfos:      file_reaction_proto_msgTypes,
+	}.Build()
+	File_reaction_proto = out.File
+	file_reaction_proto_goTypes = nil
+	file_reaction_proto_depIdxs = nil
+}
@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: reaction.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReactionService_ListReactionEmojis_FullMethodName = "/reaction.ReactionService/ListReactionEmojis"
+	ReactionService_React_FullMethodName              = "/reaction.ReactionService/React"
+	ReactionService_Unreact_FullMethodName            = "/reaction.ReactionService/Unreact"
+	ReactionService_ListReactors_FullMethodName       = "/reaction.ReactionService/ListReactors"
+)
+
+// ReactionServiceClient is the client API for ReactionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReactionService 帖子与评论的表情回应。默认表情即点赞。
+type ReactionServiceClient interface {
+	// GET /api/v1/reactions 可用的表情
+	ListReactionEmojis(ctx context.Context, in *ListReactionEmojisRequest, opts ...grpc.CallOption) (*ListReactionEmojisResponse, error)
+	// POST /api/v1/posts/{post_uid}/reactions 添加表情回应
+	React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*ReactResponse, error)
+	// DELETE /api/v1/posts/{post_uid}/reactions/{emoji} 取消表情回应
+	Unreact(ctx context.Context, in *UnreactRequest, opts ...grpc.CallOption) (*ReactResponse, error)
+	// GET /api/v1/posts/{post_uid}/reactions/{emoji}/users 某个表情的回应用户列表
+	ListReactors(ctx context.Context, in *ListReactorsRequest, opts ...grpc.CallOption) (*ListReactorsResponse, error)
+}
+
+type reactionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReactionServiceClient(cc grpc.ClientConnInterface) ReactionServiceClient {
+	return &reactionServiceClient{cc}
+}
+
+func (c *reactionServiceClient) ListReactionEmojis(ctx context.Context, in *ListReactionEmojisRequest, opts ...grpc.CallOption) (*ListReactionEmojisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReactionEmojisResponse)
+	err := c.cc.Invoke(ctx, ReactionService_ListReactionEmojis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reactionServiceClient) React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*ReactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactResponse)
+	err := c.cc.Invoke(ctx, ReactionService_React_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reactionServiceClient) Unreact(ctx context.Context, in *UnreactRequest, opts ...grpc.CallOption) (*ReactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactResponse)
+	err := c.cc.Invoke(ctx, ReactionService_Unreact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reactionServiceClient) ListReactors(ctx context.Context, in *ListReactorsRequest, opts ...grpc.CallOption) (*ListReactorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReactorsResponse)
+	err := c.cc.Invoke(ctx, ReactionService_ListReactors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReactionServiceServer is the server API for ReactionService service.
+// All implementations must embed UnimplementedReactionServiceServer
+// for forward compatibility.
+//
+// ReactionService 帖子与评论的表情回应。默认表情即点赞。
+type ReactionServiceServer interface {
+	// GET /api/v1/reactions 可用的表情
+	ListReactionEmojis(context.Context, *ListReactionEmojisRequest) (*ListReactionEmojisResponse, error)
+	// POST /api/v1/posts/{post_uid}/reactions 添加表情回应
+	React(context.Context, *ReactRequest) (*ReactResponse, error)
+	// DELETE /api/v1/posts/{post_uid}/reactions/{emoji} 取消表情回应
+	Unreact(context.Context, *UnreactRequest) (*ReactResponse, error)
+	// GET /api/v1/posts/{post_uid}/reactions/{emoji}/users 某个表情的回应用户列表
+	ListReactors(context.Context, *ListReactorsRequest) (*ListReactorsResponse, error)
+	mustEmbedUnimplementedReactionServiceServer()
+}
+
+// UnimplementedReactionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReactionServiceServer struct{}
+
+func (UnimplementedReactionServiceServer) ListReactionEmojis(context.Context, *ListReactionEmojisRequest) (*ListReactionEmojisResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReactionEmojis not implemented")
+}
+func (UnimplementedReactionServiceServer) React(context.Context, *ReactRequest) (*ReactResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method React not implemented")
+}
+func (UnimplementedReactionServiceServer) Unreact(context.Context, *UnreactRequest) (*ReactResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Unreact not implemented")
+}
+func (UnimplementedReactionServiceServer) ListReactors(context.Context, *ListReactorsRequest) (*ListReactorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReactors not implemented")
+}
+func (UnimplementedReactionServiceServer) mustEmbedUnimplementedReactionServiceServer() {}
+func (UnimplementedReactionServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeReactionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReactionServiceServer will
+// result in compilation errors.
+type UnsafeReactionServiceServer interface {
+	mustEmbedUnimplementedReactionServiceServer()
+}
+
+func RegisterReactionServiceServer(s grpc.ServiceRegistrar, srv ReactionServiceServer) {
+	// If the following call panics, it indicates UnimplementedReactionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReactionService_ServiceDesc, srv)
+}
+
+func _ReactionService_ListReactionEmojis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReactionEmojisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReactionServiceServer).ListReactionEmojis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReactionService_ListReactionEmojis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReactionServiceServer).ListReactionEmojis(ctx, req.(*ListReactionEmojisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReactionService_React_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReactionServiceServer).React(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReactionService_React_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReactionServiceServer).React(ctx, req.(*ReactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReactionService_Unreact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnreactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReactionServiceServer).Unreact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReactionService_Unreact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReactionServiceServer).Unreact(ctx, req.(*UnreactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReactionService_ListReactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReactorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReactionServiceServer).ListReactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReactionService_ListReactors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReactionServiceServer).ListReactors(ctx, req.(*ListReactorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReactionService_ServiceDesc is the grpc.ServiceDesc for ReactionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReactionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reaction.ReactionService",
+	HandlerType: (*ReactionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListReactionEmojis",
+			Handler:    _ReactionService_ListReactionEmojis_Handler,
+		},
+		{
+			MethodName: "React",
+			Handler:    _ReactionService_React_Handler,
+		},
+		{
+			MethodName: "Unreact",
+			Handler:    _ReactionService_Unreact_Handler,
+		},
+		{
+			MethodName: "ListReactors",
+			Handler:    _ReactionService_ListReactors_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reaction.proto",
+}
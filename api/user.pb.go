@@ -357,10 +357,12 @@ func (x *SuggestUsersByPrefixResponse) GetUsers() []*User {
 }
 
 type GetMeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	User                     *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	SensitiveMediaPreference string                 `protobuf:"bytes,2,opt,name=sensitive_media_preference,json=sensitiveMediaPreference,proto3" json:"sensitive_media_preference,omitempty"` // SHOW / HIDE / FILTER
+	HideFollowLists          bool                   `protobuf:"varint,3,opt,name=hide_follow_lists,json=hideFollowLists,proto3" json:"hide_follow_lists,omitempty"`                           // followers/following hidden from others
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *GetMeResponse) Reset() {
@@ -400,14 +402,30 @@ func (x *GetMeResponse) GetUser() *User {
 	return nil
 }
 
+func (x *GetMeResponse) GetSensitiveMediaPreference() string {
+	if x != nil {
+		return x.SensitiveMediaPreference
+	}
+	return ""
+}
+
+func (x *GetMeResponse) GetHideFollowLists() bool {
+	if x != nil {
+		return x.HideFollowLists
+	}
+	return false
+}
+
 type UpdateMeUser struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Username                 string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Email                    string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Nickname                 string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarUrl                string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	SensitiveMediaPreference string                 `protobuf:"bytes,5,opt,name=sensitive_media_preference,json=sensitiveMediaPreference,proto3" json:"sensitive_media_preference,omitempty"` // SHOW / HIDE / FILTER
+	HideFollowLists          bool                   `protobuf:"varint,6,opt,name=hide_follow_lists,json=hideFollowLists,proto3" json:"hide_follow_lists,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *UpdateMeUser) Reset() {
@@ -468,6 +486,20 @@ func (x *UpdateMeUser) GetAvatarUrl() string {
 	return ""
 }
 
+func (x *UpdateMeUser) GetSensitiveMediaPreference() string {
+	if x != nil {
+		return x.SensitiveMediaPreference
+	}
+	return ""
+}
+
+func (x *UpdateMeUser) GetHideFollowLists() bool {
+	if x != nil {
+		return x.HideFollowLists
+	}
+	return false
+}
+
 type UpdateMeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *UpdateMeUser          `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -847,15 +879,19 @@ const file_user_proto_rawDesc = "" +
 	"\x1bSuggestUsersByPrefixRequest\x12\x1b\n" +
 	"\x06prefix\x18\x01 \x01(\tB\x03\xe0A\x02R\x06prefix\"G\n" +
 	"\x1cSuggestUsersByPrefixResponse\x12'\n" +
-	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\"6\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\"\xaa\x01\n" +
 	"\rGetMeResponse\x12%\n" +
-	"\x04user\x18\x01 \x01(\v2\f.common.UserB\x03\xe0A\x02R\x04user\"{\n" +
+	"\x04user\x18\x01 \x01(\v2\f.common.UserB\x03\xe0A\x02R\x04user\x12A\n" +
+	"\x1asensitive_media_preference\x18\x02 \x01(\tB\x03\xe0A\x02R\x18sensitiveMediaPreference\x12/\n" +
+	"\x11hide_follow_lists\x18\x03 \x01(\bB\x03\xe0A\x02R\x0fhideFollowLists\"\xe5\x01\n" +
 	"\fUpdateMeUser\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
 	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x1d\n" +
 	"\n" +
-	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\"\x80\x01\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12<\n" +
+	"\x1asensitive_media_preference\x18\x05 \x01(\tR\x18sensitiveMediaPreference\x12*\n" +
+	"\x11hide_follow_lists\x18\x06 \x01(\bR\x0fhideFollowLists\"\x80\x01\n" +
 	"\x0fUpdateMeRequest\x12+\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.user.UpdateMeUserB\x03\xe0A\x02R\x04user\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
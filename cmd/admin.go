@@ -0,0 +1,105 @@
+package main
+
+import (
+	"aeibi/internal/async"
+	"aeibi/internal/config"
+	"aeibi/internal/env"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	adminCmd.AddCommand(reconcileCountersCmd)
+	adminCmd.AddCommand(reindexCommentsCmd)
+	rootCmd.AddCommand(adminCmd)
+}
+
+var adminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "Maintenance commands for operators",
+}
+
+var reconcileCountersCmd = &cobra.Command{
+	Use:   "reconcile-counters",
+	Short: "Recount like, collection, comment, reply and follow counters and fix drift",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPath, err := cmd.Flags().GetString("config")
+		if err != nil {
+			return err
+		}
+
+		cfg, err := config.Load(configPath)
+		if err != nil {
+			return err
+		}
+
+		return RunReconcileCounters(cmd.Context(), cfg, cmd.OutOrStdout())
+	},
+}
+
+// RunReconcileCounters fixes drifted counters once and prints every change.
+// Search reindexing is enqueued and picked up by the running server.
+func RunReconcileCounters(ctx context.Context, cfg *config.Config, out io.Writer) error {
+	dbPool, err := env.InitDB(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer dbPool.Close()
+
+	riverClient, err := env.InitRiverInsertClient(dbPool)
+	if err != nil {
+		return err
+	}
+
+	drifted, err := async.NewCounterReconciler(dbPool).Reconcile(ctx, async.New(riverClient), func(d async.CounterDrift) {
+		fmt.Fprintf(out, "%s %s %s: %d -> %d\n", d.Table, d.UID, d.Column, d.Stored, d.Actual)
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%d counters fixed\n", drifted)
+	return nil
+}
+
+var reindexCommentsCmd = &cobra.Command{
+	Use:   "reindex-comments",
+	Short: "Queue every commented post for comment search indexing",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPath, err := cmd.Flags().GetString("config")
+		if err != nil {
+			return err
+		}
+
+		cfg, err := config.Load(configPath)
+		if err != nil {
+			return err
+		}
+
+		return RunReindexComments(cmd.Context(), cfg, cmd.OutOrStdout())
+	},
+}
+
+// RunReindexComments backfills the comments search index. The jobs are
+// enqueued here and worked off by the running server.
+func RunReindexComments(ctx context.Context, cfg *config.Config, out io.Writer) error {
+	dbPool, err := env.InitDB(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer dbPool.Close()
+
+	riverClient, err := env.InitRiverInsertClient(dbPool)
+	if err != nil {
+		return err
+	}
+
+	queued, err := async.BackfillCommentSearch(ctx, dbPool, async.New(riverClient))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%d posts queued for comment reindexing\n", queued)
+	return nil
+}
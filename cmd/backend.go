@@ -55,7 +55,13 @@ func RunBackend(ctx context.Context, cfg *config.Config) error {
 	}
 	defer searchRepo.Close()
 
-	riverClient, err := env.InitRiverClient(dbPool, searchRepo)
+	geoResolver, err := env.InitGeoIP(cfg.GeoIP)
+	if err != nil {
+		return err
+	}
+	defer geoResolver.Close()
+
+	riverClient, err := env.InitRiverClient(dbPool, searchRepo, ossClient)
 	if err != nil {
 		return err
 	}
@@ -66,7 +72,7 @@ func RunBackend(ctx context.Context, cfg *config.Config) error {
 	}
 
 	// Start gRPC server
-	grpcServer, grpcErrCh, err := server.StartGRPCServer(ctx, cfg, dbPool, ossClient, searchRepo, riverClient)
+	grpcServer, grpcErrCh, err := server.StartGRPCServer(ctx, cfg, dbPool, ossClient, searchRepo, geoResolver, riverClient)
 	if err != nil {
 		stopCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 		defer cancel()
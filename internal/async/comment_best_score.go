@@ -0,0 +1,67 @@
+package async
+
+import (
+	"aeibi/internal/repository/db"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+const (
+	QueueCommentBestScore = "comment_best_score"
+
+	commentBestScoreInterval = 10 * time.Minute
+	// commentBestScoreWindowHours is how long a comment's age keeps
+	// discounting its score.
+	commentBestScoreWindowHours = 7 * 24
+	// commentBestScoreGravity controls how fast engagement decays with age.
+	commentBestScoreGravity = 1.5
+)
+
+// RefreshCommentBestScoresArgs recomputes post_comments.best_score, a
+// time-decayed score over likes and replies used by the "best" comment sort.
+type RefreshCommentBestScoresArgs struct{}
+
+func (RefreshCommentBestScoresArgs) Kind() string {
+	return "comment.best_score.refresh"
+}
+
+type RefreshCommentBestScoresWorker struct {
+	river.WorkerDefaults[RefreshCommentBestScoresArgs]
+	db *db.Queries
+}
+
+func NewRefreshCommentBestScoresWorker(pool *pgxpool.Pool) *RefreshCommentBestScoresWorker {
+	return &RefreshCommentBestScoresWorker{
+		db: db.New(pool),
+	}
+}
+
+func (w *RefreshCommentBestScoresWorker) Work(ctx context.Context, job *river.Job[RefreshCommentBestScoresArgs]) error {
+	// Look back two intervals so a late or skipped run does not miss changes.
+	changedSince := time.Now().Add(-2 * commentBestScoreInterval)
+	if err := w.db.RefreshCommentBestScores(ctx, db.RefreshCommentBestScoresParams{
+		WindowHours:  commentBestScoreWindowHours,
+		Gravity:      commentBestScoreGravity,
+		ChangedSince: pgtype.Timestamptz{Time: changedSince, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("refresh comment best scores: %w", err)
+	}
+	return nil
+}
+
+func NewRefreshCommentBestScoresPeriodicJob() *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(commentBestScoreInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return RefreshCommentBestScoresArgs{}, &river.InsertOpts{
+				Queue: QueueCommentBestScore,
+			}
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	)
+}
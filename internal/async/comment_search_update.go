@@ -0,0 +1,183 @@
+package async
+
+import (
+	"aeibi/internal/repository/db"
+	searchrepo "aeibi/internal/repository/search"
+	"aeibi/util"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+type CommentSearchAction string
+
+const (
+	CommentSearchActionUpsert CommentSearchAction = "upsert"
+	CommentSearchActionDelete CommentSearchAction = "delete"
+	// CommentSearchActionSyncPost reindexes every comment under PostUID, used
+	// when the post's visibility or status changes.
+	CommentSearchActionSyncPost CommentSearchAction = "sync_post"
+	QueueCommentSearch                              = "search_comment_update"
+)
+
+type UpdateCommentSearchArgs struct {
+	CommentUID uuid.UUID           `json:"comment_uid,omitempty"`
+	PostUID    uuid.UUID           `json:"post_uid,omitempty"`
+	Action     CommentSearchAction `json:"action"`
+}
+
+func (UpdateCommentSearchArgs) Kind() string {
+	return "search.comment.update"
+}
+
+type UpdateCommentSearchWorker struct {
+	river.WorkerDefaults[UpdateCommentSearchArgs]
+	db     *db.Queries
+	search *searchrepo.Search
+}
+
+func NewUpdateCommentSearchWorker(pool *pgxpool.Pool, search *searchrepo.Search) *UpdateCommentSearchWorker {
+	return &UpdateCommentSearchWorker{
+		db:     db.New(pool),
+		search: search,
+	}
+}
+
+func (w *UpdateCommentSearchWorker) Work(ctx context.Context, job *river.Job[UpdateCommentSearchArgs]) error {
+	switch job.Args.Action {
+	case "", CommentSearchActionUpsert:
+		if job.Args.CommentUID == uuid.Nil {
+			return fmt.Errorf("comment uid is required")
+		}
+
+		row, err := w.db.GetCommentSearchDocByUid(ctx, job.Args.CommentUID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("get comment search doc: %w", err)
+		}
+		if errors.Is(err, pgx.ErrNoRows) || row.Status != db.CommentStatusNORMAL || row.PostStatus != db.PostStatusNORMAL {
+			if err := w.search.DeleteCommentsByUIDs([]string{job.Args.CommentUID.String()}); err != nil {
+				return fmt.Errorf("delete missing comment from search: %w", err)
+			}
+			return nil
+		}
+
+		if err := w.search.UpsertComments([]searchrepo.CommentDocument{commentSearchDocument(db.ListCommentSearchDocsByPostUidRow(row))}); err != nil {
+			return fmt.Errorf("upsert comment to search: %w", err)
+		}
+		return nil
+
+	case CommentSearchActionDelete:
+		if job.Args.CommentUID == uuid.Nil {
+			return fmt.Errorf("comment uid is required")
+		}
+		if err := w.search.DeleteCommentsByUIDs([]string{job.Args.CommentUID.String()}); err != nil {
+			return fmt.Errorf("delete comment from search: %w", err)
+		}
+		return nil
+
+	case CommentSearchActionSyncPost:
+		if job.Args.PostUID == uuid.Nil {
+			return fmt.Errorf("post uid is required")
+		}
+
+		rows, err := w.db.ListCommentSearchDocsByPostUid(ctx, job.Args.PostUID)
+		if err != nil {
+			return fmt.Errorf("list comment search docs: %w", err)
+		}
+
+		docs := make([]searchrepo.CommentDocument, 0, len(rows))
+		removed := make([]string, 0)
+		for _, row := range rows {
+			if row.PostStatus != db.PostStatusNORMAL {
+				removed = append(removed, row.Uid.String())
+				continue
+			}
+			docs = append(docs, commentSearchDocument(row))
+		}
+		if err := w.search.UpsertComments(docs); err != nil {
+			return fmt.Errorf("upsert post comments to search: %w", err)
+		}
+		if err := w.search.DeleteCommentsByUIDs(removed); err != nil {
+			return fmt.Errorf("delete post comments from search: %w", err)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported comment search action: %q", job.Args.Action)
+	}
+}
+
+func commentSearchDocument(row db.ListCommentSearchDocsByPostUidRow) searchrepo.CommentDocument {
+	return searchrepo.CommentDocument{
+		UID:            row.Uid.String(),
+		PostUID:        row.PostUid.String(),
+		RootUID:        row.RootUid.String(),
+		ParentUID:      util.NullUUIDString(row.ParentUid),
+		AuthorUID:      row.AuthorUid.String(),
+		AuthorNickname: row.AuthorNickname,
+		Content:        row.Content,
+		Images:         row.Images,
+		ReplyCount:     int(row.ReplyCount),
+		LikeCount:      int(row.LikeCount),
+		PostAuthorUID:  row.PostAuthorUid.String(),
+		PostVisibility: string(row.PostVisibility),
+		Status:         string(row.Status),
+		CreatedAt:      row.CreatedAt.Time.Unix(),
+		UpdatedAt:      row.UpdatedAt.Time.Unix(),
+	}
+}
+
+const commentSearchBackfillBatchSize = 500
+
+// BackfillCommentSearch enqueues a sync_post job for every post that has
+// comments, so comments written before the index existed become searchable.
+// It returns the number of posts queued.
+func BackfillCommentSearch(ctx context.Context, pool *pgxpool.Pool, producer *Producer) (int, error) {
+	queries := db.New(pool)
+	total := 0
+	after := uuid.Nil
+	for {
+		postUids, err := queries.ListPostUidsWithCommentsAfter(ctx, db.ListPostUidsWithCommentsAfterParams{
+			AfterUid:   after,
+			LimitCount: commentSearchBackfillBatchSize,
+		})
+		if err != nil {
+			return total, fmt.Errorf("list post uids with comments: %w", err)
+		}
+		if len(postUids) == 0 {
+			return total, nil
+		}
+
+		if err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+			for _, postUid := range postUids {
+				if err := producer.EnqueueUpdateCommentSearchTx(ctx, tx, UpdateCommentSearchArgs{
+					PostUID: postUid,
+					Action:  CommentSearchActionSyncPost,
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return total, err
+		}
+		total += len(postUids)
+		after = postUids[len(postUids)-1]
+	}
+}
+
+func (p *Producer) EnqueueUpdateCommentSearchTx(ctx context.Context, tx pgx.Tx, args UpdateCommentSearchArgs) error {
+	_, err := p.Client.InsertTx(ctx, tx, args, &river.InsertOpts{
+		Queue: QueueCommentSearch,
+	})
+	if err != nil {
+		return fmt.Errorf("insert update comment search job: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package async
+
+import (
+	"aeibi/internal/repository/db"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+const (
+	QueueCounterReconcile = "counter_reconcile"
+
+	counterReconcileInterval = 6 * time.Hour
+	// counterReconcileChunk is how many ids are recounted per transaction.
+	counterReconcileChunk = 1000
+)
+
+// CounterDrift is one denormalized counter that disagreed with its edge table.
+type CounterDrift struct {
+	Table  string
+	UID    uuid.UUID
+	Column string
+	Stored int32
+	Actual int32
+}
+
+// CounterReconciler recomputes like, collection, comment, reply and follow
+// counters from their edge tables and fixes the ones that drifted. A write
+// racing with a chunk can leave a small drift behind; the next run fixes it.
+type CounterReconciler struct {
+	pool *pgxpool.Pool
+	db   *db.Queries
+}
+
+func NewCounterReconciler(pool *pgxpool.Pool) *CounterReconciler {
+	return &CounterReconciler{
+		pool: pool,
+		db:   db.New(pool),
+	}
+}
+
+// Reconcile walks posts, comments and users in id chunks, calls report for
+// every drifted counter and enqueues search reindexing for the affected posts
+// and users. It returns the number of drifted counters.
+func (r *CounterReconciler) Reconcile(ctx context.Context, producer *Producer, report func(CounterDrift)) (int, error) {
+	var total int
+	count := func(d CounterDrift) {
+		total++
+		if report != nil {
+			report(d)
+		}
+	}
+
+	if err := r.reconcilePosts(ctx, producer, count); err != nil {
+		return total, err
+	}
+	if err := r.reconcileComments(ctx, producer, count); err != nil {
+		return total, err
+	}
+	if err := r.reconcileUsers(ctx, producer, count); err != nil {
+		return total, err
+	}
+	return total, nil
+}
+
+func (r *CounterReconciler) reconcilePosts(ctx context.Context, producer *Producer, report func(CounterDrift)) error {
+	maxID, err := r.db.GetMaxPostID(ctx)
+	if err != nil {
+		return fmt.Errorf("get max post id: %w", err)
+	}
+
+	for afterID := int32(0); afterID < maxID; afterID += counterReconcileChunk {
+		if err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+			rows, err := r.db.WithTx(tx).ReconcilePostCounters(ctx, db.ReconcilePostCountersParams{
+				AfterID:   afterID,
+				ThroughID: afterID + counterReconcileChunk,
+			})
+			if err != nil {
+				return fmt.Errorf("reconcile post counters: %w", err)
+			}
+			for _, row := range rows {
+				reportDrift(report, "posts", row.Uid, "like_count", row.StoredLikeCount, row.LikeCount)
+				reportDrift(report, "posts", row.Uid, "collection_count", row.StoredCollectionCount, row.CollectionCount)
+				reportDrift(report, "posts", row.Uid, "comment_count", row.StoredCommentCount, row.CommentCount)
+				if err := producer.EnqueueUpdatePostSearchTx(ctx, tx, UpdatePostSearchArgs{
+					PostUID: row.Uid,
+					Action:  PostSearchActionUpsert,
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *CounterReconciler) reconcileComments(ctx context.Context, producer *Producer, report func(CounterDrift)) error {
+	maxID, err := r.db.GetMaxCommentID(ctx)
+	if err != nil {
+		return fmt.Errorf("get max comment id: %w", err)
+	}
+
+	for afterID := int32(0); afterID < maxID; afterID += counterReconcileChunk {
+		if err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+			rows, err := r.db.WithTx(tx).ReconcileCommentCounters(ctx, db.ReconcileCommentCountersParams{
+				AfterID:   afterID,
+				ThroughID: afterID + counterReconcileChunk,
+			})
+			if err != nil {
+				return fmt.Errorf("reconcile comment counters: %w", err)
+			}
+			for _, row := range rows {
+				reportDrift(report, "post_comments", row.Uid, "like_count", row.StoredLikeCount, row.LikeCount)
+				reportDrift(report, "post_comments", row.Uid, "reply_count", row.StoredReplyCount, row.ReplyCount)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *CounterReconciler) reconcileUsers(ctx context.Context, producer *Producer, report func(CounterDrift)) error {
+	maxID, err := r.db.GetMaxUserID(ctx)
+	if err != nil {
+		return fmt.Errorf("get max user id: %w", err)
+	}
+
+	for afterID := int32(0); afterID < maxID; afterID += counterReconcileChunk {
+		if err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+			rows, err := r.db.WithTx(tx).ReconcileUserCounters(ctx, db.ReconcileUserCountersParams{
+				AfterID:   afterID,
+				ThroughID: afterID + counterReconcileChunk,
+			})
+			if err != nil {
+				return fmt.Errorf("reconcile user counters: %w", err)
+			}
+			for _, row := range rows {
+				reportDrift(report, "users", row.Uid, "followers_count", row.StoredFollowersCount, row.FollowersCount)
+				reportDrift(report, "users", row.Uid, "following_count", row.StoredFollowingCount, row.FollowingCount)
+				if err := producer.EnqueueUpdateUserSearchTx(ctx, tx, UpdateUserSearchArgs{
+					UserUID: row.Uid,
+					Action:  UserSearchActionUpsert,
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func reportDrift(report func(CounterDrift), table string, uid uuid.UUID, column string, stored, actual int32) {
+	if stored == actual {
+		return
+	}
+	report(CounterDrift{
+		Table:  table,
+		UID:    uid,
+		Column: column,
+		Stored: stored,
+		Actual: actual,
+	})
+}
+
+// ReconcileCountersArgs runs a CounterReconciler pass.
+type ReconcileCountersArgs struct{}
+
+func (ReconcileCountersArgs) Kind() string {
+	return "counters.reconcile"
+}
+
+type ReconcileCountersWorker struct {
+	river.WorkerDefaults[ReconcileCountersArgs]
+	reconciler *CounterReconciler
+}
+
+func NewReconcileCountersWorker(pool *pgxpool.Pool) *ReconcileCountersWorker {
+	return &ReconcileCountersWorker{
+		reconciler: NewCounterReconciler(pool),
+	}
+}
+
+func (w *ReconcileCountersWorker) Timeout(*river.Job[ReconcileCountersArgs]) time.Duration {
+	return time.Hour
+}
+
+func (w *ReconcileCountersWorker) Work(ctx context.Context, job *river.Job[ReconcileCountersArgs]) error {
+	producer := New(river.ClientFromContext[pgx.Tx](ctx))
+	drifted, err := w.reconciler.Reconcile(ctx, producer, func(d CounterDrift) {
+		slog.Warn("counter drift fixed",
+			"table", d.Table,
+			"uid", d.UID,
+			"column", d.Column,
+			"stored", d.Stored,
+			"actual", d.Actual,
+		)
+	})
+	if err != nil {
+		return err
+	}
+	if drifted > 0 {
+		slog.Info("counters reconciled", "drifted", drifted)
+	}
+	return nil
+}
+
+func NewReconcileCountersPeriodicJob() *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(counterReconcileInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return ReconcileCountersArgs{}, &river.InsertOpts{
+				Queue: QueueCounterReconcile,
+			}
+		},
+		nil,
+	)
+}
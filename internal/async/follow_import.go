@@ -0,0 +1,423 @@
+package async
+
+import (
+	"aeibi/internal/repository/db"
+	"aeibi/internal/repository/oss"
+	"aeibi/util"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+const (
+	QueueFollowImport = "follow_import"
+
+	// MaxFollowImportEntries caps how many rows of one file are followed;
+	// the rest are counted as skipped.
+	MaxFollowImportEntries = 5000
+
+	followImportBatchSize = 50
+	followImportInterval  = time.Minute
+)
+
+// FollowImportArgs follows the users listed in an uploaded CSV file on behalf
+// of the importing user. The job handles one batch per run and snoozes between
+// batches, so a large import is spread out over time instead of flooding the
+// follow graph and the inbox of the followed users.
+type FollowImportArgs struct {
+	ImportUID uuid.UUID `json:"import_uid"`
+}
+
+func (FollowImportArgs) Kind() string {
+	return "follow.import"
+}
+
+type FollowImportWorker struct {
+	river.WorkerDefaults[FollowImportArgs]
+	pool *pgxpool.Pool
+	db   *db.Queries
+	oss  *oss.OSS
+}
+
+func NewFollowImportWorker(pool *pgxpool.Pool, ossClient *oss.OSS) *FollowImportWorker {
+	return &FollowImportWorker{
+		pool: pool,
+		db:   db.New(pool),
+		oss:  ossClient,
+	}
+}
+
+// followImportFileError is a problem with the uploaded file itself; it fails
+// the import instead of being retried.
+type followImportFileError struct {
+	reason string
+}
+
+func (e *followImportFileError) Error() string {
+	return e.reason
+}
+
+type followImportEntry struct {
+	uid      uuid.UUID
+	hasUID   bool
+	nickname string
+}
+
+func (w *FollowImportWorker) Work(ctx context.Context, job *river.Job[FollowImportArgs]) error {
+	imp, err := w.db.GetFollowImportByUid(ctx, job.Args.ImportUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("get follow import: %w", err)
+	}
+	if imp.Status != db.FollowImportStatusRUNNING {
+		return nil
+	}
+
+	// The file is parsed once; later batches read the stored entries.
+	if !imp.ParsedAt.Valid {
+		if err := w.storeEntries(ctx, &imp); err != nil {
+			var fileErr *followImportFileError
+			if !errors.As(err, &fileErr) {
+				return w.failOnLastAttempt(ctx, job, imp, err)
+			}
+			return w.finish(ctx, imp, db.UpdateFollowImportProgressParams{
+				Uid:            imp.Uid,
+				Status:         db.FollowImportStatusFAILED,
+				TotalCount:     imp.TotalCount,
+				ProcessedCount: imp.ProcessedCount,
+				Error:          fileErr.reason,
+			})
+		}
+	}
+
+	running, err := w.processBatch(ctx, imp)
+	if err != nil {
+		return w.failOnLastAttempt(ctx, job, imp, err)
+	}
+	if running {
+		return river.JobSnooze(followImportInterval)
+	}
+	return nil
+}
+
+// storeEntries parses the import file and stores the entries that will be
+// followed, so retries and later batches do not download the file again.
+func (w *FollowImportWorker) storeEntries(ctx context.Context, imp *db.GetFollowImportByUidRow) error {
+	entries, err := w.readEntries(ctx, imp.FileUrl)
+	if err != nil {
+		return err
+	}
+
+	// total_count keeps every parsed row so the rows past the cap are counted
+	// as skipped by the last batch; only the followed rows are stored.
+	total := int32(len(entries))
+	entries = entries[:min(len(entries), MaxFollowImportEntries)]
+	uids := make([]uuid.UUID, len(entries))
+	nicknames := make([]string, len(entries))
+	for i, entry := range entries {
+		uids[i] = entry.uid
+		nicknames[i] = entry.nickname
+	}
+	if err := pgx.BeginFunc(ctx, w.pool, func(tx pgx.Tx) error {
+		qtx := w.db.WithTx(tx)
+		if err := qtx.CreateFollowImportEntries(ctx, db.CreateFollowImportEntriesParams{
+			ImportUid: imp.Uid,
+			Uids:      uids,
+			Nicknames: nicknames,
+		}); err != nil {
+			return fmt.Errorf("create follow import entries: %w", err)
+		}
+		if err := qtx.MarkFollowImportParsed(ctx, db.MarkFollowImportParsedParams{
+			Uid:        imp.Uid,
+			TotalCount: total,
+		}); err != nil {
+			return fmt.Errorf("mark follow import parsed: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	imp.TotalCount = total
+	imp.ParsedAt.Valid = true
+	return nil
+}
+
+// processBatch follows the next batch of stored entries and reports whether
+// the import is still running.
+func (w *FollowImportWorker) processBatch(ctx context.Context, imp db.GetFollowImportByUidRow) (bool, error) {
+	total := int(imp.TotalCount)
+	limit := min(total, MaxFollowImportEntries)
+	start := min(int(imp.ProcessedCount), limit)
+	end := min(start+followImportBatchSize, limit)
+
+	rows, err := w.db.ListFollowImportEntries(ctx, db.ListFollowImportEntriesParams{
+		ImportUid:     imp.Uid,
+		StartPosition: int32(start),
+		LimitCount:    int32(end - start),
+	})
+	if err != nil {
+		return false, fmt.Errorf("list follow import entries: %w", err)
+	}
+	entries := make([]followImportEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, followImportEntry{
+			uid:      row.Uid.UUID,
+			hasUID:   row.Uid.Valid,
+			nickname: row.Nickname,
+		})
+	}
+
+	progress := db.UpdateFollowImportProgressParams{
+		Uid:            imp.Uid,
+		Status:         db.FollowImportStatusRUNNING,
+		TotalCount:     imp.TotalCount,
+		ProcessedCount: int32(end),
+	}
+	if end >= limit {
+		progress.Status = db.FollowImportStatusDONE
+		progress.SkippedCount = int32(total - limit)
+	}
+
+	if err := pgx.BeginFunc(ctx, w.pool, func(tx pgx.Tx) error {
+		if err := w.followBatch(ctx, tx, imp.UserUid, entries, &progress); err != nil {
+			return err
+		}
+		return w.saveProgress(ctx, tx, imp, progress)
+	}); err != nil {
+		return false, err
+	}
+	return progress.Status == db.FollowImportStatusRUNNING, nil
+}
+
+// failOnLastAttempt marks the import failed when River is about to discard
+// the job, which also frees the user to start another import.
+func (w *FollowImportWorker) failOnLastAttempt(ctx context.Context, job *river.Job[FollowImportArgs], imp db.GetFollowImportByUidRow, err error) error {
+	if job.Attempt < job.MaxAttempts {
+		return err
+	}
+	if finishErr := w.finish(context.WithoutCancel(ctx), imp, db.UpdateFollowImportProgressParams{
+		Uid:            imp.Uid,
+		Status:         db.FollowImportStatusFAILED,
+		TotalCount:     imp.TotalCount,
+		ProcessedCount: imp.ProcessedCount,
+		Error:          "import failed, please try again",
+	}); finishErr != nil {
+		return errors.Join(err, finishErr)
+	}
+	return err
+}
+
+func (w *FollowImportWorker) followBatch(ctx context.Context, tx pgx.Tx, userUID uuid.UUID, entries []followImportEntry, progress *db.UpdateFollowImportProgressParams) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	qtx := w.db.WithTx(tx)
+
+	uids := make([]uuid.UUID, 0, len(entries))
+	nicknames := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.hasUID {
+			uids = append(uids, entry.uid)
+		}
+		if entry.nickname != "" {
+			nicknames = append(nicknames, entry.nickname)
+		}
+	}
+	rows, err := qtx.ResolveFollowImportUsers(ctx, db.ResolveFollowImportUsersParams{
+		Uids:      uids,
+		Nicknames: nicknames,
+	})
+	if err != nil {
+		return fmt.Errorf("resolve follow import users: %w", err)
+	}
+	byUID := make(map[uuid.UUID]bool, len(rows))
+	byNickname := make(map[string]uuid.UUID, len(rows))
+	for _, row := range rows {
+		byUID[row.Uid] = true
+		byNickname[row.Nickname] = row.Uid
+	}
+
+	producer := New(river.ClientFromContext[pgx.Tx](ctx))
+	for _, entry := range entries {
+		// A uid from another instance will not resolve here, so fall back to
+		// the nickname on the same row.
+		var target uuid.UUID
+		switch {
+		case entry.hasUID && byUID[entry.uid]:
+			target = entry.uid
+		case entry.nickname != "" && byNickname[entry.nickname] != uuid.Nil:
+			target = byNickname[entry.nickname]
+		default:
+			progress.NotFoundCount++
+			continue
+		}
+		if target == userUID {
+			progress.SkippedCount++
+			continue
+		}
+
+		affected, err := qtx.InsertFollowEdge(ctx, db.InsertFollowEdgeParams{
+			FollowerUid: userUID,
+			FolloweeUid: target,
+		})
+		if err != nil {
+			return fmt.Errorf("insert follow edge: %w", err)
+		}
+		if affected == 0 {
+			progress.AlreadyFollowingCount++
+			continue
+		}
+		if _, err := qtx.IncrementFollowingCount(ctx, userUID); err != nil {
+			return fmt.Errorf("increment following_count: %w", err)
+		}
+		if _, err := qtx.IncrementFollowersCount(ctx, target); err != nil {
+			return fmt.Errorf("increment followers_count: %w", err)
+		}
+		if err := producer.EnqueueFollowInboxTx(ctx, tx, FollowInboxArgs{
+			MessageUID:  uuid.New(),
+			ReceiverUID: target,
+			ActorUID:    userUID,
+		}); err != nil {
+			return err
+		}
+		progress.FollowedCount++
+	}
+	return nil
+}
+
+func (w *FollowImportWorker) finish(ctx context.Context, imp db.GetFollowImportByUidRow, progress db.UpdateFollowImportProgressParams) error {
+	return pgx.BeginFunc(ctx, w.pool, func(tx pgx.Tx) error {
+		return w.saveProgress(ctx, tx, imp, progress)
+	})
+}
+
+// saveProgress records the batch and, once the import is no longer running,
+// drops the stored entries and sends the summary to the importing user's inbox.
+func (w *FollowImportWorker) saveProgress(ctx context.Context, tx pgx.Tx, imp db.GetFollowImportByUidRow, progress db.UpdateFollowImportProgressParams) error {
+	qtx := w.db.WithTx(tx)
+	if err := qtx.UpdateFollowImportProgress(ctx, progress); err != nil {
+		return fmt.Errorf("update follow import progress: %w", err)
+	}
+	if progress.Status == db.FollowImportStatusRUNNING {
+		return nil
+	}
+	if err := qtx.DeleteFollowImportEntries(ctx, imp.Uid); err != nil {
+		return fmt.Errorf("delete follow import entries: %w", err)
+	}
+	if err := qtx.CreateFollowImportInboxMessage(ctx, db.CreateFollowImportInboxMessageParams{
+		Uid:             uuid.New(),
+		ReceiverUid:     imp.UserUid,
+		FollowImportUid: uuid.NullUUID{UUID: imp.Uid, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("create follow import inbox message: %w", err)
+	}
+	return nil
+}
+
+// readEntries parses the import file. Each row names one user by uid and/or
+// nickname; a header row with "uid" and "nickname" columns is optional, which
+// also makes files produced by ExportFollows importable as-is. Without a
+// header the first column is read as a uid or an @nickname.
+func (w *FollowImportWorker) readEntries(ctx context.Context, fileURL string) ([]followImportEntry, error) {
+	reader, _, err := w.oss.GetObject(ctx, strings.TrimPrefix(fileURL, "/"))
+	if err != nil {
+		if errors.Is(err, oss.ErrObjectNotFound) {
+			return nil, &followImportFileError{reason: "file not found"}
+		}
+		return nil, fmt.Errorf("get follow import file: %w", err)
+	}
+	defer reader.Close()
+
+	r := csv.NewReader(reader)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	uidCol, nicknameCol := 0, 0
+	seen := make(map[string]bool)
+	var entries []followImportEntry
+	for line := 0; ; line++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, &followImportFileError{reason: fmt.Sprintf("invalid csv: %v", parseErr)}
+			}
+			return nil, fmt.Errorf("read follow import file: %w", err)
+		}
+
+		if line == 0 {
+			record[0] = strings.TrimPrefix(record[0], "\ufeff")
+			if u, n, ok := followImportHeader(record); ok {
+				uidCol, nicknameCol = u, n
+				continue
+			}
+		}
+
+		var entry followImportEntry
+		if uidCol >= 0 && uidCol < len(record) {
+			if parsed, err := uuid.Parse(strings.TrimSpace(record[uidCol])); err == nil {
+				entry.uid, entry.hasUID = parsed, true
+			}
+		}
+		if nicknameCol >= 0 && nicknameCol < len(record) && (nicknameCol != uidCol || !entry.hasUID) {
+			// An escaped cell comes from ExportFollows and holds the exact
+			// nickname; anything else may use the @nickname form.
+			cell := strings.TrimSpace(record[nicknameCol])
+			if nickname := util.UnescapeCSVCell(cell); nickname != cell {
+				entry.nickname = nickname
+			} else {
+				entry.nickname = strings.TrimPrefix(cell, "@")
+			}
+		}
+		if !entry.hasUID && entry.nickname == "" {
+			continue
+		}
+
+		key := entry.uid.String() + "\x00" + entry.nickname
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func followImportHeader(record []string) (uidCol int, nicknameCol int, ok bool) {
+	uidCol, nicknameCol = -1, -1
+	for i, cell := range record {
+		switch strings.ToLower(strings.TrimSpace(cell)) {
+		case "uid":
+			uidCol = i
+		case "nickname":
+			nicknameCol = i
+		}
+	}
+	return uidCol, nicknameCol, uidCol >= 0 || nicknameCol >= 0
+}
+
+func (p *Producer) EnqueueFollowImportTx(ctx context.Context, tx pgx.Tx, args FollowImportArgs) error {
+	_, err := p.Client.InsertTx(ctx, tx, args, &river.InsertOpts{
+		Queue: QueueFollowImport,
+	})
+	if err != nil {
+		return fmt.Errorf("insert follow import job: %w", err)
+	}
+
+	return nil
+}
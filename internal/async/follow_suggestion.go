@@ -0,0 +1,135 @@
+package async
+
+import (
+	"aeibi/internal/repository/db"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+const (
+	QueueFollowSuggestion = "follow_suggestion"
+
+	followSuggestionInterval = time.Hour
+	// followSuggestionBatchSize is how many users one job recomputes during a
+	// full refresh.
+	followSuggestionBatchSize = 200
+	// followSuggestionsPerUser caps the stored suggestions for each user.
+	followSuggestionsPerUser = 50
+	// followSuggestionActivityDays is how far back posts count as recent
+	// activity and for shared tags.
+	followSuggestionActivityDays = 30
+
+	followSuggestionMutualWeight     = 1.0
+	followSuggestionTagWeight        = 0.5
+	followSuggestionPopularityWeight = 0.1
+	followSuggestionActivityWeight   = 1.0
+)
+
+// RefreshFollowSuggestionsArgs recomputes precomputed follow suggestions for
+// UserUIDs. With no UserUIDs the job only fans out: it enqueues one job per
+// batch of users, so a full refresh never has to fit in a single job's
+// timeout and a failed batch is retried on its own.
+type RefreshFollowSuggestionsArgs struct {
+	UserUIDs []uuid.UUID `json:"user_uids,omitempty"`
+}
+
+func (RefreshFollowSuggestionsArgs) Kind() string {
+	return "follow.suggestion.refresh"
+}
+
+type RefreshFollowSuggestionsWorker struct {
+	river.WorkerDefaults[RefreshFollowSuggestionsArgs]
+	pool *pgxpool.Pool
+	db   *db.Queries
+}
+
+func NewRefreshFollowSuggestionsWorker(pool *pgxpool.Pool) *RefreshFollowSuggestionsWorker {
+	return &RefreshFollowSuggestionsWorker{
+		pool: pool,
+		db:   db.New(pool),
+	}
+}
+
+func (w *RefreshFollowSuggestionsWorker) Timeout(*river.Job[RefreshFollowSuggestionsArgs]) time.Duration {
+	return 5 * time.Minute
+}
+
+func (w *RefreshFollowSuggestionsWorker) Work(ctx context.Context, job *river.Job[RefreshFollowSuggestionsArgs]) error {
+	if len(job.Args.UserUIDs) > 0 {
+		return w.refresh(ctx, job.Args.UserUIDs)
+	}
+
+	producer := New(river.ClientFromContext[pgx.Tx](ctx))
+	var after uuid.NullUUID
+	for {
+		uids, err := w.db.ListUserUidsAfter(ctx, db.ListUserUidsAfterParams{
+			AfterUid:  after,
+			BatchSize: followSuggestionBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("list users: %w", err)
+		}
+		if len(uids) == 0 {
+			return nil
+		}
+		if err := pgx.BeginFunc(ctx, w.pool, func(tx pgx.Tx) error {
+			return producer.EnqueueRefreshFollowSuggestionsTx(ctx, tx, RefreshFollowSuggestionsArgs{
+				UserUIDs: uids,
+			})
+		}); err != nil {
+			return err
+		}
+		after = uuid.NullUUID{UUID: uids[len(uids)-1], Valid: true}
+	}
+}
+
+func (w *RefreshFollowSuggestionsWorker) refresh(ctx context.Context, userUIDs []uuid.UUID) error {
+	return pgx.BeginFunc(ctx, w.pool, func(tx pgx.Tx) error {
+		qtx := w.db.WithTx(tx)
+
+		if err := qtx.DeleteFollowSuggestionsByUsers(ctx, userUIDs); err != nil {
+			return fmt.Errorf("delete follow suggestions: %w", err)
+		}
+		if err := qtx.InsertFollowSuggestionsForUsers(ctx, db.InsertFollowSuggestionsForUsersParams{
+			UserUids:         userUIDs,
+			PerUser:          followSuggestionsPerUser,
+			ActivityDays:     followSuggestionActivityDays,
+			MutualWeight:     followSuggestionMutualWeight,
+			TagWeight:        followSuggestionTagWeight,
+			PopularityWeight: followSuggestionPopularityWeight,
+			ActivityWeight:   followSuggestionActivityWeight,
+		}); err != nil {
+			return fmt.Errorf("insert follow suggestions: %w", err)
+		}
+		return nil
+	})
+}
+
+func NewRefreshFollowSuggestionsPeriodicJob() *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(followSuggestionInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return RefreshFollowSuggestionsArgs{}, &river.InsertOpts{
+				Queue: QueueFollowSuggestion,
+			}
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	)
+}
+
+func (p *Producer) EnqueueRefreshFollowSuggestionsTx(ctx context.Context, tx pgx.Tx, args RefreshFollowSuggestionsArgs) error {
+	_, err := p.Client.InsertTx(ctx, tx, args, &river.InsertOpts{
+		Queue: QueueFollowSuggestion,
+	})
+	if err != nil {
+		return fmt.Errorf("insert refresh follow suggestions job: %w", err)
+	}
+
+	return nil
+}
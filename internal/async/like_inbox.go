@@ -0,0 +1,118 @@
+package async
+
+import (
+	"aeibi/internal/repository/db"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+type LikeInboxType string
+
+const (
+	LikeInboxTypeLike    LikeInboxType = "LIKE"
+	LikeInboxTypeCollect LikeInboxType = "COLLECT"
+)
+
+// LikeInboxArgs notifies the author of a post or comment about a like or a
+// collection. CommentUID is set only for comment likes.
+type LikeInboxArgs struct {
+	MessageUID  uuid.UUID     `json:"message_uid"`
+	ReceiverUID uuid.UUID     `json:"receiver_uid" river:"unique"`
+	ActorUID    uuid.UUID     `json:"actor_uid" river:"unique"`
+	Type        LikeInboxType `json:"type" river:"unique"`
+	PostUID     uuid.UUID     `json:"post_uid" river:"unique"`
+	CommentUID  uuid.UUID     `json:"comment_uid" river:"unique"`
+}
+
+const (
+	QueueLikeInbox = "inbox_like"
+
+	// likeInboxDelay holds a notification back so that a like followed by a
+	// quick unlike never reaches the author: the worker only writes the
+	// message if the edge still exists when it runs.
+	likeInboxDelay = time.Minute
+	// likeInboxUniquePeriod collapses repeated toggles by the same actor on
+	// the same target into a single pending job.
+	likeInboxUniquePeriod = time.Hour
+)
+
+func (LikeInboxArgs) Kind() string {
+	return "inbox.like"
+}
+
+type LikeInboxWorker struct {
+	river.WorkerDefaults[LikeInboxArgs]
+	db *db.Queries
+}
+
+func NewLikeInboxWorker(pool *pgxpool.Pool) *LikeInboxWorker {
+	return &LikeInboxWorker{
+		db: db.New(pool),
+	}
+}
+
+func (w *LikeInboxWorker) Work(ctx context.Context, job *river.Job[LikeInboxArgs]) error {
+	args := job.Args
+	postUID := uuid.NullUUID{UUID: args.PostUID, Valid: args.PostUID != uuid.Nil}
+
+	var err error
+	switch {
+	case args.Type == LikeInboxTypeLike && args.CommentUID != uuid.Nil:
+		_, err = w.db.CreateCommentLikeInboxMessage(ctx, db.CreateCommentLikeInboxMessageParams{
+			Uid:         args.MessageUID,
+			ReceiverUid: args.ReceiverUID,
+			ActorUid:    args.ActorUID,
+			CommentUid:  uuid.NullUUID{UUID: args.CommentUID, Valid: true},
+			PostUid:     postUID,
+		})
+	case args.Type == LikeInboxTypeLike:
+		_, err = w.db.CreatePostLikeInboxMessage(ctx, db.CreatePostLikeInboxMessageParams{
+			Uid:         args.MessageUID,
+			ReceiverUid: args.ReceiverUID,
+			ActorUid:    args.ActorUID,
+			PostUid:     postUID,
+		})
+	case args.Type == LikeInboxTypeCollect:
+		_, err = w.db.CreatePostCollectInboxMessage(ctx, db.CreatePostCollectInboxMessageParams{
+			Uid:         args.MessageUID,
+			ReceiverUid: args.ReceiverUID,
+			ActorUid:    args.ActorUID,
+			PostUid:     postUID,
+		})
+	default:
+		return fmt.Errorf("unsupported like inbox type: %q", args.Type)
+	}
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil
+		}
+		return fmt.Errorf("create like inbox message: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Producer) EnqueueLikeInboxTx(ctx context.Context, tx pgx.Tx, args LikeInboxArgs) error {
+	_, err := p.Client.InsertTx(ctx, tx, args, &river.InsertOpts{
+		Queue:       QueueLikeInbox,
+		ScheduledAt: time.Now().Add(likeInboxDelay),
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: likeInboxUniquePeriod,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("insert like inbox job: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package async
+
+import (
+	"aeibi/internal/linkpreview"
+	"aeibi/internal/repository/db"
+	"aeibi/internal/repository/oss"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+const (
+	QueueLinkPreview = "link_preview"
+
+	// linkPreviewTTL is how long a cached preview, successful or not, is
+	// reused before the URL is fetched again.
+	linkPreviewTTL = 7 * 24 * time.Hour
+)
+
+// LinkPreviewArgs fetches and caches the preview card for one URL.
+type LinkPreviewArgs struct {
+	URL string `json:"url"`
+}
+
+func (LinkPreviewArgs) Kind() string {
+	return "link.preview.fetch"
+}
+
+type LinkPreviewWorker struct {
+	river.WorkerDefaults[LinkPreviewArgs]
+	db      *db.Queries
+	oss     *oss.OSS
+	fetcher *linkpreview.Fetcher
+}
+
+func NewLinkPreviewWorker(pool *pgxpool.Pool, ossClient *oss.OSS, fetcher *linkpreview.Fetcher) *LinkPreviewWorker {
+	return &LinkPreviewWorker{
+		db:      db.New(pool),
+		oss:     ossClient,
+		fetcher: fetcher,
+	}
+}
+
+func (w *LinkPreviewWorker) Timeout(*river.Job[LinkPreviewArgs]) time.Duration {
+	return time.Minute
+}
+
+func (w *LinkPreviewWorker) Work(ctx context.Context, job *river.Job[LinkPreviewArgs]) error {
+	if job.Args.URL == "" {
+		return fmt.Errorf("url is required")
+	}
+
+	fetchedAt, err := w.db.GetLinkPreviewFetchedAt(ctx, job.Args.URL)
+	if err == nil && time.Since(fetchedAt.Time) < linkPreviewTTL {
+		return nil
+	}
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("get link preview: %w", err)
+	}
+
+	// Remote failures are cached as FAILED instead of retried so a broken or
+	// hostile URL is not hammered by every post that links to it.
+	preview, err := w.fetcher.Fetch(ctx, job.Args.URL)
+	if err != nil {
+		slog.Warn("fetch link preview", "url", job.Args.URL, "error", err)
+		if err := w.db.UpsertLinkPreview(ctx, db.UpsertLinkPreviewParams{
+			Url:    job.Args.URL,
+			Status: db.LinkPreviewStatusFAILED,
+		}); err != nil {
+			return fmt.Errorf("upsert link preview: %w", err)
+		}
+		return nil
+	}
+
+	var imageURL string
+	if preview.ImageURL != "" {
+		imageURL, err = w.storeImage(ctx, preview.ImageURL)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := w.db.UpsertLinkPreview(ctx, db.UpsertLinkPreviewParams{
+		Url:         job.Args.URL,
+		Status:      db.LinkPreviewStatusREADY,
+		Title:       preview.Title,
+		Description: preview.Description,
+		SiteName:    preview.SiteName,
+		ImageUrl:    imageURL,
+	}); err != nil {
+		return fmt.Errorf("upsert link preview: %w", err)
+	}
+	return nil
+}
+
+// storeImage copies the remote preview image into OSS and returns its file
+// URL. A missing or invalid image leaves the card without one.
+func (w *LinkPreviewWorker) storeImage(ctx context.Context, rawURL string) (string, error) {
+	image, err := w.fetcher.FetchImage(ctx, rawURL)
+	if err != nil {
+		slog.Warn("fetch link preview image", "url", rawURL, "error", err)
+		return "", nil
+	}
+
+	url := "/file/" + uuid.NewString() + imageExt(image.ContentType)
+	if _, err := w.oss.PutObject(ctx, strings.TrimPrefix(url, "/"), image.Data, image.ContentType); err != nil {
+		return "", fmt.Errorf("upload link preview image: %w", err)
+	}
+	return url, nil
+}
+
+func imageExt(contentType string) string {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	default:
+		return ""
+	}
+}
+
+func (p *Producer) EnqueueLinkPreviewTx(ctx context.Context, tx pgx.Tx, args LinkPreviewArgs) error {
+	_, err := p.Client.InsertTx(ctx, tx, args, &river.InsertOpts{
+		Queue: QueueLinkPreview,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: time.Hour,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("insert link preview job: %w", err)
+	}
+
+	return nil
+}
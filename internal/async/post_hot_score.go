@@ -0,0 +1,79 @@
+package async
+
+import (
+	"aeibi/internal/repository/db"
+	searchrepo "aeibi/internal/repository/search"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+const (
+	QueuePostHotScore = "post_hot_score"
+
+	postHotScoreInterval = 10 * time.Minute
+	// postHotScoreWindowHours bounds which posts are still ranked; anything
+	// older decays to zero.
+	postHotScoreWindowHours = 7 * 24
+	// postHotScoreGravity controls how fast engagement decays with age.
+	postHotScoreGravity = 1.5
+)
+
+// RefreshPostHotScoresArgs recomputes posts.hot_score, a time-decayed score over
+// likes, comments and collections, and pushes changed scores to search.
+type RefreshPostHotScoresArgs struct{}
+
+func (RefreshPostHotScoresArgs) Kind() string {
+	return "post.hot_score.refresh"
+}
+
+type RefreshPostHotScoresWorker struct {
+	river.WorkerDefaults[RefreshPostHotScoresArgs]
+	db     *db.Queries
+	search *searchrepo.Search
+}
+
+func NewRefreshPostHotScoresWorker(pool *pgxpool.Pool, search *searchrepo.Search) *RefreshPostHotScoresWorker {
+	return &RefreshPostHotScoresWorker{
+		db:     db.New(pool),
+		search: search,
+	}
+}
+
+func (w *RefreshPostHotScoresWorker) Work(ctx context.Context, job *river.Job[RefreshPostHotScoresArgs]) error {
+	rows, err := w.db.RefreshPostHotScores(ctx, db.RefreshPostHotScoresParams{
+		WindowHours: postHotScoreWindowHours,
+		Gravity:     postHotScoreGravity,
+	})
+	if err != nil {
+		return fmt.Errorf("refresh post hot scores: %w", err)
+	}
+
+	docs := make([]searchrepo.PostHotScoreDocument, 0, len(rows))
+	for _, row := range rows {
+		docs = append(docs, searchrepo.PostHotScoreDocument{
+			UID:      row.Uid.String(),
+			HotScore: row.HotScore,
+		})
+	}
+	if err := w.search.UpdatePostHotScores(docs); err != nil {
+		return fmt.Errorf("update post hot scores in search: %w", err)
+	}
+
+	return nil
+}
+
+func NewRefreshPostHotScoresPeriodicJob() *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(postHotScoreInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return RefreshPostHotScoresArgs{}, &river.InsertOpts{
+				Queue: QueuePostHotScore,
+			}
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	)
+}
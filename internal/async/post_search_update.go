@@ -77,10 +77,17 @@ func (w *UpdatePostSearchWorker) Work(ctx context.Context, job *river.Job[Update
 			CommentCount:    int(row.CommentCount),
 			CollectionCount: int(row.CollectionCount),
 			LikeCount:       int(row.LikeCount),
+			ViewCount:       row.ViewCount,
 			Pinned:          row.Pinned,
 			Visibility:      string(row.Visibility),
 			Status:          string(row.Status),
+			ContentWarning:  row.ContentWarning,
+			SensitiveMedia:  row.SensitiveMedia,
+			CommentPolicy:   string(row.CommentPolicy),
+			LinkURL:         row.LinkUrl,
+			IPRegion:        row.IpRegion,
 			LatestRepliedOn: row.LatestRepliedOn.Time.Unix(),
+			HotScore:        row.HotScore,
 			CreatedAt:       row.CreatedAt.Time.Unix(),
 			UpdatedAt:       row.UpdatedAt.Time.Unix(),
 		}
@@ -1,12 +1,14 @@
 package async
 
 import (
+	"aeibi/internal/repository/db"
 	searchrepo "aeibi/internal/repository/search"
 	"aeibi/util"
 	"context"
 	"fmt"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/riverqueue/river"
 )
 
@@ -22,11 +24,13 @@ func (UpdateTagSearchArgs) Kind() string {
 
 type UpdateTagSearchWorker struct {
 	river.WorkerDefaults[UpdateTagSearchArgs]
+	db     *db.Queries
 	search *searchrepo.Search
 }
 
-func NewUpdateTagSearchWorker(search *searchrepo.Search) *UpdateTagSearchWorker {
+func NewUpdateTagSearchWorker(pool *pgxpool.Pool, search *searchrepo.Search) *UpdateTagSearchWorker {
 	return &UpdateTagSearchWorker{
+		db:     db.New(pool),
 		search: search,
 	}
 }
@@ -37,17 +41,39 @@ func (w *UpdateTagSearchWorker) Work(ctx context.Context, job *river.Job[UpdateT
 		return nil
 	}
 
-	docs := make([]searchrepo.TagDocument, 0, len(names))
-	for _, name := range names {
+	rows, err := w.db.GetTagsByNames(ctx, names)
+	if err != nil {
+		return fmt.Errorf("get tags by names: %w", err)
+	}
+
+	// Names that were renamed, merged away or banned are dropped from the
+	// index so suggestions only surface usable tags.
+	indexed := make(map[string]struct{}, len(rows))
+	docs := make([]searchrepo.TagDocument, 0, len(rows))
+	for _, row := range rows {
+		if row.Banned {
+			continue
+		}
+		indexed[row.Name] = struct{}{}
 		docs = append(docs, searchrepo.TagDocument{
-			ID:   name,
-			Name: name,
+			ID:        row.Name,
+			Name:      row.Name,
+			PostCount: int(row.PostCount),
 		})
 	}
+	removed := make([]string, 0, len(names)-len(docs))
+	for _, name := range names {
+		if _, ok := indexed[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
 
 	if err := w.search.UpsertTags(docs); err != nil {
 		return fmt.Errorf("upsert tags to search: %w", err)
 	}
+	if err := w.search.DeleteTagsByIDs(removed); err != nil {
+		return fmt.Errorf("delete tags from search: %w", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,119 @@
+package async
+
+import (
+	"aeibi/internal/repository/db"
+	searchrepo "aeibi/internal/repository/search"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+const (
+	QueueTagTrend = "tag_trend"
+
+	tagTrendInterval = 5 * time.Minute
+	// tagTrendRetention is the longest window trending tags can be asked for.
+	tagTrendRetention = 7 * 24 * time.Hour
+	// tagTrendRecount re-aggregates the most recent buckets on every run so
+	// late commits and unlikes are reflected.
+	tagTrendRecount = 15 * time.Minute
+)
+
+// RefreshTagTrendsArgs folds recent posts and engagement edges into
+// tag_activity_buckets and refreshes per-tag usage counts in search.
+type RefreshTagTrendsArgs struct{}
+
+func (RefreshTagTrendsArgs) Kind() string {
+	return "tag.trend.refresh"
+}
+
+type RefreshTagTrendsWorker struct {
+	river.WorkerDefaults[RefreshTagTrendsArgs]
+	pool   *pgxpool.Pool
+	db     *db.Queries
+	search *searchrepo.Search
+}
+
+func NewRefreshTagTrendsWorker(pool *pgxpool.Pool, search *searchrepo.Search) *RefreshTagTrendsWorker {
+	return &RefreshTagTrendsWorker{
+		pool:   pool,
+		db:     db.New(pool),
+		search: search,
+	}
+}
+
+func (w *RefreshTagTrendsWorker) Work(ctx context.Context, job *river.Job[RefreshTagTrendsArgs]) error {
+	now := time.Now()
+	retainFrom := now.Add(-tagTrendRetention)
+
+	if err := pgx.BeginFunc(ctx, w.pool, func(tx pgx.Tx) error {
+		qtx := w.db.WithTx(tx)
+
+		latest, err := qtx.GetLatestTagActivityBucketStart(ctx)
+		if err != nil {
+			return fmt.Errorf("get latest tag activity bucket: %w", err)
+		}
+		// Only the tail is recounted; a fresh table or a long outage falls
+		// back to rebuilding the whole retention window.
+		since := now.Add(-tagTrendRecount)
+		if !latest.Valid {
+			since = retainFrom
+		} else if latest.Time.Before(since) {
+			since = latest.Time
+		}
+		if since.Before(retainFrom) {
+			since = retainFrom
+		}
+		sinceTs := pgtype.Timestamptz{Time: since.Truncate(tagTrendInterval), Valid: true}
+
+		if err := qtx.DeleteTagActivityBucketsSince(ctx, sinceTs); err != nil {
+			return fmt.Errorf("delete tag activity buckets: %w", err)
+		}
+		if err := qtx.RebuildTagActivityBucketsSince(ctx, sinceTs); err != nil {
+			return fmt.Errorf("rebuild tag activity buckets: %w", err)
+		}
+		if err := qtx.DeleteTagActivityBucketsBefore(ctx, pgtype.Timestamptz{Time: retainFrom, Valid: true}); err != nil {
+			return fmt.Errorf("delete expired tag activity buckets: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	rows, err := w.db.RefreshTagPostCounts(ctx)
+	if err != nil {
+		return fmt.Errorf("refresh tag post counts: %w", err)
+	}
+
+	docs := make([]searchrepo.TagDocument, 0, len(rows))
+	for _, row := range rows {
+		docs = append(docs, searchrepo.TagDocument{
+			ID:        row.Name,
+			Name:      row.Name,
+			PostCount: int(row.PostCount),
+		})
+	}
+	if err := w.search.UpsertTags(docs); err != nil {
+		return fmt.Errorf("upsert tags to search: %w", err)
+	}
+
+	return nil
+}
+
+func NewRefreshTagTrendsPeriodicJob() *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(tagTrendInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return RefreshTagTrendsArgs{}, &river.InsertOpts{
+				Queue: QueueTagTrend,
+			}
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	)
+}
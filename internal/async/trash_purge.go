@@ -0,0 +1,207 @@
+package async
+
+import (
+	"aeibi/internal/repository/db"
+	"aeibi/internal/repository/oss"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+)
+
+const (
+	QueueTrashPurge = "trash_purge"
+
+	// TrashRetention is how long deleted posts and comments stay restorable
+	// before they are purged for good.
+	TrashRetention = 30 * 24 * time.Hour
+
+	trashPurgeInterval  = time.Hour
+	trashPurgeBatchSize = 100
+)
+
+// PurgeTrashArgs hard-deletes posts and comments that have been in the trash
+// longer than TrashRetention, together with everything hanging off them.
+type PurgeTrashArgs struct{}
+
+func (PurgeTrashArgs) Kind() string {
+	return "trash.purge"
+}
+
+type PurgeTrashWorker struct {
+	river.WorkerDefaults[PurgeTrashArgs]
+	pool *pgxpool.Pool
+	db   *db.Queries
+	oss  *oss.OSS
+}
+
+func NewPurgeTrashWorker(pool *pgxpool.Pool, ossClient *oss.OSS) *PurgeTrashWorker {
+	return &PurgeTrashWorker{
+		pool: pool,
+		db:   db.New(pool),
+		oss:  ossClient,
+	}
+}
+
+func (w *PurgeTrashWorker) Timeout(*river.Job[PurgeTrashArgs]) time.Duration {
+	return 10 * time.Minute
+}
+
+func (w *PurgeTrashWorker) Work(ctx context.Context, job *river.Job[PurgeTrashArgs]) error {
+	cutoff := pgtype.Timestamptz{Time: time.Now().Add(-TrashRetention), Valid: true}
+
+	for {
+		n, err := w.purgePosts(ctx, cutoff)
+		if err != nil {
+			return err
+		}
+		if n < trashPurgeBatchSize {
+			break
+		}
+	}
+	for {
+		n, err := w.purgeComments(ctx, cutoff)
+		if err != nil {
+			return err
+		}
+		if n < trashPurgeBatchSize {
+			break
+		}
+	}
+	return nil
+}
+
+// purgePosts deletes one batch of expired posts. Likes, collections and tags
+// go with them through foreign keys; comments and inbox messages reference
+// posts by uid only and are deleted explicitly.
+func (w *PurgeTrashWorker) purgePosts(ctx context.Context, cutoff pgtype.Timestamptz) (int, error) {
+	var n int
+	var urls []string
+	if err := pgx.BeginFunc(ctx, w.pool, func(tx pgx.Tx) error {
+		qtx := w.db.WithTx(tx)
+
+		posts, err := qtx.ListExpiredTrashedPosts(ctx, db.ListExpiredTrashedPostsParams{
+			ArchivedBefore: cutoff,
+			BatchSize:      trashPurgeBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("list expired trashed posts: %w", err)
+		}
+		n = len(posts)
+		if n == 0 {
+			return nil
+		}
+
+		postUids := make([]uuid.UUID, 0, len(posts))
+		for _, post := range posts {
+			postUids = append(postUids, post.Uid)
+			urls = append(urls, post.Images...)
+			urls = append(urls, post.Attachments...)
+		}
+
+		comments, err := qtx.DeleteCommentsByPostUids(ctx, postUids)
+		if err != nil {
+			return fmt.Errorf("delete comments by post uids: %w", err)
+		}
+		for _, comment := range comments {
+			urls = append(urls, comment.Images...)
+			urls = append(urls, comment.Attachments...)
+		}
+		if err := qtx.DeleteInboxMessagesByPostUids(ctx, postUids); err != nil {
+			return fmt.Errorf("delete inbox messages by post uids: %w", err)
+		}
+		if err := qtx.DeletePostsByUids(ctx, postUids); err != nil {
+			return fmt.Errorf("delete posts: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := w.purgeFiles(ctx, urls); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// purgeComments deletes one batch of expired comments on posts that are still
+// around, including the replies under expired top-level comments.
+func (w *PurgeTrashWorker) purgeComments(ctx context.Context, cutoff pgtype.Timestamptz) (int, error) {
+	var n int
+	var urls []string
+	if err := pgx.BeginFunc(ctx, w.pool, func(tx pgx.Tx) error {
+		qtx := w.db.WithTx(tx)
+
+		commentUids, err := qtx.ListExpiredTrashedCommentUids(ctx, db.ListExpiredTrashedCommentUidsParams{
+			ArchivedBefore: cutoff,
+			BatchSize:      trashPurgeBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("list expired trashed comments: %w", err)
+		}
+		n = len(commentUids)
+		if n == 0 {
+			return nil
+		}
+
+		deleted, err := qtx.DeleteCommentsByUidsOrRoots(ctx, commentUids)
+		if err != nil {
+			return fmt.Errorf("delete comments: %w", err)
+		}
+		deletedUids := make([]uuid.UUID, 0, len(deleted))
+		for _, comment := range deleted {
+			deletedUids = append(deletedUids, comment.Uid)
+			urls = append(urls, comment.Images...)
+			urls = append(urls, comment.Attachments...)
+		}
+		if err := qtx.DeleteInboxMessagesByCommentUids(ctx, deletedUids); err != nil {
+			return fmt.Errorf("delete inbox messages by comment uids: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := w.purgeFiles(ctx, urls); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// purgeFiles drops the file rows and objects behind urls that nothing else
+// references anymore.
+func (w *PurgeTrashWorker) purgeFiles(ctx context.Context, urls []string) error {
+	if len(urls) == 0 {
+		return nil
+	}
+
+	removed, err := w.db.DeleteUnreferencedFilesByUrls(ctx, urls)
+	if err != nil {
+		return fmt.Errorf("delete unreferenced files: %w", err)
+	}
+	for _, url := range removed {
+		if err := w.oss.RemoveObject(ctx, strings.TrimPrefix(url, "/")); err != nil {
+			slog.Warn("remove purged file", "url", url, "error", err)
+		}
+	}
+	return nil
+}
+
+func NewPurgeTrashPeriodicJob() *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(trashPurgeInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return PurgeTrashArgs{}, &river.InsertOpts{
+				Queue: QueueTrashPurge,
+			}
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	)
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// NewClientIPUnaryServerInterceptor stores the caller's IP in the context.
+// X-Forwarded-For is only honored when the direct peer is a trusted proxy,
+// such as the in-process gateway; it is walked right to left and the first
+// untrusted hop is taken as the client.
+func NewClientIPUnaryServerInterceptor(trustedProxies []string) (grpc.UnaryServerInterceptor, error) {
+	trusted, err := parseTrustedProxies(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if ip := clientIP(ctx, trusted); ip != "" {
+			ctx = WithClientIP(ctx, ip)
+		}
+		return handler(ctx, req)
+	}, nil
+}
+
+func clientIP(ctx context.Context, trusted []*net.IPNet) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+	peerIP := net.ParseIP(host)
+	if peerIP == nil {
+		return ""
+	}
+	if !isTrusted(peerIP, trusted) {
+		return peerIP.String()
+	}
+
+	var hops []string
+	for _, value := range metadata.ValueFromIncomingContext(ctx, "x-forwarded-for") {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	client := peerIP
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := net.ParseIP(strings.TrimSpace(hops[i]))
+		if ip == nil {
+			break
+		}
+		client = ip
+		if !isTrusted(ip, trusted) {
+			break
+		}
+	}
+	return client.String()
+}
+
+func isTrusted(ip net.IP, trusted []*net.IPNet) bool {
+	for _, network := range trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTrustedProxies(values []string) ([]*net.IPNet, error) {
+	if len(values) == 0 {
+		values = []string{"127.0.0.0/8", "::1/128"}
+	}
+
+	networks := make([]*net.IPNet, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if !strings.Contains(value, "/") {
+			if ip := net.ParseIP(value); ip != nil && ip.To4() != nil {
+				value += "/32"
+			} else {
+				value += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("parse trusted proxy %q: %w", value, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
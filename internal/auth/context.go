@@ -4,7 +4,10 @@ import "context"
 
 type contextKey string
 
-const authInfoKey contextKey = "auth-info"
+const (
+	authInfoKey contextKey = "auth-info"
+	clientIPKey contextKey = "client-ip"
+)
 
 type AuthInfo struct {
 	Subject string
@@ -36,3 +39,18 @@ func SubjectFromContext(ctx context.Context) (string, bool) {
 	}
 	return info.Subject, true
 }
+
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey, ip)
+}
+
+func ClientIPFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	ip, ok := ctx.Value(clientIPKey).(string)
+	if !ok || ip == "" {
+		return "", false
+	}
+	return ip, true
+}
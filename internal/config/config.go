@@ -9,16 +9,22 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	OSS      OSSConfig      `mapstructure:"oss"`
-	Search   SearchConfig   `mapstructure:"search"`
-	Auth     AuthConfig     `mapstructure:"auth"`
+	Server    ServerConfig    `mapstructure:"server"`
+	Database  DatabaseConfig  `mapstructure:"database"`
+	OSS       OSSConfig       `mapstructure:"oss"`
+	Search    SearchConfig    `mapstructure:"search"`
+	Auth      AuthConfig      `mapstructure:"auth"`
+	GeoIP     GeoIPConfig     `mapstructure:"geoip"`
+	Reactions ReactionsConfig `mapstructure:"reactions"`
+	Comments  CommentsConfig  `mapstructure:"comments"`
 }
 
 type ServerConfig struct {
 	GRPCAddr string `mapstructure:"grpc_addr"`
 	HTTPAddr string `mapstructure:"http_addr"`
+	// TrustedProxies lists proxy IPs/CIDRs whose X-Forwarded-For is honored
+	// when resolving the client IP. Loopback is trusted when empty.
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
 }
 
 type DatabaseConfig struct {
@@ -47,6 +53,28 @@ type AuthConfig struct {
 	RefreshTTL time.Duration `mapstructure:"refresh_ttl"`
 }
 
+type GeoIPConfig struct {
+	// DatabasePath points at a MaxMind-format (.mmdb) city or country
+	// database. Regions are left empty when unset.
+	DatabasePath string `mapstructure:"database_path"`
+	Language     string `mapstructure:"language"`
+}
+
+type ReactionsConfig struct {
+	// Emojis users may react with. The first one is the default reaction
+	// and is what a like counts as. A built-in set is used when empty.
+	Emojis []string `mapstructure:"emojis"`
+}
+
+type CommentsConfig struct {
+	// EditWindow is how long after posting an author may still edit a
+	// comment. Zero means no limit.
+	EditWindow time.Duration `mapstructure:"edit_window"`
+	// MaxPinned is how many top-level comments a post author may pin.
+	// Defaults to 3.
+	MaxPinned int `mapstructure:"max_pinned"`
+}
+
 func Load(path string) (*Config, error) {
 	if path == "" {
 		return nil, fmt.Errorf("config path is required")
@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"aeibi/api"
+	"aeibi/internal/auth"
+	"aeibi/internal/service"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AdminHandler struct {
+	api.UnimplementedAdminServiceServer
+	svc *service.AdminService
+}
+
+func NewAdminHandler(svc *service.AdminService) *AdminHandler {
+	return &AdminHandler{svc: svc}
+}
+
+func (h *AdminHandler) requireAdmin(ctx context.Context) error {
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.RequireAdmin(ctx, uid)
+}
+
+func (h *AdminHandler) RenameTag(ctx context.Context, req *api.RenameTagRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.NewName == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_name is required")
+	}
+	if err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.svc.RenameTag(ctx, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) MergeTags(ctx context.Context, req *api.MergeTagsRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if len(req.SourceNames) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "source_names is required")
+	}
+	if req.TargetName == "" {
+		return nil, status.Error(codes.InvalidArgument, "target_name is required")
+	}
+	if err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.svc.MergeTags(ctx, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) CreateTagAlias(ctx context.Context, req *api.CreateTagAliasRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.Alias == "" {
+		return nil, status.Error(codes.InvalidArgument, "alias is required")
+	}
+	if err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.svc.CreateTagAlias(ctx, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) DeleteTagAlias(ctx context.Context, req *api.DeleteTagAliasRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Alias == "" {
+		return nil, status.Error(codes.InvalidArgument, "alias is required")
+	}
+	if err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.svc.DeleteTagAlias(ctx, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) BanTag(ctx context.Context, req *api.BanTagRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.svc.BanTag(ctx, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) UnbanTag(ctx context.Context, req *api.UnbanTagRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.svc.UnbanTag(ctx, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"aeibi/api"
+	"aeibi/internal/auth"
+	"aeibi/internal/service"
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type CollectionHandler struct {
+	api.UnimplementedCollectionServiceServer
+	svc *service.CollectionService
+}
+
+func NewCollectionHandler(svc *service.CollectionService) *CollectionHandler {
+	return &CollectionHandler{svc: svc}
+}
+
+func (h *CollectionHandler) CreateCollectionFolder(ctx context.Context, req *api.CreateCollectionFolderRequest) (*api.CreateCollectionFolderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.CreateCollectionFolder(ctx, uid, req)
+}
+
+func (h *CollectionHandler) ListMyCollectionFolders(ctx context.Context, req *api.ListMyCollectionFoldersRequest) (*api.ListCollectionFoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListMyCollectionFolders(ctx, uid, req)
+}
+
+func (h *CollectionHandler) ListUserCollectionFolders(ctx context.Context, req *api.ListUserCollectionFoldersRequest) (*api.ListCollectionFoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.ListUserCollectionFolders(ctx, viewerUid, req)
+}
+
+func (h *CollectionHandler) UpdateCollectionFolder(ctx context.Context, req *api.UpdateCollectionFolderRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	if req.Folder == nil {
+		return nil, status.Error(codes.InvalidArgument, "folder is required")
+	}
+	if req.UpdateMask == nil || len(req.UpdateMask.Paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.UpdateCollectionFolder(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CollectionHandler) ReorderCollectionFolders(ctx context.Context, req *api.ReorderCollectionFoldersRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if len(req.FolderUids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "folder_uids is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.ReorderCollectionFolders(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CollectionHandler) DeleteCollectionFolder(ctx context.Context, req *api.DeleteCollectionFolderRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.DeleteCollectionFolder(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CollectionHandler) FilePostToCollectionFolder(ctx context.Context, req *api.FilePostToCollectionFolderRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.FolderUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "folder_uid is required")
+	}
+	if req.PostUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "post_uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.FilePostToCollectionFolder(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CollectionHandler) RemovePostFromCollectionFolder(ctx context.Context, req *api.RemovePostFromCollectionFolderRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.FolderUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "folder_uid is required")
+	}
+	if req.PostUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "post_uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.RemovePostFromCollectionFolder(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CollectionHandler) ListPostCollectionFolders(ctx context.Context, req *api.ListPostCollectionFoldersRequest) (*api.ListPostCollectionFoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.PostUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "post_uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListPostCollectionFolders(ctx, uid, req)
+}
@@ -5,7 +5,9 @@ import (
 	"aeibi/internal/auth"
 	"aeibi/internal/service"
 	"context"
+	"strings"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -79,6 +81,33 @@ func (h *CommentHandler) ListReplies(ctx context.Context, req *api.ListRepliesRe
 	return h.svc.ListReplies(ctx, viewerUid, req)
 }
 
+func (h *CommentHandler) SearchComments(ctx context.Context, req *api.SearchCommentsRequest) (*api.SearchCommentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	req.Query = strings.TrimSpace(req.Query)
+	req.PostUid = strings.TrimSpace(req.PostUid)
+	if req.PostUid != "" {
+		if _, err := uuid.Parse(req.PostUid); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "post_uid is invalid")
+		}
+	}
+	req.AuthorUid = strings.TrimSpace(req.AuthorUid)
+	if req.AuthorUid != "" {
+		if _, err := uuid.Parse(req.AuthorUid); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "author_uid is invalid")
+		}
+	}
+	if req.CreatedAfter < 0 || req.CreatedBefore < 0 {
+		return nil, status.Error(codes.InvalidArgument, "created_after and created_before must not be negative")
+	}
+	if req.CreatedAfter > 0 && req.CreatedBefore > 0 && req.CreatedAfter >= req.CreatedBefore {
+		return nil, status.Error(codes.InvalidArgument, "created_after must be before created_before")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.SearchComments(ctx, viewerUid, req)
+}
+
 func (h *CommentHandler) GetComment(ctx context.Context, req *api.GetCommentRequest) (*api.GetCommentResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
@@ -90,6 +119,47 @@ func (h *CommentHandler) GetComment(ctx context.Context, req *api.GetCommentRequ
 	return h.svc.GetComment(ctx, viewerUid, req)
 }
 
+func (h *CommentHandler) GetCommentThread(ctx context.Context, req *api.GetCommentThreadRequest) (*api.GetCommentThreadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.GetCommentThread(ctx, viewerUid, req)
+}
+
+func (h *CommentHandler) UpdateComment(ctx context.Context, req *api.UpdateCommentRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	if req.Content == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.UpdateComment(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CommentHandler) ListCommentRevisions(ctx context.Context, req *api.ListCommentRevisionsRequest) (*api.ListCommentRevisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	return h.svc.ListCommentRevisions(ctx, req)
+}
+
 func (h *CommentHandler) DeleteComment(ctx context.Context, req *api.DeleteCommentRequest) (*emptypb.Empty, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
@@ -107,6 +177,51 @@ func (h *CommentHandler) DeleteComment(ctx context.Context, req *api.DeleteComme
 	return &emptypb.Empty{}, nil
 }
 
+func (h *CommentHandler) ListMyTrashedComments(ctx context.Context, req *api.ListMyTrashedCommentsRequest) (*api.ListMyTrashedCommentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListMyTrashedComments(ctx, uid, req)
+}
+
+func (h *CommentHandler) RestoreComment(ctx context.Context, req *api.RestoreCommentRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.RestoreComment(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CommentHandler) PinComment(ctx context.Context, req *api.PinCommentRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.PinComment(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (h *CommentHandler) LikeComment(ctx context.Context, req *api.LikeCommentRequest) (*api.LikeCommentResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
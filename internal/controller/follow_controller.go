@@ -6,8 +6,11 @@ import (
 	"aeibi/internal/service"
 	"context"
 
+	"github.com/google/uuid"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type FollowHandler struct {
@@ -57,3 +60,107 @@ func (h *FollowHandler) ListMyFollowing(ctx context.Context, req *api.ListMyFoll
 	}
 	return h.svc.ListMyFollowing(ctx, uid, req)
 }
+
+func (h *FollowHandler) ListUserFollowers(ctx context.Context, req *api.ListUserFollowersRequest) (*api.ListUserFollowersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	if _, err := uuid.Parse(req.Uid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "uid is invalid")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.ListUserFollowers(ctx, viewerUid, req)
+}
+
+func (h *FollowHandler) ListUserFollowing(ctx context.Context, req *api.ListUserFollowingRequest) (*api.ListUserFollowingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	if _, err := uuid.Parse(req.Uid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "uid is invalid")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.ListUserFollowing(ctx, viewerUid, req)
+}
+
+func (h *FollowHandler) ListMutualFollowers(ctx context.Context, req *api.ListMutualFollowersRequest) (*api.ListMutualFollowersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	if _, err := uuid.Parse(req.Uid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "uid is invalid")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListMutualFollowers(ctx, uid, req)
+}
+
+func (h *FollowHandler) SuggestUsersToFollow(ctx context.Context, req *api.SuggestUsersToFollowRequest) (*api.SuggestUsersToFollowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.SuggestUsersToFollow(ctx, uid, req)
+}
+
+func (h *FollowHandler) DismissFollowSuggestion(ctx context.Context, req *api.DismissFollowSuggestionRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	if _, err := uuid.Parse(req.Uid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "uid is invalid")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if uid == req.Uid {
+		return nil, status.Error(codes.InvalidArgument, "cannot dismiss yourself")
+	}
+	if err := h.svc.DismissFollowSuggestion(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *FollowHandler) ExportFollows(ctx context.Context, req *api.ExportFollowsRequest) (*httpbody.HttpBody, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ExportFollows(ctx, uid, req)
+}
+
+func (h *FollowHandler) ImportFollows(ctx context.Context, req *api.ImportFollowsRequest) (*api.ImportFollowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.FileUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "file_url is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ImportFollows(ctx, uid, req)
+}
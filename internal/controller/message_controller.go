@@ -42,6 +42,28 @@ func (h *MessageHandler) ListFollowInboxMessages(ctx context.Context, req *api.L
 	return h.svc.ListFollowInboxMessages(ctx, uid, req)
 }
 
+func (h *MessageHandler) ListLikeInboxMessages(ctx context.Context, req *api.ListLikeInboxMessagesRequest) (*api.ListLikeInboxMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListLikeInboxMessages(ctx, uid, req)
+}
+
+func (h *MessageHandler) ListSystemInboxMessages(ctx context.Context, req *api.ListSystemInboxMessagesRequest) (*api.ListSystemInboxMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListSystemInboxMessages(ctx, uid, req)
+}
+
 func (h *MessageHandler) DeleteInboxMessage(ctx context.Context, req *api.DeleteInboxMessageRequest) (*emptypb.Empty, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
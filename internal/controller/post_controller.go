@@ -5,6 +5,7 @@ import (
 	"aeibi/internal/auth"
 	"aeibi/internal/service"
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -29,6 +30,11 @@ func (h *PostHandler) CreatePost(ctx context.Context, req *api.CreatePostRequest
 	if req.Text == "" {
 		return nil, status.Error(codes.InvalidArgument, "content is required")
 	}
+	switch req.CommentPolicy {
+	case "", "EVERYONE", "FOLLOWERS", "LOCKED":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "comment_policy is invalid")
+	}
 	uid, ok := auth.SubjectFromContext(ctx)
 	if !ok || uid == "" {
 		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
@@ -62,6 +68,12 @@ func (h *PostHandler) ListPosts(ctx context.Context, req *api.ListPostsRequest)
 			return nil, status.Error(codes.InvalidArgument, "author_uid is invalid")
 		}
 	}
+	req.Sort = strings.TrimSpace(req.Sort)
+	switch req.Sort {
+	case "", "latest", "active", "hot":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "sort is invalid")
+	}
 	viewerUid, _ := auth.SubjectFromContext(ctx)
 	return h.svc.ListPosts(ctx, viewerUid, req)
 }
@@ -78,16 +90,25 @@ func (h *PostHandler) SearchPosts(ctx context.Context, req *api.SearchPostsReque
 			return nil, status.Error(codes.InvalidArgument, "author_uid is invalid")
 		}
 	}
+	req.Sort = strings.TrimSpace(req.Sort)
+	switch req.Sort {
+	case "", "latest", "active", "hot", "views":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "sort is invalid")
+	}
 	viewerUid, _ := auth.SubjectFromContext(ctx)
 	return h.svc.SearchPosts(ctx, viewerUid, req)
 }
 
-func (h *PostHandler) ListMyCollections(ctx context.Context, req *api.ListPostsRequest) (*api.ListPostsResponse, error) {
+func (h *PostHandler) ListMyCollections(ctx context.Context, req *api.ListMyCollectionsRequest) (*api.ListPostsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
 	}
-	if strings.TrimSpace(req.Query) != "" || strings.TrimSpace(req.AuthorUid) != "" || strings.TrimSpace(req.TagName) != "" {
-		return nil, status.Error(codes.InvalidArgument, "query, author_uid, tag_name are not supported")
+	req.FolderUid = strings.TrimSpace(req.FolderUid)
+	if req.FolderUid != "" {
+		if _, err := uuid.Parse(req.FolderUid); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "folder_uid is invalid")
+		}
 	}
 	uid, ok := auth.SubjectFromContext(ctx)
 	if !ok || uid == "" {
@@ -96,6 +117,17 @@ func (h *PostHandler) ListMyCollections(ctx context.Context, req *api.ListPostsR
 	return h.svc.ListMyCollections(ctx, uid, req)
 }
 
+func (h *PostHandler) ListCollectionFolderPosts(ctx context.Context, req *api.ListCollectionFolderPostsRequest) (*api.ListPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.ListCollectionFolderPosts(ctx, viewerUid, req)
+}
+
 func (h *PostHandler) SearchTags(ctx context.Context, req *api.SearchTagsRequest) (*api.SearchTagsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
@@ -106,6 +138,77 @@ func (h *PostHandler) SearchTags(ctx context.Context, req *api.SearchTagsRequest
 	return h.svc.SearchTags(ctx, req)
 }
 
+func (h *PostHandler) ListTrendingTags(ctx context.Context, req *api.ListTrendingTagsRequest) (*api.ListTrendingTagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	return h.svc.ListTrendingTags(ctx, req)
+}
+
+func (h *PostHandler) GetTag(ctx context.Context, req *api.GetTagRequest) (*api.GetTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.GetTag(ctx, viewerUid, req)
+}
+
+func (h *PostHandler) FollowTag(ctx context.Context, req *api.FollowTagRequest) (*api.FollowTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.FollowTag(ctx, uid, req)
+}
+
+func (h *PostHandler) UnfollowTag(ctx context.Context, req *api.UnfollowTagRequest) (*api.UnfollowTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.UnfollowTag(ctx, uid, req)
+}
+
+func (h *PostHandler) ListMyFollowedTags(ctx context.Context, req *api.ListMyFollowedTagsRequest) (*api.ListMyFollowedTagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListMyFollowedTags(ctx, uid, req)
+}
+
+func (h *PostHandler) ListMyFeed(ctx context.Context, req *api.ListMyFeedRequest) (*api.ListPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListMyFeed(ctx, uid, req)
+}
+
 func (h *PostHandler) SuggestTagsByPrefix(ctx context.Context, req *api.SuggestTagsByPrefixRequest) (*api.SuggestTagsByPrefixResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
@@ -129,6 +232,13 @@ func (h *PostHandler) UpdatePost(ctx context.Context, req *api.UpdatePostRequest
 	if req.UpdateMask == nil || len(req.UpdateMask.Paths) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
 	}
+	if slices.Contains(req.UpdateMask.Paths, "comment_policy") {
+		switch req.Post.CommentPolicy {
+		case "EVERYONE", "FOLLOWERS", "LOCKED":
+		default:
+			return nil, status.Error(codes.InvalidArgument, "comment_policy is invalid")
+		}
+	}
 	uid, ok := auth.SubjectFromContext(ctx)
 	if !ok || uid == "" {
 		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
@@ -156,6 +266,34 @@ func (h *PostHandler) DeletePost(ctx context.Context, req *api.DeletePostRequest
 	return &emptypb.Empty{}, nil
 }
 
+func (h *PostHandler) ListMyTrashedPosts(ctx context.Context, req *api.ListMyTrashedPostsRequest) (*api.ListMyTrashedPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListMyTrashedPosts(ctx, uid, req)
+}
+
+func (h *PostHandler) RestorePost(ctx context.Context, req *api.RestorePostRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.RestorePost(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (h *PostHandler) LikePost(ctx context.Context, req *api.LikePostRequest) (*api.LikePostResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
@@ -183,3 +321,25 @@ func (h *PostHandler) CollectPost(ctx context.Context, req *api.CollectPostReque
 	}
 	return h.svc.CollectPost(ctx, uid, req)
 }
+
+func (h *PostHandler) ListPostLikers(ctx context.Context, req *api.ListPostLikersRequest) (*api.ListPostLikersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.ListPostLikers(ctx, viewerUid, req)
+}
+
+func (h *PostHandler) ListPostCollectors(ctx context.Context, req *api.ListPostCollectorsRequest) (*api.ListPostCollectorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.ListPostCollectors(ctx, viewerUid, req)
+}
@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"aeibi/api"
+	"aeibi/internal/auth"
+	"aeibi/internal/service"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ReactionHandler struct {
+	api.UnimplementedReactionServiceServer
+	postSvc    *service.PostService
+	commentSvc *service.CommentService
+}
+
+func NewReactionHandler(postSvc *service.PostService, commentSvc *service.CommentService) *ReactionHandler {
+	return &ReactionHandler{postSvc: postSvc, commentSvc: commentSvc}
+}
+
+func (h *ReactionHandler) ListReactionEmojis(ctx context.Context, req *api.ListReactionEmojisRequest) (*api.ListReactionEmojisResponse, error) {
+	return h.postSvc.ListReactionEmojis(), nil
+}
+
+func (h *ReactionHandler) React(ctx context.Context, req *api.ReactRequest) (*api.ReactResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if err := checkReactionTarget(req.PostUid, req.CommentUid); err != nil {
+		return nil, err
+	}
+	if req.Emoji == "" {
+		return nil, status.Error(codes.InvalidArgument, "emoji is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.react(ctx, uid, req.PostUid, req.CommentUid, req.Emoji, api.ToggleAction_TOGGLE_ACTION_ADD)
+}
+
+func (h *ReactionHandler) Unreact(ctx context.Context, req *api.UnreactRequest) (*api.ReactResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if err := checkReactionTarget(req.PostUid, req.CommentUid); err != nil {
+		return nil, err
+	}
+	if req.Emoji == "" {
+		return nil, status.Error(codes.InvalidArgument, "emoji is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.react(ctx, uid, req.PostUid, req.CommentUid, req.Emoji, api.ToggleAction_TOGGLE_ACTION_REMOVE)
+}
+
+func (h *ReactionHandler) ListReactors(ctx context.Context, req *api.ListReactorsRequest) (*api.ListReactorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if err := checkReactionTarget(req.PostUid, req.CommentUid); err != nil {
+		return nil, err
+	}
+	if req.Emoji == "" {
+		return nil, status.Error(codes.InvalidArgument, "emoji is required")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	if req.PostUid != "" {
+		return h.postSvc.ListPostReactors(ctx, viewerUid, req)
+	}
+	return h.commentSvc.ListCommentReactors(ctx, viewerUid, req)
+}
+
+func (h *ReactionHandler) react(ctx context.Context, uid, postUid, commentUid, emoji string, action api.ToggleAction) (*api.ReactResponse, error) {
+	var reactions []*api.Reaction
+	var err error
+	if postUid != "" {
+		reactions, err = h.postSvc.ReactPost(ctx, uid, postUid, emoji, action)
+	} else {
+		reactions, err = h.commentSvc.ReactComment(ctx, uid, commentUid, emoji, action)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &api.ReactResponse{Reactions: reactions}, nil
+}
+
+func checkReactionTarget(postUid, commentUid string) error {
+	if (postUid == "") == (commentUid == "") {
+		return status.Error(codes.InvalidArgument, "exactly one of post_uid and comment_uid is required")
+	}
+	return nil
+}
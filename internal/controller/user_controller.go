@@ -5,6 +5,7 @@ import (
 	"aeibi/internal/auth"
 	"aeibi/internal/service"
 	"context"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -88,6 +89,13 @@ func (h *UserHandler) UpdateMe(ctx context.Context, req *api.UpdateMeRequest) (*
 	if req.UpdateMask == nil || len(req.UpdateMask.Paths) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
 	}
+	if slices.Contains(req.UpdateMask.Paths, "sensitive_media_preference") {
+		switch req.User.SensitiveMediaPreference {
+		case "SHOW", "HIDE", "FILTER":
+		default:
+			return nil, status.Error(codes.InvalidArgument, "sensitive_media_preference is invalid")
+		}
+	}
 	uid, ok := auth.SubjectFromContext(ctx)
 	if !ok || uid == "" {
 		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
@@ -0,0 +1,10 @@
+package env
+
+import (
+	"aeibi/internal/config"
+	"aeibi/internal/geoip"
+)
+
+func InitGeoIP(cfg config.GeoIPConfig) (*geoip.Resolver, error) {
+	return geoip.Open(cfg.DatabasePath, cfg.Language)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"aeibi/internal/async"
+	"aeibi/internal/linkpreview"
+	"aeibi/internal/repository/oss"
 	searchrepo "aeibi/internal/repository/search"
 
 	"github.com/jackc/pgx/v5"
@@ -12,7 +14,7 @@ import (
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
 )
 
-func InitRiverClient(pool *pgxpool.Pool, search *searchrepo.Search) (*river.Client[pgx.Tx], error) {
+func InitRiverClient(pool *pgxpool.Pool, search *searchrepo.Search, ossClient *oss.OSS) (*river.Client[pgx.Tx], error) {
 	workers := river.NewWorkers()
 
 	if err := river.AddWorkerSafely(workers, async.NewFollowInboxWorker(pool)); err != nil {
@@ -21,24 +23,74 @@ func InitRiverClient(pool *pgxpool.Pool, search *searchrepo.Search) (*river.Clie
 	if err := river.AddWorkerSafely(workers, async.NewCommentInboxWorker(pool)); err != nil {
 		return nil, fmt.Errorf("register comment inbox worker: %w", err)
 	}
+	if err := river.AddWorkerSafely(workers, async.NewLikeInboxWorker(pool)); err != nil {
+		return nil, fmt.Errorf("register like inbox worker: %w", err)
+	}
 	if err := river.AddWorkerSafely(workers, async.NewUpdatePostSearchWorker(pool, search)); err != nil {
 		return nil, fmt.Errorf("register post search worker: %w", err)
 	}
 	if err := river.AddWorkerSafely(workers, async.NewUpdateUserSearchWorker(pool, search)); err != nil {
 		return nil, fmt.Errorf("register user search worker: %w", err)
 	}
-	if err := river.AddWorkerSafely(workers, async.NewUpdateTagSearchWorker(search)); err != nil {
+	if err := river.AddWorkerSafely(workers, async.NewUpdateTagSearchWorker(pool, search)); err != nil {
 		return nil, fmt.Errorf("register tag search worker: %w", err)
 	}
+	if err := river.AddWorkerSafely(workers, async.NewUpdateCommentSearchWorker(pool, search)); err != nil {
+		return nil, fmt.Errorf("register comment search worker: %w", err)
+	}
+	if err := river.AddWorkerSafely(workers, async.NewRefreshPostHotScoresWorker(pool, search)); err != nil {
+		return nil, fmt.Errorf("register post hot score worker: %w", err)
+	}
+	if err := river.AddWorkerSafely(workers, async.NewRefreshCommentBestScoresWorker(pool)); err != nil {
+		return nil, fmt.Errorf("register comment best score worker: %w", err)
+	}
+	if err := river.AddWorkerSafely(workers, async.NewRefreshTagTrendsWorker(pool, search)); err != nil {
+		return nil, fmt.Errorf("register tag trend worker: %w", err)
+	}
+	if err := river.AddWorkerSafely(workers, async.NewRefreshFollowSuggestionsWorker(pool)); err != nil {
+		return nil, fmt.Errorf("register follow suggestion worker: %w", err)
+	}
+
+	// The SSRF guard stays on: AllowPrivateNetworks is only for tests.
+	if err := river.AddWorkerSafely(workers, async.NewLinkPreviewWorker(pool, ossClient, linkpreview.New(linkpreview.Config{}))); err != nil {
+		return nil, fmt.Errorf("register link preview worker: %w", err)
+	}
+	if err := river.AddWorkerSafely(workers, async.NewFollowImportWorker(pool, ossClient)); err != nil {
+		return nil, fmt.Errorf("register follow import worker: %w", err)
+	}
+	if err := river.AddWorkerSafely(workers, async.NewPurgeTrashWorker(pool, ossClient)); err != nil {
+		return nil, fmt.Errorf("register trash purge worker: %w", err)
+	}
+	if err := river.AddWorkerSafely(workers, async.NewReconcileCountersWorker(pool)); err != nil {
+		return nil, fmt.Errorf("register counter reconcile worker: %w", err)
+	}
 
 	client, err := river.NewClient(riverpgxv5.New(pool), &river.Config{
 		Workers: workers,
 		Queues: map[string]river.QueueConfig{
-			async.QueueFollowInbox:  {MaxWorkers: 100},
-			async.QueueCommentInbox: {MaxWorkers: 100},
-			async.QueuePostSearch:   {MaxWorkers: 100},
-			async.QueueUserSearch:   {MaxWorkers: 100},
-			async.QueueTagSearch:    {MaxWorkers: 100},
+			async.QueueFollowInbox:      {MaxWorkers: 100},
+			async.QueueCommentInbox:     {MaxWorkers: 100},
+			async.QueueLikeInbox:        {MaxWorkers: 100},
+			async.QueuePostSearch:       {MaxWorkers: 100},
+			async.QueueUserSearch:       {MaxWorkers: 100},
+			async.QueueTagSearch:        {MaxWorkers: 100},
+			async.QueueCommentSearch:    {MaxWorkers: 100},
+			async.QueuePostHotScore:     {MaxWorkers: 1},
+			async.QueueCommentBestScore: {MaxWorkers: 1},
+			async.QueueTagTrend:         {MaxWorkers: 1},
+			async.QueueFollowSuggestion: {MaxWorkers: 2},
+			async.QueueLinkPreview:      {MaxWorkers: 10},
+			async.QueueFollowImport:     {MaxWorkers: 5},
+			async.QueueTrashPurge:       {MaxWorkers: 1},
+			async.QueueCounterReconcile: {MaxWorkers: 1},
+		},
+		PeriodicJobs: []*river.PeriodicJob{
+			async.NewRefreshPostHotScoresPeriodicJob(),
+			async.NewRefreshCommentBestScoresPeriodicJob(),
+			async.NewRefreshTagTrendsPeriodicJob(),
+			async.NewRefreshFollowSuggestionsPeriodicJob(),
+			async.NewPurgeTrashPeriodicJob(),
+			async.NewReconcileCountersPeriodicJob(),
 		},
 	})
 	if err != nil {
@@ -47,3 +99,13 @@ func InitRiverClient(pool *pgxpool.Pool, search *searchrepo.Search) (*river.Clie
 
 	return client, nil
 }
+
+// InitRiverInsertClient returns a client that can only enqueue jobs, for
+// one-off commands that must not start workers.
+func InitRiverInsertClient(pool *pgxpool.Pool) (*river.Client[pgx.Tx], error) {
+	client, err := river.NewClient(riverpgxv5.New(pool), &river.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("create river insert-only client: %w", err)
+	}
+	return client, nil
+}
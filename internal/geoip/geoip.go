@@ -0,0 +1,78 @@
+// Package geoip resolves client IPs to a coarse region using a local
+// MaxMind-format database.
+package geoip
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+type Resolver struct {
+	reader   *maxminddb.Reader
+	language string
+}
+
+type record struct {
+	Country struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"country"`
+	Subdivisions []struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"subdivisions"`
+}
+
+// Open loads the database at path. An empty path yields a Resolver that
+// resolves every IP to "".
+func Open(path string, language string) (*Resolver, error) {
+	if language == "" {
+		language = "en"
+	}
+	if path == "" {
+		return &Resolver{language: language}, nil
+	}
+
+	reader, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open geoip database: %w", err)
+	}
+	return &Resolver{reader: reader, language: language}, nil
+}
+
+// Region returns the first-level subdivision (province/state) when known,
+// otherwise the country. Private and unknown addresses resolve to "".
+func (r *Resolver) Region(ip string) string {
+	if r == nil || r.reader == nil {
+		return ""
+	}
+	parsed := net.ParseIP(ip)
+	if parsed == nil || parsed.IsLoopback() || parsed.IsPrivate() || parsed.IsUnspecified() {
+		return ""
+	}
+
+	var rec record
+	if err := r.reader.Lookup(parsed, &rec); err != nil {
+		return ""
+	}
+	if len(rec.Subdivisions) > 0 {
+		if name := r.name(rec.Subdivisions[0].Names); name != "" {
+			return name
+		}
+	}
+	return r.name(rec.Country.Names)
+}
+
+func (r *Resolver) name(names map[string]string) string {
+	if name, ok := names[r.language]; ok {
+		return name
+	}
+	return names["en"]
+}
+
+func (r *Resolver) Close() error {
+	if r == nil || r.reader == nil {
+		return nil
+	}
+	return r.reader.Close()
+}
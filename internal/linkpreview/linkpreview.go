@@ -0,0 +1,345 @@
+// Package linkpreview fetches OpenGraph / Twitter card metadata for URLs
+// found in post text.
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	defaultTimeout       = 5 * time.Second
+	defaultMaxHTMLBytes  = 512 * 1024
+	defaultMaxImageBytes = 2 * 1024 * 1024
+	maxRedirects         = 3
+	maxURLLength         = 2048
+	maxTitleLength       = 300
+	maxDescriptionLength = 1000
+)
+
+// ErrBlocked is returned when a URL resolves to an address the fetcher is not
+// allowed to reach.
+var ErrBlocked = errors.New("link preview: destination not allowed")
+
+type Config struct {
+	Timeout       time.Duration
+	MaxHTMLBytes  int64
+	MaxImageBytes int64
+	// AllowPrivateNetworks disables the SSRF guard so the fetcher can talk to
+	// a local stub server. It exists for tests only and is deliberately not
+	// exposed in the app config.
+	AllowPrivateNetworks bool
+}
+
+type Preview struct {
+	URL         string
+	Title       string
+	Description string
+	SiteName    string
+	ImageURL    string
+}
+
+type Image struct {
+	Data        []byte
+	ContentType string
+}
+
+type Fetcher struct {
+	client        *http.Client
+	maxHTMLBytes  int64
+	maxImageBytes int64
+	allowPrivate  bool
+}
+
+func New(cfg Config) *Fetcher {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.MaxHTMLBytes <= 0 {
+		cfg.MaxHTMLBytes = defaultMaxHTMLBytes
+	}
+	if cfg.MaxImageBytes <= 0 {
+		cfg.MaxImageBytes = defaultMaxImageBytes
+	}
+
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	if !cfg.AllowPrivateNetworks {
+		// Checked on the resolved address right before connecting, so DNS
+		// rebinding and redirects to internal hosts are covered too.
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, port, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if port != "80" && port != "443" {
+				return ErrBlocked
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublicIP(ip) {
+				return ErrBlocked
+			}
+			return nil
+		}
+	}
+
+	transport := &http.Transport{
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   cfg.Timeout,
+		ResponseHeaderTimeout: cfg.Timeout,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+	}
+
+	f := &Fetcher{
+		maxHTMLBytes:  cfg.MaxHTMLBytes,
+		maxImageBytes: cfg.MaxImageBytes,
+		allowPrivate:  cfg.AllowPrivateNetworks,
+	}
+	f.client = &http.Client{
+		Transport: transport,
+		Timeout:   cfg.Timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			// via holds every request made so far, the original included.
+			if len(via) > maxRedirects {
+				return fmt.Errorf("link preview: too many redirects")
+			}
+			return f.checkURL(req.URL)
+		},
+	}
+	return f
+}
+
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"'` + "`" + `]+`)
+
+// FirstURL returns the first http(s) URL in text, or "" when there is none.
+func FirstURL(text string) string {
+	for _, match := range urlPattern.FindAllString(text, -1) {
+		match = strings.TrimRight(match, ".,;:!?)]}")
+		if len(match) > maxURLLength {
+			continue
+		}
+		u, err := url.Parse(match)
+		if err != nil || u.Host == "" {
+			continue
+		}
+		return u.String()
+	}
+	return ""
+}
+
+// Fetch downloads rawURL and extracts its preview metadata. ImageURL is
+// resolved against the final page URL but not downloaded.
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (*Preview, error) {
+	resp, err := f.get(ctx, rawURL, "text/html,application/xhtml+xml")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, fmt.Errorf("link preview: unsupported content type %q", mediaType)
+	}
+
+	meta := parseMeta(io.LimitReader(resp.Body, f.maxHTMLBytes))
+
+	preview := &Preview{
+		URL:         rawURL,
+		Title:       truncate(firstNonEmpty(meta["og:title"], meta["twitter:title"], meta["title"]), maxTitleLength),
+		Description: truncate(firstNonEmpty(meta["og:description"], meta["twitter:description"], meta["description"]), maxDescriptionLength),
+		SiteName:    truncate(meta["og:site_name"], maxTitleLength),
+	}
+	if image := firstNonEmpty(meta["og:image"], meta["og:image:url"], meta["twitter:image"], meta["twitter:image:src"]); image != "" {
+		if ref, err := resp.Request.URL.Parse(image); err == nil && (ref.Scheme == "http" || ref.Scheme == "https") {
+			preview.ImageURL = ref.String()
+		}
+	}
+	if preview.Title == "" && preview.Description == "" {
+		return nil, fmt.Errorf("link preview: no metadata found")
+	}
+	return preview, nil
+}
+
+// FetchImage downloads a preview image, rejecting anything that is not an
+// image or exceeds the size cap.
+func (f *Fetcher) FetchImage(ctx context.Context, rawURL string) (*Image, error) {
+	resp, err := f.get(ctx, rawURL, "image/*")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.ContentLength > f.maxImageBytes {
+		return nil, fmt.Errorf("link preview: image too large")
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, f.maxImageBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("link preview: read image: %w", err)
+	}
+	if int64(len(data)) > f.maxImageBytes {
+		return nil, fmt.Errorf("link preview: image too large")
+	}
+
+	contentType := http.DetectContentType(data)
+	if !strings.HasPrefix(contentType, "image/") {
+		return nil, fmt.Errorf("link preview: unsupported image type %q", contentType)
+	}
+	return &Image{Data: data, ContentType: contentType}, nil
+}
+
+func (f *Fetcher) get(ctx context.Context, rawURL string, accept string) (*http.Response, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("link preview: parse url: %w", err)
+	}
+	if err := f.checkURL(u); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("link preview: new request: %w", err)
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("User-Agent", "AeiBiBot/1.0 (+link preview)")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("link preview: fetch: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("link preview: unexpected status %d", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+func (f *Fetcher) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ErrBlocked
+	}
+	if u.User != nil || u.Hostname() == "" {
+		return ErrBlocked
+	}
+	if f.allowPrivate {
+		return nil
+	}
+	if ip := net.ParseIP(u.Hostname()); ip != nil && !isPublicIP(ip) {
+		return ErrBlocked
+	}
+	return nil
+}
+
+var blockedNetworks = mustParseCIDRs(
+	"0.0.0.0/8",
+	"100.64.0.0/10", // carrier-grade NAT
+	"192.0.0.0/24",
+	"198.18.0.0/15",
+	"240.0.0.0/4",
+	"64:ff9b::/96", // NAT64 can map onto internal IPv4 addresses
+)
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// parseMeta collects <meta> tags and <title> from the document head.
+func parseMeta(r io.Reader) map[string]string {
+	meta := make(map[string]string)
+	tokenizer := html.NewTokenizer(r)
+	inTitle := false
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return meta
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.Data {
+			case "body":
+				return meta
+			case "title":
+				inTitle = true
+			case "meta":
+				var key, content string
+				for _, attr := range token.Attr {
+					switch attr.Key {
+					case "property", "name":
+						key = strings.ToLower(strings.TrimSpace(attr.Val))
+					case "content":
+						content = strings.TrimSpace(attr.Val)
+					}
+				}
+				if key != "" && content != "" {
+					if _, ok := meta[key]; !ok {
+						meta[key] = content
+					}
+				}
+			}
+		case html.TextToken:
+			if inTitle {
+				if _, ok := meta["title"]; !ok {
+					meta["title"] = strings.TrimSpace(string(tokenizer.Text()))
+				}
+			}
+		case html.EndTagToken:
+			switch tokenizer.Token().Data {
+			case "head":
+				return meta
+			case "title":
+				inTitle = false
+			}
+		}
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
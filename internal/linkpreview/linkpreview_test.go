@@ -0,0 +1,170 @@
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func newTestFetcher(cfg Config) *Fetcher {
+	cfg.AllowPrivateNetworks = true
+	return New(cfg)
+}
+
+func TestFetchOpenGraph(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, `<!doctype html><html><head>
+<title>Fallback title</title>
+<meta property="og:title" content=" OG title ">
+<meta property="og:description" content="OG description">
+<meta property="og:site_name" content="Example">
+<meta property="og:image" content="/img/card.png">
+</head><body><meta property="og:title" content="ignored"></body></html>`)
+	}))
+	defer srv.Close()
+
+	preview, err := newTestFetcher(Config{}).Fetch(context.Background(), srv.URL+"/post")
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if preview.Title != "OG title" {
+		t.Errorf("Title = %q, want %q", preview.Title, "OG title")
+	}
+	if preview.Description != "OG description" {
+		t.Errorf("Description = %q, want %q", preview.Description, "OG description")
+	}
+	if preview.SiteName != "Example" {
+		t.Errorf("SiteName = %q, want %q", preview.SiteName, "Example")
+	}
+	if want := srv.URL + "/img/card.png"; preview.ImageURL != want {
+		t.Errorf("ImageURL = %q, want %q", preview.ImageURL, want)
+	}
+}
+
+func TestFetchFallsBackToTitle(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><title>Plain page</title><meta name="description" content="About it"></head></html>`)
+	}))
+	defer srv.Close()
+
+	preview, err := newTestFetcher(Config{}).Fetch(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if preview.Title != "Plain page" || preview.Description != "About it" {
+		t.Errorf("got title %q description %q", preview.Title, preview.Description)
+	}
+}
+
+func TestFetchRejectsNonHTML(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"title":"nope"}`)
+	}))
+	defer srv.Close()
+
+	if _, err := newTestFetcher(Config{}).Fetch(context.Background(), srv.URL); err == nil {
+		t.Fatal("Fetch: want error for non-HTML content")
+	}
+}
+
+func TestFetchHTMLSizeCap(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><!--`+strings.Repeat("x", 4096)+`-->`)
+		fmt.Fprint(w, `<meta property="og:title" content="Too late"></head></html>`)
+	}))
+	defer srv.Close()
+
+	if _, err := newTestFetcher(Config{MaxHTMLBytes: 1024}).Fetch(context.Background(), srv.URL); err == nil {
+		t.Fatal("Fetch: want error when metadata is past the size cap")
+	}
+	if _, err := newTestFetcher(Config{}).Fetch(context.Background(), srv.URL); err != nil {
+		t.Fatalf("Fetch with default cap: %v", err)
+	}
+}
+
+func TestFetchImageSizeCap(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(append(png, make([]byte, size)...))
+	}))
+	defer srv.Close()
+
+	f := newTestFetcher(Config{MaxImageBytes: 1024})
+	image, err := f.FetchImage(context.Background(), srv.URL+"?size=100")
+	if err != nil {
+		t.Fatalf("FetchImage: %v", err)
+	}
+	if image.ContentType != "image/png" {
+		t.Errorf("ContentType = %q, want image/png", image.ContentType)
+	}
+	if _, err := f.FetchImage(context.Background(), srv.URL+"?size=2048"); err == nil {
+		t.Fatal("FetchImage: want error for image over the size cap")
+	}
+}
+
+func TestFetchRedirectLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hops, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/"))
+		if hops > 0 {
+			http.Redirect(w, r, "/"+strconv.Itoa(hops-1), http.StatusFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><title>Landed</title></head></html>`)
+	}))
+	defer srv.Close()
+
+	f := newTestFetcher(Config{})
+	if _, err := f.Fetch(context.Background(), srv.URL+"/"+strconv.Itoa(maxRedirects)); err != nil {
+		t.Fatalf("Fetch with %d redirects: %v", maxRedirects, err)
+	}
+	if _, err := f.Fetch(context.Background(), srv.URL+"/"+strconv.Itoa(maxRedirects+1)); err == nil {
+		t.Fatalf("Fetch with %d redirects: want error", maxRedirects+1)
+	}
+}
+
+func TestFetchBlocksPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><title>Internal</title></head></html>`)
+	}))
+	defer srv.Close()
+
+	f := New(Config{})
+	for _, rawURL := range []string{
+		srv.URL, // literal loopback IP, rejected before dialing
+		strings.Replace(srv.URL, "127.0.0.1", "localhost", 1), // resolved at dial time
+		"http://10.0.0.1/",
+		"http://[::1]/",
+		"http://169.254.169.254/latest/meta-data/",
+		"ftp://example.com/",
+	} {
+		if _, err := f.Fetch(context.Background(), rawURL); !errors.Is(err, ErrBlocked) {
+			t.Errorf("Fetch(%q) error = %v, want ErrBlocked", rawURL, err)
+		}
+	}
+}
+
+func TestFirstURL(t *testing.T) {
+	tests := map[string]string{
+		"see https://example.com/a, thanks": "https://example.com/a",
+		"(http://example.com/x)":            "http://example.com/x",
+		"no links here":                     "",
+	}
+	for text, want := range tests {
+		if got := FirstURL(text); got != want {
+			t.Errorf("FirstURL(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Package reaction holds the configured set of emoji reactions.
+package reaction
+
+import (
+	"fmt"
+	"strings"
+)
+
+var defaultEmojis = []string{"👍", "❤️", "😂", "😮", "😢", "🎉"}
+
+// Set is the ordered list of emojis users may react with. The first emoji is
+// the default reaction and is backed by likes, so LikePost and LikeComment
+// keep working as that reaction.
+type Set struct {
+	emojis []string
+	index  map[string]int
+}
+
+// New builds a Set from emojis, falling back to the built-in set when empty.
+func New(emojis []string) (*Set, error) {
+	if len(emojis) == 0 {
+		emojis = defaultEmojis
+	}
+
+	s := &Set{index: make(map[string]int, len(emojis))}
+	for _, emoji := range emojis {
+		emoji = strings.TrimSpace(emoji)
+		if emoji == "" {
+			return nil, fmt.Errorf("reaction emoji is empty")
+		}
+		if _, ok := s.index[emoji]; ok {
+			return nil, fmt.Errorf("duplicate reaction emoji %q", emoji)
+		}
+		s.index[emoji] = len(s.emojis)
+		s.emojis = append(s.emojis, emoji)
+	}
+	return s, nil
+}
+
+// Default returns the reaction that likes map onto.
+func (s *Set) Default() string {
+	return s.emojis[0]
+}
+
+// Emojis returns every configured emoji, default first.
+func (s *Set) Emojis() []string {
+	return append([]string(nil), s.emojis...)
+}
+
+func (s *Set) Contains(emoji string) bool {
+	_, ok := s.index[emoji]
+	return ok
+}
+
+// Position orders emojis as configured; unknown emojis sort last.
+func (s *Set) Position(emoji string) int {
+	if i, ok := s.index[emoji]; ok {
+		return i
+	}
+	return len(s.emojis)
+}
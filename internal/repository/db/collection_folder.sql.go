@@ -0,0 +1,470 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: collection_folder.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countCollectionFolderItemsByUserAndPost = `-- name: CountCollectionFolderItemsByUserAndPost :one
+SELECT count(*)::int4
+FROM collection_folder_items
+WHERE user_uid = $1
+  AND post_uid = $2
+`
+
+type CountCollectionFolderItemsByUserAndPostParams struct {
+	UserUid uuid.UUID
+	PostUid uuid.UUID
+}
+
+func (q *Queries) CountCollectionFolderItemsByUserAndPost(ctx context.Context, arg CountCollectionFolderItemsByUserAndPostParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countCollectionFolderItemsByUserAndPost, arg.UserUid, arg.PostUid)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countCollectionFoldersByOwner = `-- name: CountCollectionFoldersByOwner :one
+SELECT count(*)::int4
+FROM collection_folders
+WHERE owner_uid = $1
+`
+
+func (q *Queries) CountCollectionFoldersByOwner(ctx context.Context, ownerUid uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countCollectionFoldersByOwner, ownerUid)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createCollectionFolder = `-- name: CreateCollectionFolder :one
+INSERT INTO collection_folders (uid, owner_uid, name, is_public, position)
+SELECT $1,
+  $2,
+  $3,
+  $4,
+  COALESCE(MAX(f.position) + 1, 0)::int4
+FROM collection_folders f
+WHERE f.owner_uid = $2
+RETURNING uid,
+  owner_uid,
+  name,
+  is_default,
+  is_public,
+  position,
+  created_at,
+  updated_at
+`
+
+type CreateCollectionFolderParams struct {
+	Uid      uuid.UUID
+	OwnerUid uuid.UUID
+	Name     string
+	IsPublic bool
+}
+
+type CreateCollectionFolderRow struct {
+	Uid       uuid.UUID
+	OwnerUid  uuid.UUID
+	Name      string
+	IsDefault bool
+	IsPublic  bool
+	Position  int32
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateCollectionFolder(ctx context.Context, arg CreateCollectionFolderParams) (CreateCollectionFolderRow, error) {
+	row := q.db.QueryRow(ctx, createCollectionFolder,
+		arg.Uid,
+		arg.OwnerUid,
+		arg.Name,
+		arg.IsPublic,
+	)
+	var i CreateCollectionFolderRow
+	err := row.Scan(
+		&i.Uid,
+		&i.OwnerUid,
+		&i.Name,
+		&i.IsDefault,
+		&i.IsPublic,
+		&i.Position,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteCollectionFolderByUidAndOwner = `-- name: DeleteCollectionFolderByUidAndOwner :execrows
+DELETE FROM collection_folders
+WHERE uid = $1
+  AND owner_uid = $2
+  AND NOT is_default
+`
+
+type DeleteCollectionFolderByUidAndOwnerParams struct {
+	Uid      uuid.UUID
+	OwnerUid uuid.UUID
+}
+
+func (q *Queries) DeleteCollectionFolderByUidAndOwner(ctx context.Context, arg DeleteCollectionFolderByUidAndOwnerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCollectionFolderByUidAndOwner, arg.Uid, arg.OwnerUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCollectionFolderItem = `-- name: DeleteCollectionFolderItem :execrows
+DELETE FROM collection_folder_items
+WHERE folder_uid = $1
+  AND post_uid = $2
+  AND user_uid = $3
+`
+
+type DeleteCollectionFolderItemParams struct {
+	FolderUid uuid.UUID
+	PostUid   uuid.UUID
+	UserUid   uuid.UUID
+}
+
+func (q *Queries) DeleteCollectionFolderItem(ctx context.Context, arg DeleteCollectionFolderItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCollectionFolderItem, arg.FolderUid, arg.PostUid, arg.UserUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCollectionFolderItemsByUserAndPost = `-- name: DeleteCollectionFolderItemsByUserAndPost :exec
+DELETE FROM collection_folder_items
+WHERE user_uid = $1
+  AND post_uid = $2
+`
+
+type DeleteCollectionFolderItemsByUserAndPostParams struct {
+	UserUid uuid.UUID
+	PostUid uuid.UUID
+}
+
+func (q *Queries) DeleteCollectionFolderItemsByUserAndPost(ctx context.Context, arg DeleteCollectionFolderItemsByUserAndPostParams) error {
+	_, err := q.db.Exec(ctx, deleteCollectionFolderItemsByUserAndPost, arg.UserUid, arg.PostUid)
+	return err
+}
+
+const ensureDefaultCollectionFolder = `-- name: EnsureDefaultCollectionFolder :one
+INSERT INTO collection_folders (uid, owner_uid, name, is_default)
+VALUES ($1, $2, $3, true)
+ON CONFLICT (owner_uid)
+WHERE is_default DO
+UPDATE
+SET owner_uid = EXCLUDED.owner_uid
+RETURNING uid
+`
+
+type EnsureDefaultCollectionFolderParams struct {
+	Uid      uuid.UUID
+	OwnerUid uuid.UUID
+	Name     string
+}
+
+func (q *Queries) EnsureDefaultCollectionFolder(ctx context.Context, arg EnsureDefaultCollectionFolderParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, ensureDefaultCollectionFolder, arg.Uid, arg.OwnerUid, arg.Name)
+	var uid uuid.UUID
+	err := row.Scan(&uid)
+	return uid, err
+}
+
+const getCollectionFolderByUid = `-- name: GetCollectionFolderByUid :one
+SELECT uid,
+  owner_uid,
+  name,
+  is_default,
+  is_public
+FROM collection_folders
+WHERE uid = $1
+LIMIT 1
+`
+
+type GetCollectionFolderByUidRow struct {
+	Uid       uuid.UUID
+	OwnerUid  uuid.UUID
+	Name      string
+	IsDefault bool
+	IsPublic  bool
+}
+
+func (q *Queries) GetCollectionFolderByUid(ctx context.Context, uid uuid.UUID) (GetCollectionFolderByUidRow, error) {
+	row := q.db.QueryRow(ctx, getCollectionFolderByUid, uid)
+	var i GetCollectionFolderByUidRow
+	err := row.Scan(
+		&i.Uid,
+		&i.OwnerUid,
+		&i.Name,
+		&i.IsDefault,
+		&i.IsPublic,
+	)
+	return i, err
+}
+
+const insertCollectionFolderItem = `-- name: InsertCollectionFolderItem :exec
+INSERT INTO collection_folder_items (folder_uid, post_uid, user_uid)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type InsertCollectionFolderItemParams struct {
+	FolderUid uuid.UUID
+	PostUid   uuid.UUID
+	UserUid   uuid.UUID
+}
+
+func (q *Queries) InsertCollectionFolderItem(ctx context.Context, arg InsertCollectionFolderItemParams) error {
+	_, err := q.db.Exec(ctx, insertCollectionFolderItem, arg.FolderUid, arg.PostUid, arg.UserUid)
+	return err
+}
+
+const listCollectionFoldersByOwner = `-- name: ListCollectionFoldersByOwner :many
+SELECT f.uid,
+  f.owner_uid,
+  f.name,
+  f.is_default,
+  f.is_public,
+  f.position,
+  f.created_at,
+  f.updated_at,
+  (
+    SELECT count(*)
+    FROM collection_folder_items i
+      JOIN posts p ON p.uid = i.post_uid
+      AND p.status = 'NORMAL'::post_status
+    WHERE i.folder_uid = f.uid
+  )::int4 AS post_count
+FROM collection_folders f
+WHERE f.owner_uid = $1
+  AND (
+    NOT $2::boolean
+    OR f.is_public
+  )
+ORDER BY f.position,
+  f.id
+`
+
+type ListCollectionFoldersByOwnerParams struct {
+	OwnerUid   uuid.UUID
+	PublicOnly bool
+}
+
+type ListCollectionFoldersByOwnerRow struct {
+	Uid       uuid.UUID
+	OwnerUid  uuid.UUID
+	Name      string
+	IsDefault bool
+	IsPublic  bool
+	Position  int32
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+	PostCount int32
+}
+
+func (q *Queries) ListCollectionFoldersByOwner(ctx context.Context, arg ListCollectionFoldersByOwnerParams) ([]ListCollectionFoldersByOwnerRow, error) {
+	rows, err := q.db.Query(ctx, listCollectionFoldersByOwner, arg.OwnerUid, arg.PublicOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCollectionFoldersByOwnerRow
+	for rows.Next() {
+		var i ListCollectionFoldersByOwnerRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.OwnerUid,
+			&i.Name,
+			&i.IsDefault,
+			&i.IsPublic,
+			&i.Position,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PostCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCollectionFoldersByUserAndPost = `-- name: ListCollectionFoldersByUserAndPost :many
+SELECT f.uid AS folder_uid,
+  f.name AS folder_name,
+  i.note,
+  i.created_at
+FROM collection_folder_items i
+  JOIN collection_folders f ON f.uid = i.folder_uid
+WHERE i.user_uid = $1
+  AND i.post_uid = $2
+ORDER BY f.position,
+  f.id
+`
+
+type ListCollectionFoldersByUserAndPostParams struct {
+	UserUid uuid.UUID
+	PostUid uuid.UUID
+}
+
+type ListCollectionFoldersByUserAndPostRow struct {
+	FolderUid  uuid.UUID
+	FolderName string
+	Note       string
+	CreatedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) ListCollectionFoldersByUserAndPost(ctx context.Context, arg ListCollectionFoldersByUserAndPostParams) ([]ListCollectionFoldersByUserAndPostRow, error) {
+	rows, err := q.db.Query(ctx, listCollectionFoldersByUserAndPost, arg.UserUid, arg.PostUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCollectionFoldersByUserAndPostRow
+	for rows.Next() {
+		var i ListCollectionFoldersByUserAndPostRow
+		if err := rows.Scan(
+			&i.FolderUid,
+			&i.FolderName,
+			&i.Note,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveOrphanCollectionFolderItems = `-- name: MoveOrphanCollectionFolderItems :exec
+INSERT INTO collection_folder_items (folder_uid, post_uid, user_uid, note, created_at)
+SELECT $1,
+  i.post_uid,
+  i.user_uid,
+  i.note,
+  i.created_at
+FROM collection_folder_items i
+WHERE i.folder_uid = $2
+  AND NOT EXISTS (
+    SELECT 1
+    FROM collection_folder_items o
+    WHERE o.user_uid = i.user_uid
+      AND o.post_uid = i.post_uid
+      AND o.folder_uid <> i.folder_uid
+  ) ON CONFLICT DO NOTHING
+`
+
+type MoveOrphanCollectionFolderItemsParams struct {
+	TargetFolderUid uuid.UUID
+	FolderUid       uuid.UUID
+}
+
+func (q *Queries) MoveOrphanCollectionFolderItems(ctx context.Context, arg MoveOrphanCollectionFolderItemsParams) error {
+	_, err := q.db.Exec(ctx, moveOrphanCollectionFolderItems, arg.TargetFolderUid, arg.FolderUid)
+	return err
+}
+
+const updateCollectionFolderByUidAndOwner = `-- name: UpdateCollectionFolderByUidAndOwner :execrows
+UPDATE collection_folders
+SET name = COALESCE($1, name),
+  is_public = COALESCE($2::boolean, is_public),
+  updated_at = now()
+WHERE uid = $3
+  AND owner_uid = $4
+`
+
+type UpdateCollectionFolderByUidAndOwnerParams struct {
+	Name     pgtype.Text
+	IsPublic pgtype.Bool
+	Uid      uuid.UUID
+	OwnerUid uuid.UUID
+}
+
+func (q *Queries) UpdateCollectionFolderByUidAndOwner(ctx context.Context, arg UpdateCollectionFolderByUidAndOwnerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateCollectionFolderByUidAndOwner,
+		arg.Name,
+		arg.IsPublic,
+		arg.Uid,
+		arg.OwnerUid,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateCollectionFolderPositions = `-- name: UpdateCollectionFolderPositions :execrows
+UPDATE collection_folders f
+SET position = (x.ord - 1)::int4,
+  updated_at = now()
+FROM unnest($2::uuid []) WITH ORDINALITY AS x(uid, ord)
+WHERE f.uid = x.uid
+  AND f.owner_uid = $1
+`
+
+type UpdateCollectionFolderPositionsParams struct {
+	OwnerUid uuid.UUID
+	Uids     []uuid.UUID
+}
+
+func (q *Queries) UpdateCollectionFolderPositions(ctx context.Context, arg UpdateCollectionFolderPositionsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateCollectionFolderPositions, arg.OwnerUid, arg.Uids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertCollectionFolderItem = `-- name: UpsertCollectionFolderItem :execrows
+INSERT INTO collection_folder_items (folder_uid, post_uid, user_uid, note)
+SELECT $1,
+  $2,
+  $3,
+  $4
+WHERE EXISTS (
+    SELECT 1
+    FROM post_collections pc
+    WHERE pc.post_uid = $2
+      AND pc.user_uid = $3
+  ) ON CONFLICT (folder_uid, post_uid) DO
+UPDATE
+SET note = EXCLUDED.note
+`
+
+type UpsertCollectionFolderItemParams struct {
+	FolderUid uuid.UUID
+	PostUid   uuid.UUID
+	UserUid   uuid.UUID
+	Note      string
+}
+
+func (q *Queries) UpsertCollectionFolderItem(ctx context.Context, arg UpsertCollectionFolderItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, upsertCollectionFolderItem,
+		arg.FolderUid,
+		arg.PostUid,
+		arg.UserUid,
+		arg.Note,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -15,6 +15,9 @@ import (
 const archiveCommentByUidAndAuthor = `-- name: ArchiveCommentByUidAndAuthor :execrows
 UPDATE post_comments
 SET status = 'ARCHIVED'::comment_status,
+  archived_at = now(),
+  archived_by = author_uid,
+  pinned_at = NULL,
   updated_at = now()
 WHERE uid = $1
   AND author_uid = $2
@@ -34,6 +37,65 @@ func (q *Queries) ArchiveCommentByUidAndAuthor(ctx context.Context, arg ArchiveC
 	return result.RowsAffected(), nil
 }
 
+const archiveCommentByUidAndPostAuthor = `-- name: ArchiveCommentByUidAndPostAuthor :execrows
+UPDATE post_comments c
+SET status = 'ARCHIVED'::comment_status,
+  archived_at = now(),
+  archived_by = p.author,
+  pinned_at = NULL,
+  updated_at = now()
+FROM posts p
+WHERE c.uid = $1
+  AND c.status = 'NORMAL'::comment_status
+  AND p.uid = c.post_uid
+  AND p.author = $2
+`
+
+type ArchiveCommentByUidAndPostAuthorParams struct {
+	Uid        uuid.UUID
+	PostAuthor uuid.UUID
+}
+
+// Post authors may remove any comment under their own posts.
+func (q *Queries) ArchiveCommentByUidAndPostAuthor(ctx context.Context, arg ArchiveCommentByUidAndPostAuthorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, archiveCommentByUidAndPostAuthor, arg.Uid, arg.PostAuthor)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countDirectReplies = `-- name: CountDirectReplies :one
+SELECT COUNT(*)::int
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.parent_uid = $1::uuid
+`
+
+func (q *Queries) CountDirectReplies(ctx context.Context, parentUid uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countDirectReplies, parentUid)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countPinnedComments = `-- name: CountPinnedComments :one
+SELECT COUNT(*)::int4
+FROM post_comments
+WHERE post_uid = $1
+  AND status = 'NORMAL'::comment_status
+  AND pinned_at IS NOT NULL
+`
+
+func (q *Queries) CountPinnedComments(ctx context.Context, postUid uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countPinnedComments, postUid)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const createComment = `-- name: CreateComment :one
 INSERT INTO post_comments (
     uid,
@@ -44,7 +106,9 @@ INSERT INTO post_comments (
     reply_to_author_uid,
     content,
     images,
-    ip
+    attachments,
+    ip,
+    ip_region
   )
 VALUES (
     $1,
@@ -55,7 +119,9 @@ VALUES (
     $6,
     $7,
     COALESCE($8::text [], '{}'::text []),
-    $9
+    COALESCE($9::text [], '{}'::text []),
+    $10,
+    $11
   )
 RETURNING id,
   uid
@@ -70,7 +136,9 @@ type CreateCommentParams struct {
 	ReplyToAuthorUid uuid.NullUUID
 	Content          string
 	Images           []string
+	Attachments      []string
 	Ip               string
+	IpRegion         string
 }
 
 type CreateCommentRow struct {
@@ -88,7 +156,9 @@ func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (C
 		arg.ReplyToAuthorUid,
 		arg.Content,
 		arg.Images,
+		arg.Attachments,
 		arg.Ip,
+		arg.IpRegion,
 	)
 	var i CreateCommentRow
 	err := row.Scan(&i.ID, &i.Uid)
@@ -161,6 +231,76 @@ func (q *Queries) DeleteCommentLikeEdge(ctx context.Context, arg DeleteCommentLi
 	return result.RowsAffected(), nil
 }
 
+const deleteCommentsByPostUids = `-- name: DeleteCommentsByPostUids :many
+DELETE FROM post_comments
+WHERE post_uid = ANY($1::uuid [])
+RETURNING uid,
+  images,
+  attachments
+`
+
+type DeleteCommentsByPostUidsRow struct {
+	Uid         uuid.UUID
+	Images      []string
+	Attachments []string
+}
+
+func (q *Queries) DeleteCommentsByPostUids(ctx context.Context, postUids []uuid.UUID) ([]DeleteCommentsByPostUidsRow, error) {
+	rows, err := q.db.Query(ctx, deleteCommentsByPostUids, postUids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeleteCommentsByPostUidsRow
+	for rows.Next() {
+		var i DeleteCommentsByPostUidsRow
+		if err := rows.Scan(&i.Uid, &i.Images, &i.Attachments); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteCommentsByUidsOrRoots = `-- name: DeleteCommentsByUidsOrRoots :many
+DELETE FROM post_comments
+WHERE uid = ANY($1::uuid [])
+  OR root_uid = ANY($1::uuid [])
+RETURNING uid,
+  images,
+  attachments
+`
+
+type DeleteCommentsByUidsOrRootsRow struct {
+	Uid         uuid.UUID
+	Images      []string
+	Attachments []string
+}
+
+// Deleting a top-level comment takes its replies with it.
+func (q *Queries) DeleteCommentsByUidsOrRoots(ctx context.Context, uids []uuid.UUID) ([]DeleteCommentsByUidsOrRootsRow, error) {
+	rows, err := q.db.Query(ctx, deleteCommentsByUidsOrRoots, uids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeleteCommentsByUidsOrRootsRow
+	for rows.Next() {
+		var i DeleteCommentsByUidsOrRootsRow
+		if err := rows.Scan(&i.Uid, &i.Images, &i.Attachments); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCommentByUid = `-- name: GetCommentByUid :one
 SELECT c.uid,
   u.uid AS author_uid,
@@ -174,9 +314,14 @@ SELECT c.uid,
   c.reply_to_author_uid,
   c.content,
   c.images,
+  c.attachments,
   c.reply_count,
   c.like_count,
+  c.ip,
+  c.ip_region,
   (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
   c.created_at,
   c.updated_at
 FROM post_comments c
@@ -209,9 +354,14 @@ type GetCommentByUidRow struct {
 	ReplyToAuthorUid       uuid.NullUUID
 	Content                string
 	Images                 []string
+	Attachments            []string
 	ReplyCount             int32
 	LikeCount              int32
+	Ip                     string
+	IpRegion               string
 	Liked                  bool
+	Edited                 bool
+	Pinned                 bool
 	CreatedAt              pgtype.Timestamptz
 	UpdatedAt              pgtype.Timestamptz
 }
@@ -232,15 +382,43 @@ func (q *Queries) GetCommentByUid(ctx context.Context, arg GetCommentByUidParams
 		&i.ReplyToAuthorUid,
 		&i.Content,
 		&i.Images,
+		&i.Attachments,
 		&i.ReplyCount,
 		&i.LikeCount,
+		&i.Ip,
+		&i.IpRegion,
 		&i.Liked,
+		&i.Edited,
+		&i.Pinned,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const getCommentForUpdateByUid = `-- name: GetCommentForUpdateByUid :one
+SELECT author_uid,
+  content,
+  created_at
+FROM post_comments
+WHERE uid = $1
+  AND status = 'NORMAL'::comment_status
+LIMIT 1 FOR UPDATE
+`
+
+type GetCommentForUpdateByUidRow struct {
+	AuthorUid uuid.UUID
+	Content   string
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetCommentForUpdateByUid(ctx context.Context, uid uuid.UUID) (GetCommentForUpdateByUidRow, error) {
+	row := q.db.QueryRow(ctx, getCommentForUpdateByUid, uid)
+	var i GetCommentForUpdateByUidRow
+	err := row.Scan(&i.AuthorUid, &i.Content, &i.CreatedAt)
+	return i, err
+}
+
 const getCommentLikeCount = `-- name: GetCommentLikeCount :one
 SELECT like_count::int4
 FROM post_comments
@@ -284,6 +462,103 @@ func (q *Queries) GetCommentMetaByUid(ctx context.Context, uid uuid.UUID) (GetCo
 	return i, err
 }
 
+const getCommentSearchDocByUid = `-- name: GetCommentSearchDocByUid :one
+SELECT c.uid,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.author_uid,
+  u.nickname AS author_nickname,
+  c.content,
+  c.images,
+  c.reply_count,
+  c.like_count,
+  c.status,
+  p.author AS post_author_uid,
+  p.visibility AS post_visibility,
+  p.status AS post_status,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  JOIN posts p ON p.uid = c.post_uid
+WHERE c.uid = $1
+LIMIT 1
+`
+
+type GetCommentSearchDocByUidRow struct {
+	Uid            uuid.UUID
+	PostUid        uuid.UUID
+	RootUid        uuid.UUID
+	ParentUid      uuid.NullUUID
+	AuthorUid      uuid.UUID
+	AuthorNickname string
+	Content        string
+	Images         []string
+	ReplyCount     int32
+	LikeCount      int32
+	Status         CommentStatus
+	PostAuthorUid  uuid.UUID
+	PostVisibility PostVisibility
+	PostStatus     PostStatus
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+func (q *Queries) GetCommentSearchDocByUid(ctx context.Context, uid uuid.UUID) (GetCommentSearchDocByUidRow, error) {
+	row := q.db.QueryRow(ctx, getCommentSearchDocByUid, uid)
+	var i GetCommentSearchDocByUidRow
+	err := row.Scan(
+		&i.Uid,
+		&i.PostUid,
+		&i.RootUid,
+		&i.ParentUid,
+		&i.AuthorUid,
+		&i.AuthorNickname,
+		&i.Content,
+		&i.Images,
+		&i.ReplyCount,
+		&i.LikeCount,
+		&i.Status,
+		&i.PostAuthorUid,
+		&i.PostVisibility,
+		&i.PostStatus,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTrashedCommentMetaByUidAndAuthor = `-- name: GetTrashedCommentMetaByUidAndAuthor :one
+SELECT post_uid,
+  root_uid
+FROM post_comments
+WHERE uid = $1
+  AND author_uid = $2
+  AND status = 'ARCHIVED'::comment_status
+  AND archived_by = author_uid
+  AND archived_at > $3::timestamptz
+LIMIT 1
+`
+
+type GetTrashedCommentMetaByUidAndAuthorParams struct {
+	Uid           uuid.UUID
+	AuthorUid     uuid.UUID
+	ArchivedAfter pgtype.Timestamptz
+}
+
+type GetTrashedCommentMetaByUidAndAuthorRow struct {
+	PostUid uuid.UUID
+	RootUid uuid.UUID
+}
+
+func (q *Queries) GetTrashedCommentMetaByUidAndAuthor(ctx context.Context, arg GetTrashedCommentMetaByUidAndAuthorParams) (GetTrashedCommentMetaByUidAndAuthorRow, error) {
+	row := q.db.QueryRow(ctx, getTrashedCommentMetaByUidAndAuthor, arg.Uid, arg.AuthorUid, arg.ArchivedAfter)
+	var i GetTrashedCommentMetaByUidAndAuthorRow
+	err := row.Scan(&i.PostUid, &i.RootUid)
+	return i, err
+}
+
 const incrementCommentLikeCount = `-- name: IncrementCommentLikeCount :one
 UPDATE post_comments
 SET like_count = like_count + 1,
@@ -351,7 +626,36 @@ func (q *Queries) InsertCommentLikeEdge(ctx context.Context, arg InsertCommentLi
 	return result.RowsAffected(), nil
 }
 
-const listReplies = `-- name: ListReplies :many
+const insertCommentRevision = `-- name: InsertCommentRevision :exec
+INSERT INTO comment_revisions (comment_uid, content)
+VALUES ($1, $2)
+`
+
+type InsertCommentRevisionParams struct {
+	CommentUid uuid.UUID
+	Content    string
+}
+
+func (q *Queries) InsertCommentRevision(ctx context.Context, arg InsertCommentRevisionParams) error {
+	_, err := q.db.Exec(ctx, insertCommentRevision, arg.CommentUid, arg.Content)
+	return err
+}
+
+const listCommentAncestors = `-- name: ListCommentAncestors :many
+WITH RECURSIVE chain AS (
+  SELECT p.uid,
+    p.parent_uid,
+    1 AS depth
+  FROM post_comments t
+    JOIN post_comments p ON p.uid = t.parent_uid
+  WHERE t.uid = $2
+  UNION ALL
+  SELECT p.uid,
+    p.parent_uid,
+    ch.depth + 1
+  FROM chain ch
+    JOIN post_comments p ON p.uid = ch.parent_uid
+)
 SELECT c.uid,
   u.uid AS author_uid,
   u.nickname AS author_nickname,
@@ -364,13 +668,18 @@ SELECT c.uid,
   c.reply_to_author_uid,
   c.content,
   c.images,
+  c.attachments,
   c.reply_count,
   c.like_count,
+  c.ip,
+  c.ip_region,
   (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
   c.created_at,
-  c.updated_at,
-  COUNT(*) OVER ()::int AS total
-FROM post_comments c
+  c.updated_at
+FROM chain ch
+  JOIN post_comments c ON c.uid = ch.uid
   JOIN users u ON u.uid = c.author_uid
   AND u.status = 'NORMAL'::user_status
   LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
@@ -378,20 +687,15 @@ FROM post_comments c
   LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
   AND cl.user_uid = $1::uuid
 WHERE c.status = 'NORMAL'::comment_status
-  AND c.root_uid = $2
-  AND c.root_uid <> c.uid
-ORDER BY c.created_at ASC,
-  c.uid ASC
-LIMIT 10 OFFSET ($3::int - 1) * 10
+ORDER BY ch.depth DESC
 `
 
-type ListRepliesParams struct {
-	Viewer  uuid.NullUUID
-	RootUid uuid.UUID
-	Page    int32
+type ListCommentAncestorsParams struct {
+	Viewer uuid.NullUUID
+	Uid    uuid.UUID
 }
 
-type ListRepliesRow struct {
+type ListCommentAncestorsRow struct {
 	Uid                    uuid.UUID
 	AuthorUid              uuid.UUID
 	AuthorNickname         string
@@ -404,23 +708,29 @@ type ListRepliesRow struct {
 	ReplyToAuthorUid       uuid.NullUUID
 	Content                string
 	Images                 []string
+	Attachments            []string
 	ReplyCount             int32
 	LikeCount              int32
+	Ip                     string
+	IpRegion               string
 	Liked                  bool
+	Edited                 bool
+	Pinned                 bool
 	CreatedAt              pgtype.Timestamptz
 	UpdatedAt              pgtype.Timestamptz
-	Total                  int32
 }
 
-func (q *Queries) ListReplies(ctx context.Context, arg ListRepliesParams) ([]ListRepliesRow, error) {
-	rows, err := q.db.Query(ctx, listReplies, arg.Viewer, arg.RootUid, arg.Page)
+// Walks parent_uid up from the comment, returning the chain root first.
+// Deleted ancestors are skipped but still walked through.
+func (q *Queries) ListCommentAncestors(ctx context.Context, arg ListCommentAncestorsParams) ([]ListCommentAncestorsRow, error) {
+	rows, err := q.db.Query(ctx, listCommentAncestors, arg.Viewer, arg.Uid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListRepliesRow
+	var items []ListCommentAncestorsRow
 	for rows.Next() {
-		var i ListRepliesRow
+		var i ListCommentAncestorsRow
 		if err := rows.Scan(
 			&i.Uid,
 			&i.AuthorUid,
@@ -434,12 +744,132 @@ func (q *Queries) ListReplies(ctx context.Context, arg ListRepliesParams) ([]Lis
 			&i.ReplyToAuthorUid,
 			&i.Content,
 			&i.Images,
+			&i.Attachments,
 			&i.ReplyCount,
 			&i.LikeCount,
+			&i.Ip,
+			&i.IpRegion,
 			&i.Liked,
+			&i.Edited,
+			&i.Pinned,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommentRevisions = `-- name: ListCommentRevisions :many
+SELECT r.content,
+  r.created_at
+FROM comment_revisions r
+  JOIN post_comments c ON c.uid = r.comment_uid
+  AND c.status = 'NORMAL'::comment_status
+WHERE r.comment_uid = $1
+ORDER BY r.id DESC
+`
+
+type ListCommentRevisionsRow struct {
+	Content   string
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ListCommentRevisions(ctx context.Context, commentUid uuid.UUID) ([]ListCommentRevisionsRow, error) {
+	rows, err := q.db.Query(ctx, listCommentRevisions, commentUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCommentRevisionsRow
+	for rows.Next() {
+		var i ListCommentRevisionsRow
+		if err := rows.Scan(&i.Content, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommentSearchDocsByPostUid = `-- name: ListCommentSearchDocsByPostUid :many
+SELECT c.uid,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.author_uid,
+  u.nickname AS author_nickname,
+  c.content,
+  c.images,
+  c.reply_count,
+  c.like_count,
+  c.status,
+  p.author AS post_author_uid,
+  p.visibility AS post_visibility,
+  p.status AS post_status,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  JOIN posts p ON p.uid = c.post_uid
+WHERE c.post_uid = $1
+  AND c.status = 'NORMAL'::comment_status
+ORDER BY c.created_at
+`
+
+type ListCommentSearchDocsByPostUidRow struct {
+	Uid            uuid.UUID
+	PostUid        uuid.UUID
+	RootUid        uuid.UUID
+	ParentUid      uuid.NullUUID
+	AuthorUid      uuid.UUID
+	AuthorNickname string
+	Content        string
+	Images         []string
+	ReplyCount     int32
+	LikeCount      int32
+	Status         CommentStatus
+	PostAuthorUid  uuid.UUID
+	PostVisibility PostVisibility
+	PostStatus     PostStatus
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+func (q *Queries) ListCommentSearchDocsByPostUid(ctx context.Context, postUid uuid.UUID) ([]ListCommentSearchDocsByPostUidRow, error) {
+	rows, err := q.db.Query(ctx, listCommentSearchDocsByPostUid, postUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCommentSearchDocsByPostUidRow
+	for rows.Next() {
+		var i ListCommentSearchDocsByPostUidRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.PostUid,
+			&i.RootUid,
+			&i.ParentUid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.Content,
+			&i.Images,
+			&i.ReplyCount,
+			&i.LikeCount,
+			&i.Status,
+			&i.PostAuthorUid,
+			&i.PostVisibility,
+			&i.PostStatus,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.Total,
 		); err != nil {
 			return nil, err
 		}
@@ -451,7 +881,7 @@ func (q *Queries) ListReplies(ctx context.Context, arg ListRepliesParams) ([]Lis
 	return items, nil
 }
 
-const listTopComments = `-- name: ListTopComments :many
+const listDirectReplies = `-- name: ListDirectReplies :many
 SELECT c.uid,
   u.uid AS author_uid,
   u.nickname AS author_nickname,
@@ -464,9 +894,14 @@ SELECT c.uid,
   c.reply_to_author_uid,
   c.content,
   c.images,
+  c.attachments,
   c.reply_count,
   c.like_count,
+  c.ip,
+  c.ip_region,
   (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
   c.created_at,
   c.updated_at
 FROM post_comments c
@@ -477,31 +912,30 @@ FROM post_comments c
   LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
   AND cl.user_uid = $1::uuid
 WHERE c.status = 'NORMAL'::comment_status
-  AND c.post_uid = $2
-  AND c.parent_uid IS NULL
+  AND c.parent_uid = $2::uuid
   AND (
     (
       $3::timestamptz IS NULL
       AND $4::uuid IS NULL
     )
-    OR (c.created_at, c.uid) < (
+    OR (c.created_at, c.uid) > (
       $3::timestamptz,
       $4::uuid
     )
   )
-ORDER BY c.created_at DESC,
-  c.uid DESC
-LIMIT 20
+ORDER BY c.created_at ASC,
+  c.uid ASC
+LIMIT 10
 `
 
-type ListTopCommentsParams struct {
+type ListDirectRepliesParams struct {
 	Viewer          uuid.NullUUID
-	PostUid         uuid.UUID
+	ParentUid       uuid.UUID
 	CursorCreatedAt pgtype.Timestamptz
 	CursorID        uuid.NullUUID
 }
 
-type ListTopCommentsRow struct {
+type ListDirectRepliesRow struct {
 	Uid                    uuid.UUID
 	AuthorUid              uuid.UUID
 	AuthorNickname         string
@@ -514,17 +948,22 @@ type ListTopCommentsRow struct {
 	ReplyToAuthorUid       uuid.NullUUID
 	Content                string
 	Images                 []string
+	Attachments            []string
 	ReplyCount             int32
 	LikeCount              int32
+	Ip                     string
+	IpRegion               string
 	Liked                  bool
+	Edited                 bool
+	Pinned                 bool
 	CreatedAt              pgtype.Timestamptz
 	UpdatedAt              pgtype.Timestamptz
 }
 
-func (q *Queries) ListTopComments(ctx context.Context, arg ListTopCommentsParams) ([]ListTopCommentsRow, error) {
-	rows, err := q.db.Query(ctx, listTopComments,
+func (q *Queries) ListDirectReplies(ctx context.Context, arg ListDirectRepliesParams) ([]ListDirectRepliesRow, error) {
+	rows, err := q.db.Query(ctx, listDirectReplies,
 		arg.Viewer,
-		arg.PostUid,
+		arg.ParentUid,
 		arg.CursorCreatedAt,
 		arg.CursorID,
 	)
@@ -532,9 +971,9 @@ func (q *Queries) ListTopComments(ctx context.Context, arg ListTopCommentsParams
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListTopCommentsRow
+	var items []ListDirectRepliesRow
 	for rows.Next() {
-		var i ListTopCommentsRow
+		var i ListDirectRepliesRow
 		if err := rows.Scan(
 			&i.Uid,
 			&i.AuthorUid,
@@ -548,9 +987,14 @@ func (q *Queries) ListTopComments(ctx context.Context, arg ListTopCommentsParams
 			&i.ReplyToAuthorUid,
 			&i.Content,
 			&i.Images,
+			&i.Attachments,
 			&i.ReplyCount,
 			&i.LikeCount,
+			&i.Ip,
+			&i.IpRegion,
 			&i.Liked,
+			&i.Edited,
+			&i.Pinned,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -563,3 +1007,1119 @@ func (q *Queries) ListTopComments(ctx context.Context, arg ListTopCommentsParams
 	}
 	return items, nil
 }
+
+const listExpiredTrashedCommentUids = `-- name: ListExpiredTrashedCommentUids :many
+SELECT uid
+FROM post_comments
+WHERE status = 'ARCHIVED'::comment_status
+  AND archived_at <= $1::timestamptz
+ORDER BY archived_at
+LIMIT $2
+`
+
+type ListExpiredTrashedCommentUidsParams struct {
+	ArchivedBefore pgtype.Timestamptz
+	BatchSize      int32
+}
+
+func (q *Queries) ListExpiredTrashedCommentUids(ctx context.Context, arg ListExpiredTrashedCommentUidsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listExpiredTrashedCommentUids, arg.ArchivedBefore, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var uid uuid.UUID
+		if err := rows.Scan(&uid); err != nil {
+			return nil, err
+		}
+		items = append(items, uid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPinnedComments = `-- name: ListPinnedComments :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.best_score,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = $1::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.post_uid = $2
+  AND c.parent_uid IS NULL
+  AND c.pinned_at IS NOT NULL
+ORDER BY c.pinned_at DESC
+`
+
+type ListPinnedCommentsParams struct {
+	Viewer  uuid.NullUUID
+	PostUid uuid.UUID
+}
+
+type ListPinnedCommentsRow struct {
+	Uid                    uuid.UUID
+	AuthorUid              uuid.UUID
+	AuthorNickname         string
+	AuthorAvatarUrl        string
+	ReplyToAuthorNickname  pgtype.Text
+	ReplyToAuthorAvatarUrl pgtype.Text
+	PostUid                uuid.UUID
+	RootUid                uuid.UUID
+	ParentUid              uuid.NullUUID
+	ReplyToAuthorUid       uuid.NullUUID
+	Content                string
+	Images                 []string
+	Attachments            []string
+	ReplyCount             int32
+	LikeCount              int32
+	Ip                     string
+	IpRegion               string
+	Liked                  bool
+	Edited                 bool
+	Pinned                 bool
+	BestScore              float64
+	CreatedAt              pgtype.Timestamptz
+	UpdatedAt              pgtype.Timestamptz
+}
+
+func (q *Queries) ListPinnedComments(ctx context.Context, arg ListPinnedCommentsParams) ([]ListPinnedCommentsRow, error) {
+	rows, err := q.db.Query(ctx, listPinnedComments, arg.Viewer, arg.PostUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPinnedCommentsRow
+	for rows.Next() {
+		var i ListPinnedCommentsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.ReplyToAuthorNickname,
+			&i.ReplyToAuthorAvatarUrl,
+			&i.PostUid,
+			&i.RootUid,
+			&i.ParentUid,
+			&i.ReplyToAuthorUid,
+			&i.Content,
+			&i.Images,
+			&i.Attachments,
+			&i.ReplyCount,
+			&i.LikeCount,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Liked,
+			&i.Edited,
+			&i.Pinned,
+			&i.BestScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostUidsWithCommentsAfter = `-- name: ListPostUidsWithCommentsAfter :many
+SELECT DISTINCT c.post_uid
+FROM post_comments c
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.post_uid > $1::uuid
+ORDER BY c.post_uid
+LIMIT $2
+`
+
+type ListPostUidsWithCommentsAfterParams struct {
+	AfterUid   uuid.UUID
+	LimitCount int32
+}
+
+func (q *Queries) ListPostUidsWithCommentsAfter(ctx context.Context, arg ListPostUidsWithCommentsAfterParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listPostUidsWithCommentsAfter, arg.AfterUid, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var post_uid uuid.UUID
+		if err := rows.Scan(&post_uid); err != nil {
+			return nil, err
+		}
+		items = append(items, post_uid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReplies = `-- name: ListReplies :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  c.created_at,
+  c.updated_at,
+  COUNT(*) OVER ()::int AS total
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = $1::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.root_uid = $2
+  AND c.root_uid <> c.uid
+ORDER BY c.created_at ASC,
+  c.uid ASC
+LIMIT 10 OFFSET ($3::int - 1) * 10
+`
+
+type ListRepliesParams struct {
+	Viewer  uuid.NullUUID
+	RootUid uuid.UUID
+	Page    int32
+}
+
+type ListRepliesRow struct {
+	Uid                    uuid.UUID
+	AuthorUid              uuid.UUID
+	AuthorNickname         string
+	AuthorAvatarUrl        string
+	ReplyToAuthorNickname  pgtype.Text
+	ReplyToAuthorAvatarUrl pgtype.Text
+	PostUid                uuid.UUID
+	RootUid                uuid.UUID
+	ParentUid              uuid.NullUUID
+	ReplyToAuthorUid       uuid.NullUUID
+	Content                string
+	Images                 []string
+	Attachments            []string
+	ReplyCount             int32
+	LikeCount              int32
+	Ip                     string
+	IpRegion               string
+	Liked                  bool
+	Edited                 bool
+	CreatedAt              pgtype.Timestamptz
+	UpdatedAt              pgtype.Timestamptz
+	Total                  int32
+}
+
+func (q *Queries) ListReplies(ctx context.Context, arg ListRepliesParams) ([]ListRepliesRow, error) {
+	rows, err := q.db.Query(ctx, listReplies, arg.Viewer, arg.RootUid, arg.Page)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRepliesRow
+	for rows.Next() {
+		var i ListRepliesRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.ReplyToAuthorNickname,
+			&i.ReplyToAuthorAvatarUrl,
+			&i.PostUid,
+			&i.RootUid,
+			&i.ParentUid,
+			&i.ReplyToAuthorUid,
+			&i.Content,
+			&i.Images,
+			&i.Attachments,
+			&i.ReplyCount,
+			&i.LikeCount,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Liked,
+			&i.Edited,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSearchedCommentsByUids = `-- name: ListSearchedCommentsByUids :many
+WITH input AS (
+  SELECT DISTINCT ON (x.uid) x.uid,
+    x.ord
+  FROM unnest($2::uuid []) WITH ORDINALITY AS x(uid, ord)
+  ORDER BY x.uid,
+    x.ord
+)
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.created_at,
+  c.updated_at
+FROM input i
+  JOIN post_comments c ON c.uid = i.uid
+  JOIN posts p ON p.uid = c.post_uid
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = $1::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND p.status = 'NORMAL'::post_status
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = $1::uuid
+  )
+ORDER BY i.ord
+`
+
+type ListSearchedCommentsByUidsParams struct {
+	Viewer uuid.NullUUID
+	Uids   []uuid.UUID
+}
+
+type ListSearchedCommentsByUidsRow struct {
+	Uid                    uuid.UUID
+	AuthorUid              uuid.UUID
+	AuthorNickname         string
+	AuthorAvatarUrl        string
+	ReplyToAuthorNickname  pgtype.Text
+	ReplyToAuthorAvatarUrl pgtype.Text
+	PostUid                uuid.UUID
+	RootUid                uuid.UUID
+	ParentUid              uuid.NullUUID
+	ReplyToAuthorUid       uuid.NullUUID
+	Content                string
+	Images                 []string
+	Attachments            []string
+	ReplyCount             int32
+	LikeCount              int32
+	Ip                     string
+	IpRegion               string
+	Liked                  bool
+	Edited                 bool
+	Pinned                 bool
+	CreatedAt              pgtype.Timestamptz
+	UpdatedAt              pgtype.Timestamptz
+}
+
+// Search hits are re-checked here so a stale index never leaks comments
+// under posts the viewer can no longer see.
+func (q *Queries) ListSearchedCommentsByUids(ctx context.Context, arg ListSearchedCommentsByUidsParams) ([]ListSearchedCommentsByUidsRow, error) {
+	rows, err := q.db.Query(ctx, listSearchedCommentsByUids, arg.Viewer, arg.Uids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSearchedCommentsByUidsRow
+	for rows.Next() {
+		var i ListSearchedCommentsByUidsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.ReplyToAuthorNickname,
+			&i.ReplyToAuthorAvatarUrl,
+			&i.PostUid,
+			&i.RootUid,
+			&i.ParentUid,
+			&i.ReplyToAuthorUid,
+			&i.Content,
+			&i.Images,
+			&i.Attachments,
+			&i.ReplyCount,
+			&i.LikeCount,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Liked,
+			&i.Edited,
+			&i.Pinned,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopCommentsBest = `-- name: ListTopCommentsBest :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.best_score,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = $1::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.post_uid = $2
+  AND c.parent_uid IS NULL
+  AND c.pinned_at IS NULL
+  AND (c.best_score, c.uid) < (
+    $3::float8,
+    $4::uuid
+  )
+ORDER BY c.best_score DESC,
+  c.uid DESC
+LIMIT 20
+`
+
+type ListTopCommentsBestParams struct {
+	Viewer          uuid.NullUUID
+	PostUid         uuid.UUID
+	CursorBestScore float64
+	CursorID        uuid.UUID
+}
+
+type ListTopCommentsBestRow struct {
+	Uid                    uuid.UUID
+	AuthorUid              uuid.UUID
+	AuthorNickname         string
+	AuthorAvatarUrl        string
+	ReplyToAuthorNickname  pgtype.Text
+	ReplyToAuthorAvatarUrl pgtype.Text
+	PostUid                uuid.UUID
+	RootUid                uuid.UUID
+	ParentUid              uuid.NullUUID
+	ReplyToAuthorUid       uuid.NullUUID
+	Content                string
+	Images                 []string
+	Attachments            []string
+	ReplyCount             int32
+	LikeCount              int32
+	Ip                     string
+	IpRegion               string
+	Liked                  bool
+	Edited                 bool
+	Pinned                 bool
+	BestScore              float64
+	CreatedAt              pgtype.Timestamptz
+	UpdatedAt              pgtype.Timestamptz
+}
+
+func (q *Queries) ListTopCommentsBest(ctx context.Context, arg ListTopCommentsBestParams) ([]ListTopCommentsBestRow, error) {
+	rows, err := q.db.Query(ctx, listTopCommentsBest,
+		arg.Viewer,
+		arg.PostUid,
+		arg.CursorBestScore,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTopCommentsBestRow
+	for rows.Next() {
+		var i ListTopCommentsBestRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.ReplyToAuthorNickname,
+			&i.ReplyToAuthorAvatarUrl,
+			&i.PostUid,
+			&i.RootUid,
+			&i.ParentUid,
+			&i.ReplyToAuthorUid,
+			&i.Content,
+			&i.Images,
+			&i.Attachments,
+			&i.ReplyCount,
+			&i.LikeCount,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Liked,
+			&i.Edited,
+			&i.Pinned,
+			&i.BestScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopCommentsMostLiked = `-- name: ListTopCommentsMostLiked :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.best_score,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = $1::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.post_uid = $2
+  AND c.parent_uid IS NULL
+  AND c.pinned_at IS NULL
+  AND (c.like_count, c.uid) < (
+    $3::int4,
+    $4::uuid
+  )
+ORDER BY c.like_count DESC,
+  c.uid DESC
+LIMIT 20
+`
+
+type ListTopCommentsMostLikedParams struct {
+	Viewer          uuid.NullUUID
+	PostUid         uuid.UUID
+	CursorLikeCount int32
+	CursorID        uuid.UUID
+}
+
+type ListTopCommentsMostLikedRow struct {
+	Uid                    uuid.UUID
+	AuthorUid              uuid.UUID
+	AuthorNickname         string
+	AuthorAvatarUrl        string
+	ReplyToAuthorNickname  pgtype.Text
+	ReplyToAuthorAvatarUrl pgtype.Text
+	PostUid                uuid.UUID
+	RootUid                uuid.UUID
+	ParentUid              uuid.NullUUID
+	ReplyToAuthorUid       uuid.NullUUID
+	Content                string
+	Images                 []string
+	Attachments            []string
+	ReplyCount             int32
+	LikeCount              int32
+	Ip                     string
+	IpRegion               string
+	Liked                  bool
+	Edited                 bool
+	Pinned                 bool
+	BestScore              float64
+	CreatedAt              pgtype.Timestamptz
+	UpdatedAt              pgtype.Timestamptz
+}
+
+func (q *Queries) ListTopCommentsMostLiked(ctx context.Context, arg ListTopCommentsMostLikedParams) ([]ListTopCommentsMostLikedRow, error) {
+	rows, err := q.db.Query(ctx, listTopCommentsMostLiked,
+		arg.Viewer,
+		arg.PostUid,
+		arg.CursorLikeCount,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTopCommentsMostLikedRow
+	for rows.Next() {
+		var i ListTopCommentsMostLikedRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.ReplyToAuthorNickname,
+			&i.ReplyToAuthorAvatarUrl,
+			&i.PostUid,
+			&i.RootUid,
+			&i.ParentUid,
+			&i.ReplyToAuthorUid,
+			&i.Content,
+			&i.Images,
+			&i.Attachments,
+			&i.ReplyCount,
+			&i.LikeCount,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Liked,
+			&i.Edited,
+			&i.Pinned,
+			&i.BestScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopCommentsNewest = `-- name: ListTopCommentsNewest :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.best_score,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = $1::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.post_uid = $2
+  AND c.parent_uid IS NULL
+  AND c.pinned_at IS NULL
+  AND (c.created_at, c.uid) < (
+    $3::timestamptz,
+    $4::uuid
+  )
+ORDER BY c.created_at DESC,
+  c.uid DESC
+LIMIT 20
+`
+
+type ListTopCommentsNewestParams struct {
+	Viewer          uuid.NullUUID
+	PostUid         uuid.UUID
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.UUID
+}
+
+type ListTopCommentsNewestRow struct {
+	Uid                    uuid.UUID
+	AuthorUid              uuid.UUID
+	AuthorNickname         string
+	AuthorAvatarUrl        string
+	ReplyToAuthorNickname  pgtype.Text
+	ReplyToAuthorAvatarUrl pgtype.Text
+	PostUid                uuid.UUID
+	RootUid                uuid.UUID
+	ParentUid              uuid.NullUUID
+	ReplyToAuthorUid       uuid.NullUUID
+	Content                string
+	Images                 []string
+	Attachments            []string
+	ReplyCount             int32
+	LikeCount              int32
+	Ip                     string
+	IpRegion               string
+	Liked                  bool
+	Edited                 bool
+	Pinned                 bool
+	BestScore              float64
+	CreatedAt              pgtype.Timestamptz
+	UpdatedAt              pgtype.Timestamptz
+}
+
+func (q *Queries) ListTopCommentsNewest(ctx context.Context, arg ListTopCommentsNewestParams) ([]ListTopCommentsNewestRow, error) {
+	rows, err := q.db.Query(ctx, listTopCommentsNewest,
+		arg.Viewer,
+		arg.PostUid,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTopCommentsNewestRow
+	for rows.Next() {
+		var i ListTopCommentsNewestRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.ReplyToAuthorNickname,
+			&i.ReplyToAuthorAvatarUrl,
+			&i.PostUid,
+			&i.RootUid,
+			&i.ParentUid,
+			&i.ReplyToAuthorUid,
+			&i.Content,
+			&i.Images,
+			&i.Attachments,
+			&i.ReplyCount,
+			&i.LikeCount,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Liked,
+			&i.Edited,
+			&i.Pinned,
+			&i.BestScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopCommentsOldest = `-- name: ListTopCommentsOldest :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.best_score,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = $1::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.post_uid = $2
+  AND c.parent_uid IS NULL
+  AND c.pinned_at IS NULL
+  AND (c.created_at, c.uid) > (
+    $3::timestamptz,
+    $4::uuid
+  )
+ORDER BY c.created_at ASC,
+  c.uid ASC
+LIMIT 20
+`
+
+type ListTopCommentsOldestParams struct {
+	Viewer          uuid.NullUUID
+	PostUid         uuid.UUID
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.UUID
+}
+
+type ListTopCommentsOldestRow struct {
+	Uid                    uuid.UUID
+	AuthorUid              uuid.UUID
+	AuthorNickname         string
+	AuthorAvatarUrl        string
+	ReplyToAuthorNickname  pgtype.Text
+	ReplyToAuthorAvatarUrl pgtype.Text
+	PostUid                uuid.UUID
+	RootUid                uuid.UUID
+	ParentUid              uuid.NullUUID
+	ReplyToAuthorUid       uuid.NullUUID
+	Content                string
+	Images                 []string
+	Attachments            []string
+	ReplyCount             int32
+	LikeCount              int32
+	Ip                     string
+	IpRegion               string
+	Liked                  bool
+	Edited                 bool
+	Pinned                 bool
+	BestScore              float64
+	CreatedAt              pgtype.Timestamptz
+	UpdatedAt              pgtype.Timestamptz
+}
+
+func (q *Queries) ListTopCommentsOldest(ctx context.Context, arg ListTopCommentsOldestParams) ([]ListTopCommentsOldestRow, error) {
+	rows, err := q.db.Query(ctx, listTopCommentsOldest,
+		arg.Viewer,
+		arg.PostUid,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTopCommentsOldestRow
+	for rows.Next() {
+		var i ListTopCommentsOldestRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.ReplyToAuthorNickname,
+			&i.ReplyToAuthorAvatarUrl,
+			&i.PostUid,
+			&i.RootUid,
+			&i.ParentUid,
+			&i.ReplyToAuthorUid,
+			&i.Content,
+			&i.Images,
+			&i.Attachments,
+			&i.ReplyCount,
+			&i.LikeCount,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Liked,
+			&i.Edited,
+			&i.Pinned,
+			&i.BestScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrashedCommentsByAuthor = `-- name: ListTrashedCommentsByAuthor :many
+SELECT c.uid,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.content,
+  c.images,
+  c.created_at,
+  c.archived_at
+FROM post_comments c
+WHERE c.author_uid = $1
+  AND c.status = 'ARCHIVED'::comment_status
+  AND c.archived_by = c.author_uid
+  AND c.archived_at > $2::timestamptz
+  AND (
+    (
+      $3::timestamptz IS NULL
+      AND $4::uuid IS NULL
+    )
+    OR (c.archived_at, c.uid) < (
+      $3::timestamptz,
+      $4::uuid
+    )
+  )
+ORDER BY c.archived_at DESC,
+  c.uid DESC
+LIMIT 20
+`
+
+type ListTrashedCommentsByAuthorParams struct {
+	AuthorUid        uuid.UUID
+	ArchivedAfter    pgtype.Timestamptz
+	CursorArchivedAt pgtype.Timestamptz
+	CursorID         uuid.NullUUID
+}
+
+type ListTrashedCommentsByAuthorRow struct {
+	Uid        uuid.UUID
+	PostUid    uuid.UUID
+	RootUid    uuid.UUID
+	ParentUid  uuid.NullUUID
+	Content    string
+	Images     []string
+	CreatedAt  pgtype.Timestamptz
+	ArchivedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ListTrashedCommentsByAuthor(ctx context.Context, arg ListTrashedCommentsByAuthorParams) ([]ListTrashedCommentsByAuthorRow, error) {
+	rows, err := q.db.Query(ctx, listTrashedCommentsByAuthor,
+		arg.AuthorUid,
+		arg.ArchivedAfter,
+		arg.CursorArchivedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTrashedCommentsByAuthorRow
+	for rows.Next() {
+		var i ListTrashedCommentsByAuthorRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.PostUid,
+			&i.RootUid,
+			&i.ParentUid,
+			&i.Content,
+			&i.Images,
+			&i.CreatedAt,
+			&i.ArchivedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshCommentBestScores = `-- name: RefreshCommentBestScores :exec
+WITH scored AS (
+  SELECT c.uid,
+    (c.like_count + 2 * c.reply_count)::float8 / power(
+      LEAST(
+        extract(
+          epoch
+          FROM now() - c.created_at
+        )::float8 / 3600,
+        $1::int
+      ) + 2,
+      $2::float8
+    ) AS score
+  FROM post_comments c
+  WHERE c.status = 'NORMAL'::comment_status
+    AND c.parent_uid IS NULL
+    AND (
+      c.created_at > now() - make_interval(hours => $1::int)
+      OR c.updated_at > $3::timestamptz
+    )
+)
+UPDATE post_comments c
+SET best_score = s.score
+FROM scored s
+WHERE c.uid = s.uid
+  AND c.best_score <> s.score
+`
+
+type RefreshCommentBestScoresParams struct {
+	WindowHours  int32
+	Gravity      float64
+	ChangedSince pgtype.Timestamptz
+}
+
+// Only top-level comments are ranked. Age stops counting after the window,
+// so older comments keep a score comparable to each other and are refreshed
+// only when their likes or replies change.
+func (q *Queries) RefreshCommentBestScores(ctx context.Context, arg RefreshCommentBestScoresParams) error {
+	_, err := q.db.Exec(ctx, refreshCommentBestScores, arg.WindowHours, arg.Gravity, arg.ChangedSince)
+	return err
+}
+
+const restoreCommentByUidAndAuthor = `-- name: RestoreCommentByUidAndAuthor :execrows
+UPDATE post_comments
+SET status = 'NORMAL'::comment_status,
+  archived_at = NULL,
+  updated_at = now()
+WHERE uid = $1
+  AND author_uid = $2
+  AND status = 'ARCHIVED'::comment_status
+  AND archived_by = author_uid
+  AND archived_at > $3::timestamptz
+`
+
+type RestoreCommentByUidAndAuthorParams struct {
+	Uid           uuid.UUID
+	AuthorUid     uuid.UUID
+	ArchivedAfter pgtype.Timestamptz
+}
+
+func (q *Queries) RestoreCommentByUidAndAuthor(ctx context.Context, arg RestoreCommentByUidAndAuthorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreCommentByUidAndAuthor, arg.Uid, arg.AuthorUid, arg.ArchivedAfter)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setCommentPinned = `-- name: SetCommentPinned :execrows
+UPDATE post_comments
+SET pinned_at = CASE
+    WHEN $1::boolean THEN COALESCE(pinned_at, now())
+  END
+WHERE uid = $2
+  AND post_uid = $3
+  AND parent_uid IS NULL
+  AND status = 'NORMAL'::comment_status
+`
+
+type SetCommentPinnedParams struct {
+	Pinned  bool
+	Uid     uuid.UUID
+	PostUid uuid.UUID
+}
+
+func (q *Queries) SetCommentPinned(ctx context.Context, arg SetCommentPinnedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setCommentPinned, arg.Pinned, arg.Uid, arg.PostUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateCommentContent = `-- name: UpdateCommentContent :one
+UPDATE post_comments
+SET content = $1,
+  edited = true,
+  updated_at = now()
+WHERE uid = $2
+  AND status = 'NORMAL'::comment_status
+RETURNING updated_at
+`
+
+type UpdateCommentContentParams struct {
+	Content string
+	Uid     uuid.UUID
+}
+
+func (q *Queries) UpdateCommentContent(ctx context.Context, arg UpdateCommentContentParams) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, updateCommentContent, arg.Content, arg.Uid)
+	var updated_at pgtype.Timestamptz
+	err := row.Scan(&updated_at)
+	return updated_at, err
+}
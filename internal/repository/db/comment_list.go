@@ -0,0 +1,149 @@
+package db
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	ListTopCommentsSortNewest = "newest"
+	ListTopCommentsSortOldest = "oldest"
+	ListTopCommentsSortLikes  = "likes"
+	ListTopCommentsSortBest   = "best"
+)
+
+type ListTopCommentsParams struct {
+	Viewer          uuid.NullUUID
+	PostUid         uuid.UUID
+	Sort            string // "" or "newest", "oldest", "likes", "best"
+	CursorCreatedAt pgtype.Timestamptz
+	CursorLikeCount pgtype.Int4
+	CursorBestScore pgtype.Float8
+	CursorID        uuid.NullUUID
+}
+
+type ListTopCommentsRow struct {
+	Uid                    uuid.UUID
+	AuthorUid              uuid.UUID
+	AuthorNickname         string
+	AuthorAvatarUrl        string
+	ReplyToAuthorNickname  pgtype.Text
+	ReplyToAuthorAvatarUrl pgtype.Text
+	PostUid                uuid.UUID
+	RootUid                uuid.UUID
+	ParentUid              uuid.NullUUID
+	ReplyToAuthorUid       uuid.NullUUID
+	Content                string
+	Images                 []string
+	Attachments            []string
+	ReplyCount             int32
+	LikeCount              int32
+	Ip                     string
+	IpRegion               string
+	Liked                  bool
+	Edited                 bool
+	Pinned                 bool
+	BestScore              float64
+	CreatedAt              pgtype.Timestamptz
+	UpdatedAt              pgtype.Timestamptz
+}
+
+func (q *Queries) ListTopComments(ctx context.Context, arg ListTopCommentsParams) ([]ListTopCommentsRow, error) {
+	var (
+		listTopComments string
+		cursor          interface{}
+	)
+	cursorID := arg.CursorID
+	switch arg.Sort {
+	case ListTopCommentsSortOldest:
+		listTopComments = listTopCommentsOldest
+		cursorCreatedAt := arg.CursorCreatedAt
+		if !cursorCreatedAt.Valid || !cursorID.Valid {
+			cursorCreatedAt = minCursorTime()
+			cursorID = uuid.NullUUID{Valid: true}
+		}
+		cursor = cursorCreatedAt
+	case ListTopCommentsSortLikes:
+		listTopComments = listTopCommentsMostLiked
+		cursorLikeCount := arg.CursorLikeCount
+		if !cursorLikeCount.Valid || !cursorID.Valid {
+			cursorLikeCount = pgtype.Int4{Int32: math.MaxInt32, Valid: true}
+			cursorID = maxCursorID()
+		}
+		cursor = cursorLikeCount
+	case ListTopCommentsSortBest:
+		listTopComments = listTopCommentsBest
+		cursorBestScore := arg.CursorBestScore
+		if !cursorBestScore.Valid || !cursorID.Valid {
+			cursorBestScore = pgtype.Float8{Float64: math.MaxFloat64, Valid: true}
+			cursorID = maxCursorID()
+		}
+		cursor = cursorBestScore
+	default:
+		listTopComments = listTopCommentsNewest
+		cursorCreatedAt := arg.CursorCreatedAt
+		if !cursorCreatedAt.Valid || !cursorID.Valid {
+			cursorCreatedAt = maxCursorTime()
+			cursorID = maxCursorID()
+		}
+		cursor = cursorCreatedAt
+	}
+
+	rows, err := q.db.Query(ctx, listTopComments,
+		arg.Viewer,
+		arg.PostUid,
+		cursor,
+		cursorID.UUID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTopCommentsRow
+	for rows.Next() {
+		var i ListTopCommentsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.ReplyToAuthorNickname,
+			&i.ReplyToAuthorAvatarUrl,
+			&i.PostUid,
+			&i.RootUid,
+			&i.ParentUid,
+			&i.ReplyToAuthorUid,
+			&i.Content,
+			&i.Images,
+			&i.Attachments,
+			&i.ReplyCount,
+			&i.LikeCount,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Liked,
+			&i.Edited,
+			&i.Pinned,
+			&i.BestScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func minCursorTime() pgtype.Timestamptz {
+	return pgtype.Timestamptz{
+		Time:  time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+		Valid: true,
+	}
+}
@@ -69,6 +69,64 @@ func (q *Queries) CreateFile(ctx context.Context, arg CreateFileParams) (CreateF
 	return i, err
 }
 
+const deletePostFilesByKind = `-- name: DeletePostFilesByKind :exec
+DELETE FROM post_files
+WHERE post_uid = $1
+  AND kind = $2
+`
+
+type DeletePostFilesByKindParams struct {
+	PostUid uuid.UUID
+	Kind    PostFileKind
+}
+
+func (q *Queries) DeletePostFilesByKind(ctx context.Context, arg DeletePostFilesByKindParams) error {
+	_, err := q.db.Exec(ctx, deletePostFilesByKind, arg.PostUid, arg.Kind)
+	return err
+}
+
+const deleteUnreferencedFilesByUrls = `-- name: DeleteUnreferencedFilesByUrls :many
+DELETE FROM files f
+WHERE f.url = ANY($1::text [])
+  AND NOT EXISTS (
+    SELECT 1
+    FROM post_files pf
+    WHERE pf.file_id = f.id
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM comment_files cf
+    WHERE cf.file_id = f.id
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM users u
+    WHERE u.avatar_url = f.url
+  )
+RETURNING f.url
+`
+
+// Removes file rows no post, comment or avatar points at anymore.
+func (q *Queries) DeleteUnreferencedFilesByUrls(ctx context.Context, urls []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, deleteUnreferencedFilesByUrls, urls)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			return nil, err
+		}
+		items = append(items, url)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getFileByURL = `-- name: GetFileByURL :one
 SELECT url,
   name,
@@ -153,3 +211,86 @@ func (q *Queries) GetFilesByUrls(ctx context.Context, urls []string) ([]GetFiles
 	}
 	return items, nil
 }
+
+const insertCommentFiles = `-- name: InsertCommentFiles :exec
+INSERT INTO comment_files (comment_uid, file_id, kind)
+SELECT $1,
+  unnest($2::int4 []),
+  $3
+ON CONFLICT DO NOTHING
+`
+
+type InsertCommentFilesParams struct {
+	CommentUid uuid.UUID
+	FileIds    []int32
+	Kind       PostFileKind
+}
+
+func (q *Queries) InsertCommentFiles(ctx context.Context, arg InsertCommentFilesParams) error {
+	_, err := q.db.Exec(ctx, insertCommentFiles, arg.CommentUid, arg.FileIds, arg.Kind)
+	return err
+}
+
+const insertPostFiles = `-- name: InsertPostFiles :exec
+INSERT INTO post_files (post_uid, file_id, kind)
+SELECT $1,
+  unnest($2::int4 []),
+  $3
+ON CONFLICT DO NOTHING
+`
+
+type InsertPostFilesParams struct {
+	PostUid uuid.UUID
+	FileIds []int32
+	Kind    PostFileKind
+}
+
+func (q *Queries) InsertPostFiles(ctx context.Context, arg InsertPostFilesParams) error {
+	_, err := q.db.Exec(ctx, insertPostFiles, arg.PostUid, arg.FileIds, arg.Kind)
+	return err
+}
+
+const lockFilesByUrls = `-- name: LockFilesByUrls :many
+SELECT id,
+  url,
+  content_type,
+  uploader,
+  status
+FROM files
+WHERE url = ANY($1::text [])
+ORDER BY id FOR SHARE
+`
+
+type LockFilesByUrlsRow struct {
+	ID          int32
+	Url         string
+	ContentType string
+	Uploader    uuid.UUID
+	Status      FileStatus
+}
+
+func (q *Queries) LockFilesByUrls(ctx context.Context, urls []string) ([]LockFilesByUrlsRow, error) {
+	rows, err := q.db.Query(ctx, lockFilesByUrls, urls)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LockFilesByUrlsRow
+	for rows.Next() {
+		var i LockFilesByUrlsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.ContentType,
+			&i.Uploader,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -12,6 +12,28 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countMutualFollowers = `-- name: CountMutualFollowers :one
+SELECT COUNT(*)::int
+FROM user_follows mine
+  JOIN user_follows uf ON uf.follower_uid = mine.followee_uid
+  AND uf.followee_uid = $1
+  JOIN users u ON u.uid = mine.followee_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE mine.follower_uid = $2
+`
+
+type CountMutualFollowersParams struct {
+	Uid    uuid.UUID
+	Viewer uuid.UUID
+}
+
+func (q *Queries) CountMutualFollowers(ctx context.Context, arg CountMutualFollowersParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countMutualFollowers, arg.Uid, arg.Viewer)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const decrementFollowersCount = `-- name: DecrementFollowersCount :one
 UPDATE users
 SET followers_count = GREATEST(followers_count - 1, 0)
@@ -242,6 +264,40 @@ func (q *Queries) ListFollowers(ctx context.Context, arg ListFollowersParams) ([
 	return items, nil
 }
 
+const listFollowersAmongUids = `-- name: ListFollowersAmongUids :many
+SELECT follower_uid
+FROM user_follows
+WHERE followee_uid = $1
+  AND follower_uid = ANY($2::uuid [])
+`
+
+type ListFollowersAmongUidsParams struct {
+	Viewer uuid.UUID
+	Uids   []uuid.UUID
+}
+
+// Which of the given users follow the viewer, for annotating lists in one
+// round trip.
+func (q *Queries) ListFollowersAmongUids(ctx context.Context, arg ListFollowersAmongUidsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listFollowersAmongUids, arg.Viewer, arg.Uids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var follower_uid uuid.UUID
+		if err := rows.Scan(&follower_uid); err != nil {
+			return nil, err
+		}
+		items = append(items, follower_uid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listFollowing = `-- name: ListFollowing :many
 SELECT uf.created_at AS followed_at,
   u.uid,
@@ -250,11 +306,14 @@ SELECT uf.created_at AS followed_at,
   u.avatar_url,
   u.followers_count,
   u.following_count,
+  (back.follower_uid IS NOT NULL)::boolean AS follows_you,
   u.status,
   u.created_at
 FROM user_follows uf
   JOIN users u ON u.uid = uf.followee_uid
   AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows back ON back.follower_uid = uf.followee_uid
+  AND back.followee_uid = $1
 WHERE uf.follower_uid = $1
   AND (
     $2::text IS NULL
@@ -290,6 +349,7 @@ type ListFollowingRow struct {
 	AvatarUrl      string
 	FollowersCount int32
 	FollowingCount int32
+	FollowsYou     bool
 	Status         UserStatus
 	CreatedAt      pgtype.Timestamptz
 }
@@ -316,6 +376,293 @@ func (q *Queries) ListFollowing(ctx context.Context, arg ListFollowingParams) ([
 			&i.AvatarUrl,
 			&i.FollowersCount,
 			&i.FollowingCount,
+			&i.FollowsYou,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMutualFollowers = `-- name: ListMutualFollowers :many
+SELECT uf.created_at AS followed_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  u.status,
+  u.created_at
+FROM user_follows mine
+  JOIN user_follows uf ON uf.follower_uid = mine.followee_uid
+  AND uf.followee_uid = $1
+  JOIN users u ON u.uid = mine.followee_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE mine.follower_uid = $2
+  AND (
+    (
+      $3::timestamptz IS NULL
+      AND $4::uuid IS NULL
+    )
+    OR (uf.created_at, uf.follower_uid) < (
+      $3::timestamptz,
+      $4::uuid
+    )
+  )
+ORDER BY uf.created_at DESC,
+  uf.follower_uid DESC
+LIMIT 20
+`
+
+type ListMutualFollowersParams struct {
+	Uid             uuid.UUID
+	Viewer          uuid.UUID
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.NullUUID
+}
+
+type ListMutualFollowersRow struct {
+	FollowedAt     pgtype.Timestamptz
+	Uid            uuid.UUID
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	Status         UserStatus
+	CreatedAt      pgtype.Timestamptz
+}
+
+// Users the viewer follows who also follow the target user.
+func (q *Queries) ListMutualFollowers(ctx context.Context, arg ListMutualFollowersParams) ([]ListMutualFollowersRow, error) {
+	rows, err := q.db.Query(ctx, listMutualFollowers,
+		arg.Uid,
+		arg.Viewer,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMutualFollowersRow
+	for rows.Next() {
+		var i ListMutualFollowersRow
+		if err := rows.Scan(
+			&i.FollowedAt,
+			&i.Uid,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserFollowers = `-- name: ListUserFollowers :many
+SELECT uf.created_at AS followed_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (vf.follower_uid IS NOT NULL)::boolean AS is_following,
+  (fv.follower_uid IS NOT NULL)::boolean AS follows_you,
+  u.status,
+  u.created_at
+FROM user_follows uf
+  JOIN users u ON u.uid = uf.follower_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows vf ON vf.follower_uid = $1::uuid
+  AND vf.followee_uid = uf.follower_uid
+  LEFT JOIN user_follows fv ON fv.follower_uid = uf.follower_uid
+  AND fv.followee_uid = $1::uuid
+WHERE uf.followee_uid = $2
+  AND (
+    $3::text IS NULL
+    OR u.nickname ILIKE '%' || $3::text || '%'
+  )
+  AND (
+    (
+      $4::timestamptz IS NULL
+      AND $5::uuid IS NULL
+    )
+    OR (uf.created_at, uf.follower_uid) < (
+      $4::timestamptz,
+      $5::uuid
+    )
+  )
+ORDER BY uf.created_at DESC,
+  uf.follower_uid DESC
+LIMIT 20
+`
+
+type ListUserFollowersParams struct {
+	Viewer          uuid.NullUUID
+	Uid             uuid.UUID
+	Query           pgtype.Text
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.NullUUID
+}
+
+type ListUserFollowersRow struct {
+	FollowedAt     pgtype.Timestamptz
+	Uid            uuid.UUID
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	IsFollowing    bool
+	FollowsYou     bool
+	Status         UserStatus
+	CreatedAt      pgtype.Timestamptz
+}
+
+// Followers of any user, with flags relative to the viewer.
+func (q *Queries) ListUserFollowers(ctx context.Context, arg ListUserFollowersParams) ([]ListUserFollowersRow, error) {
+	rows, err := q.db.Query(ctx, listUserFollowers,
+		arg.Viewer,
+		arg.Uid,
+		arg.Query,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserFollowersRow
+	for rows.Next() {
+		var i ListUserFollowersRow
+		if err := rows.Scan(
+			&i.FollowedAt,
+			&i.Uid,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.IsFollowing,
+			&i.FollowsYou,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserFollowing = `-- name: ListUserFollowing :many
+SELECT uf.created_at AS followed_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (vf.follower_uid IS NOT NULL)::boolean AS is_following,
+  (fv.follower_uid IS NOT NULL)::boolean AS follows_you,
+  u.status,
+  u.created_at
+FROM user_follows uf
+  JOIN users u ON u.uid = uf.followee_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows vf ON vf.follower_uid = $1::uuid
+  AND vf.followee_uid = uf.followee_uid
+  LEFT JOIN user_follows fv ON fv.follower_uid = uf.followee_uid
+  AND fv.followee_uid = $1::uuid
+WHERE uf.follower_uid = $2
+  AND (
+    $3::text IS NULL
+    OR u.nickname ILIKE '%' || $3::text || '%'
+  )
+  AND (
+    (
+      $4::timestamptz IS NULL
+      AND $5::uuid IS NULL
+    )
+    OR (uf.created_at, uf.followee_uid) < (
+      $4::timestamptz,
+      $5::uuid
+    )
+  )
+ORDER BY uf.created_at DESC,
+  uf.followee_uid DESC
+LIMIT 20
+`
+
+type ListUserFollowingParams struct {
+	Viewer          uuid.NullUUID
+	Uid             uuid.UUID
+	Query           pgtype.Text
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.NullUUID
+}
+
+type ListUserFollowingRow struct {
+	FollowedAt     pgtype.Timestamptz
+	Uid            uuid.UUID
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	IsFollowing    bool
+	FollowsYou     bool
+	Status         UserStatus
+	CreatedAt      pgtype.Timestamptz
+}
+
+// Users any user follows, with flags relative to the viewer.
+func (q *Queries) ListUserFollowing(ctx context.Context, arg ListUserFollowingParams) ([]ListUserFollowingRow, error) {
+	rows, err := q.db.Query(ctx, listUserFollowing,
+		arg.Viewer,
+		arg.Uid,
+		arg.Query,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserFollowingRow
+	for rows.Next() {
+		var i ListUserFollowingRow
+		if err := rows.Scan(
+			&i.FollowedAt,
+			&i.Uid,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.IsFollowing,
+			&i.FollowsYou,
 			&i.Status,
 			&i.CreatedAt,
 		); err != nil {
@@ -0,0 +1,339 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: follow_import.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createFollowImport = `-- name: CreateFollowImport :exec
+INSERT INTO follow_imports (uid, user_uid, file_url)
+VALUES ($1, $2, $3)
+`
+
+type CreateFollowImportParams struct {
+	Uid     uuid.UUID
+	UserUid uuid.UUID
+	FileUrl string
+}
+
+func (q *Queries) CreateFollowImport(ctx context.Context, arg CreateFollowImportParams) error {
+	_, err := q.db.Exec(ctx, createFollowImport, arg.Uid, arg.UserUid, arg.FileUrl)
+	return err
+}
+
+const createFollowImportEntries = `-- name: CreateFollowImportEntries :exec
+INSERT INTO follow_import_entries (import_uid, position, uid, nickname)
+SELECT $1,
+  x.ord - 1,
+  NULLIF(x.uid, '00000000-0000-0000-0000-000000000000'::uuid),
+  n.nickname
+FROM unnest($2::uuid []) WITH ORDINALITY AS x(uid, ord)
+  JOIN unnest($3::text []) WITH ORDINALITY AS n(nickname, ord) ON n.ord = x.ord
+`
+
+type CreateFollowImportEntriesParams struct {
+	ImportUid uuid.UUID
+	Uids      []uuid.UUID
+	Nicknames []string
+}
+
+func (q *Queries) CreateFollowImportEntries(ctx context.Context, arg CreateFollowImportEntriesParams) error {
+	_, err := q.db.Exec(ctx, createFollowImportEntries, arg.ImportUid, arg.Uids, arg.Nicknames)
+	return err
+}
+
+const deleteFollowImportEntries = `-- name: DeleteFollowImportEntries :exec
+DELETE FROM follow_import_entries
+WHERE import_uid = $1
+`
+
+func (q *Queries) DeleteFollowImportEntries(ctx context.Context, importUid uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteFollowImportEntries, importUid)
+	return err
+}
+
+const exportFollowers = `-- name: ExportFollowers :many
+SELECT u.uid,
+  u.nickname,
+  uf.created_at AS followed_at
+FROM user_follows uf
+  JOIN users u ON u.uid = uf.follower_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE uf.followee_uid = $1
+ORDER BY uf.created_at DESC,
+  uf.follower_uid DESC
+`
+
+type ExportFollowersRow struct {
+	Uid        uuid.UUID
+	Nickname   string
+	FollowedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ExportFollowers(ctx context.Context, uid uuid.UUID) ([]ExportFollowersRow, error) {
+	rows, err := q.db.Query(ctx, exportFollowers, uid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportFollowersRow
+	for rows.Next() {
+		var i ExportFollowersRow
+		if err := rows.Scan(&i.Uid, &i.Nickname, &i.FollowedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportFollowing = `-- name: ExportFollowing :many
+SELECT u.uid,
+  u.nickname,
+  uf.created_at AS followed_at
+FROM user_follows uf
+  JOIN users u ON u.uid = uf.followee_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE uf.follower_uid = $1
+ORDER BY uf.created_at DESC,
+  uf.followee_uid DESC
+`
+
+type ExportFollowingRow struct {
+	Uid        uuid.UUID
+	Nickname   string
+	FollowedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ExportFollowing(ctx context.Context, uid uuid.UUID) ([]ExportFollowingRow, error) {
+	rows, err := q.db.Query(ctx, exportFollowing, uid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportFollowingRow
+	for rows.Next() {
+		var i ExportFollowingRow
+		if err := rows.Scan(&i.Uid, &i.Nickname, &i.FollowedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFollowImportByUid = `-- name: GetFollowImportByUid :one
+SELECT uid,
+  user_uid,
+  file_url,
+  status,
+  total_count,
+  processed_count,
+  followed_count,
+  already_following_count,
+  not_found_count,
+  skipped_count,
+  error,
+  parsed_at,
+  created_at,
+  finished_at
+FROM follow_imports
+WHERE uid = $1
+`
+
+type GetFollowImportByUidRow struct {
+	Uid                   uuid.UUID
+	UserUid               uuid.UUID
+	FileUrl               string
+	Status                FollowImportStatus
+	TotalCount            int32
+	ProcessedCount        int32
+	FollowedCount         int32
+	AlreadyFollowingCount int32
+	NotFoundCount         int32
+	SkippedCount          int32
+	Error                 string
+	ParsedAt              pgtype.Timestamptz
+	CreatedAt             pgtype.Timestamptz
+	FinishedAt            pgtype.Timestamptz
+}
+
+func (q *Queries) GetFollowImportByUid(ctx context.Context, uid uuid.UUID) (GetFollowImportByUidRow, error) {
+	row := q.db.QueryRow(ctx, getFollowImportByUid, uid)
+	var i GetFollowImportByUidRow
+	err := row.Scan(
+		&i.Uid,
+		&i.UserUid,
+		&i.FileUrl,
+		&i.Status,
+		&i.TotalCount,
+		&i.ProcessedCount,
+		&i.FollowedCount,
+		&i.AlreadyFollowingCount,
+		&i.NotFoundCount,
+		&i.SkippedCount,
+		&i.Error,
+		&i.ParsedAt,
+		&i.CreatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const listFollowImportEntries = `-- name: ListFollowImportEntries :many
+SELECT uid,
+  nickname
+FROM follow_import_entries
+WHERE import_uid = $1
+  AND position >= $2
+ORDER BY position
+LIMIT $3
+`
+
+type ListFollowImportEntriesParams struct {
+	ImportUid     uuid.UUID
+	StartPosition int32
+	LimitCount    int32
+}
+
+type ListFollowImportEntriesRow struct {
+	Uid      uuid.NullUUID
+	Nickname string
+}
+
+func (q *Queries) ListFollowImportEntries(ctx context.Context, arg ListFollowImportEntriesParams) ([]ListFollowImportEntriesRow, error) {
+	rows, err := q.db.Query(ctx, listFollowImportEntries, arg.ImportUid, arg.StartPosition, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFollowImportEntriesRow
+	for rows.Next() {
+		var i ListFollowImportEntriesRow
+		if err := rows.Scan(&i.Uid, &i.Nickname); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markFollowImportParsed = `-- name: MarkFollowImportParsed :exec
+UPDATE follow_imports
+SET total_count = $1,
+  parsed_at = now()
+WHERE uid = $2
+  AND status = 'RUNNING'::follow_import_status
+`
+
+type MarkFollowImportParsedParams struct {
+	TotalCount int32
+	Uid        uuid.UUID
+}
+
+func (q *Queries) MarkFollowImportParsed(ctx context.Context, arg MarkFollowImportParsedParams) error {
+	_, err := q.db.Exec(ctx, markFollowImportParsed, arg.TotalCount, arg.Uid)
+	return err
+}
+
+const resolveFollowImportUsers = `-- name: ResolveFollowImportUsers :many
+SELECT uid,
+  nickname
+FROM users
+WHERE status = 'NORMAL'::user_status
+  AND (
+    uid = ANY($1::uuid [])
+    OR nickname = ANY($2::text [])
+  )
+`
+
+type ResolveFollowImportUsersParams struct {
+	Uids      []uuid.UUID
+	Nicknames []string
+}
+
+type ResolveFollowImportUsersRow struct {
+	Uid      uuid.UUID
+	Nickname string
+}
+
+func (q *Queries) ResolveFollowImportUsers(ctx context.Context, arg ResolveFollowImportUsersParams) ([]ResolveFollowImportUsersRow, error) {
+	rows, err := q.db.Query(ctx, resolveFollowImportUsers, arg.Uids, arg.Nicknames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResolveFollowImportUsersRow
+	for rows.Next() {
+		var i ResolveFollowImportUsersRow
+		if err := rows.Scan(&i.Uid, &i.Nickname); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateFollowImportProgress = `-- name: UpdateFollowImportProgress :exec
+UPDATE follow_imports
+SET status = $1,
+  total_count = $2,
+  processed_count = $3,
+  followed_count = followed_count + $4,
+  already_following_count = already_following_count + $5,
+  not_found_count = not_found_count + $6,
+  skipped_count = skipped_count + $7,
+  error = $8,
+  finished_at = CASE
+    WHEN $1::follow_import_status = 'RUNNING'::follow_import_status THEN NULL
+    ELSE now()
+  END
+WHERE uid = $9
+  AND status = 'RUNNING'::follow_import_status
+`
+
+type UpdateFollowImportProgressParams struct {
+	Status                FollowImportStatus
+	TotalCount            int32
+	ProcessedCount        int32
+	FollowedCount         int32
+	AlreadyFollowingCount int32
+	NotFoundCount         int32
+	SkippedCount          int32
+	Error                 string
+	Uid                   uuid.UUID
+}
+
+func (q *Queries) UpdateFollowImportProgress(ctx context.Context, arg UpdateFollowImportProgressParams) error {
+	_, err := q.db.Exec(ctx, updateFollowImportProgress,
+		arg.Status,
+		arg.TotalCount,
+		arg.ProcessedCount,
+		arg.FollowedCount,
+		arg.AlreadyFollowingCount,
+		arg.NotFoundCount,
+		arg.SkippedCount,
+		arg.Error,
+		arg.Uid,
+	)
+	return err
+}
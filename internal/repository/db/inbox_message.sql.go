@@ -40,7 +40,13 @@ SELECT COUNT(*)::int4 AS unread_count,
     )::int4 AS follow_unread_count,
   COUNT(*) FILTER (
       WHERE type = 'COMMENT'::message_type
-    )::int4 AS comment_unread_count
+    )::int4 AS comment_unread_count,
+  COUNT(*) FILTER (
+      WHERE type IN ('LIKE'::message_type, 'COLLECT'::message_type)
+    )::int4 AS like_unread_count,
+  COUNT(*) FILTER (
+      WHERE type = 'FOLLOW_IMPORT'::message_type
+    )::int4 AS system_unread_count
 FROM inbox_messages
 WHERE receiver_uid = $1
   AND status = 'NORMAL'::message_status
@@ -51,12 +57,20 @@ type CountUnreadInboxMessagesByReceiverRow struct {
 	UnreadCount        int32
 	FollowUnreadCount  int32
 	CommentUnreadCount int32
+	LikeUnreadCount    int32
+	SystemUnreadCount  int32
 }
 
 func (q *Queries) CountUnreadInboxMessagesByReceiver(ctx context.Context, receiverUid uuid.UUID) (CountUnreadInboxMessagesByReceiverRow, error) {
 	row := q.db.QueryRow(ctx, countUnreadInboxMessagesByReceiver, receiverUid)
 	var i CountUnreadInboxMessagesByReceiverRow
-	err := row.Scan(&i.UnreadCount, &i.FollowUnreadCount, &i.CommentUnreadCount)
+	err := row.Scan(
+		&i.UnreadCount,
+		&i.FollowUnreadCount,
+		&i.CommentUnreadCount,
+		&i.LikeUnreadCount,
+		&i.SystemUnreadCount,
+	)
 	return i, err
 }
 
@@ -111,6 +125,82 @@ func (q *Queries) CreateCommentInboxMessage(ctx context.Context, arg CreateComme
 	return i, err
 }
 
+const createCommentLikeInboxMessage = `-- name: CreateCommentLikeInboxMessage :execrows
+INSERT INTO inbox_messages (uid, receiver_uid, type, actor_uid, comment_uid, post_uid)
+SELECT $1,
+  $2,
+  'LIKE'::message_type,
+  $3,
+  $4,
+  $5
+WHERE EXISTS (
+    SELECT 1
+    FROM comment_likes e
+    WHERE e.comment_uid = $4
+      AND e.user_uid = $3
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM inbox_messages im
+    WHERE im.receiver_uid = $2
+      AND im.actor_uid = $3
+      AND im.type = 'LIKE'::message_type
+      AND im.post_uid = $5
+      AND im.comment_uid = $4
+      AND im.status = 'NORMAL'::message_status
+  )
+`
+
+type CreateCommentLikeInboxMessageParams struct {
+	Uid         uuid.UUID
+	ReceiverUid uuid.UUID
+	ActorUid    uuid.UUID
+	CommentUid  uuid.NullUUID
+	PostUid     uuid.NullUUID
+}
+
+func (q *Queries) CreateCommentLikeInboxMessage(ctx context.Context, arg CreateCommentLikeInboxMessageParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createCommentLikeInboxMessage,
+		arg.Uid,
+		arg.ReceiverUid,
+		arg.ActorUid,
+		arg.CommentUid,
+		arg.PostUid,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createFollowImportInboxMessage = `-- name: CreateFollowImportInboxMessage :exec
+INSERT INTO inbox_messages (
+    uid,
+    receiver_uid,
+    type,
+    actor_uid,
+    follow_import_uid
+  )
+VALUES (
+    $1,
+    $2,
+    'FOLLOW_IMPORT'::message_type,
+    $2,
+    $3
+  )
+`
+
+type CreateFollowImportInboxMessageParams struct {
+	Uid             uuid.UUID
+	ReceiverUid     uuid.UUID
+	FollowImportUid uuid.NullUUID
+}
+
+func (q *Queries) CreateFollowImportInboxMessage(ctx context.Context, arg CreateFollowImportInboxMessageParams) error {
+	_, err := q.db.Exec(ctx, createFollowImportInboxMessage, arg.Uid, arg.ReceiverUid, arg.FollowImportUid)
+	return err
+}
+
 const createFollowInboxMessage = `-- name: CreateFollowInboxMessage :execrows
 INSERT INTO inbox_messages (uid, receiver_uid, type, actor_uid)
 SELECT $1,
@@ -141,6 +231,118 @@ func (q *Queries) CreateFollowInboxMessage(ctx context.Context, arg CreateFollow
 	return result.RowsAffected(), nil
 }
 
+const createPostCollectInboxMessage = `-- name: CreatePostCollectInboxMessage :execrows
+INSERT INTO inbox_messages (uid, receiver_uid, type, actor_uid, post_uid)
+SELECT $1,
+  $2,
+  'COLLECT'::message_type,
+  $3,
+  $4
+WHERE EXISTS (
+    SELECT 1
+    FROM post_collections e
+    WHERE e.post_uid = $4
+      AND e.user_uid = $3
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM inbox_messages im
+    WHERE im.receiver_uid = $2
+      AND im.actor_uid = $3
+      AND im.type = 'COLLECT'::message_type
+      AND im.post_uid = $4
+      AND im.comment_uid IS NULL
+      AND im.status = 'NORMAL'::message_status
+  )
+`
+
+type CreatePostCollectInboxMessageParams struct {
+	Uid         uuid.UUID
+	ReceiverUid uuid.UUID
+	ActorUid    uuid.UUID
+	PostUid     uuid.NullUUID
+}
+
+func (q *Queries) CreatePostCollectInboxMessage(ctx context.Context, arg CreatePostCollectInboxMessageParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createPostCollectInboxMessage,
+		arg.Uid,
+		arg.ReceiverUid,
+		arg.ActorUid,
+		arg.PostUid,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createPostLikeInboxMessage = `-- name: CreatePostLikeInboxMessage :execrows
+INSERT INTO inbox_messages (uid, receiver_uid, type, actor_uid, post_uid)
+SELECT $1,
+  $2,
+  'LIKE'::message_type,
+  $3,
+  $4
+WHERE EXISTS (
+    SELECT 1
+    FROM post_likes e
+    WHERE e.post_uid = $4
+      AND e.user_uid = $3
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM inbox_messages im
+    WHERE im.receiver_uid = $2
+      AND im.actor_uid = $3
+      AND im.type = 'LIKE'::message_type
+      AND im.post_uid = $4
+      AND im.comment_uid IS NULL
+      AND im.status = 'NORMAL'::message_status
+  )
+`
+
+type CreatePostLikeInboxMessageParams struct {
+	Uid         uuid.UUID
+	ReceiverUid uuid.UUID
+	ActorUid    uuid.UUID
+	PostUid     uuid.NullUUID
+}
+
+func (q *Queries) CreatePostLikeInboxMessage(ctx context.Context, arg CreatePostLikeInboxMessageParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createPostLikeInboxMessage,
+		arg.Uid,
+		arg.ReceiverUid,
+		arg.ActorUid,
+		arg.PostUid,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteInboxMessagesByCommentUids = `-- name: DeleteInboxMessagesByCommentUids :exec
+DELETE FROM inbox_messages
+WHERE comment_uid = ANY($1::uuid [])
+  OR parent_uid = ANY($1::uuid [])
+`
+
+func (q *Queries) DeleteInboxMessagesByCommentUids(ctx context.Context, commentUids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteInboxMessagesByCommentUids, commentUids)
+	return err
+}
+
+const deleteInboxMessagesByPostUids = `-- name: DeleteInboxMessagesByPostUids :exec
+DELETE FROM inbox_messages
+WHERE post_uid = ANY($1::uuid [])
+  OR parent_uid = ANY($1::uuid [])
+`
+
+func (q *Queries) DeleteInboxMessagesByPostUids(ctx context.Context, postUids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteInboxMessagesByPostUids, postUids)
+	return err
+}
+
 const listCommentInboxMessages = `-- name: ListCommentInboxMessages :many
 SELECT m.uid,
   m.receiver_uid,
@@ -153,6 +355,8 @@ SELECT m.uid,
   m.status,
   m.comment_uid,
   c.content AS comment_content,
+  c.images AS comment_images,
+  c.attachments AS comment_attachments,
   m.post_uid,
   m.parent_uid,
   COALESCE(pc.content, p.text, ''::text) AS parent_content
@@ -195,20 +399,22 @@ type ListCommentInboxMessagesParams struct {
 }
 
 type ListCommentInboxMessagesRow struct {
-	Uid            uuid.UUID
-	ReceiverUid    uuid.UUID
-	Type           MessageType
-	IsRead         bool
-	ActorUid       uuid.UUID
-	ActorNickname  string
-	ActorAvatarUrl string
-	CreatedAt      pgtype.Timestamptz
-	Status         MessageStatus
-	CommentUid     uuid.NullUUID
-	CommentContent pgtype.Text
-	PostUid        uuid.NullUUID
-	ParentUid      uuid.NullUUID
-	ParentContent  string
+	Uid                uuid.UUID
+	ReceiverUid        uuid.UUID
+	Type               MessageType
+	IsRead             bool
+	ActorUid           uuid.UUID
+	ActorNickname      string
+	ActorAvatarUrl     string
+	CreatedAt          pgtype.Timestamptz
+	Status             MessageStatus
+	CommentUid         uuid.NullUUID
+	CommentContent     pgtype.Text
+	CommentImages      []string
+	CommentAttachments []string
+	PostUid            uuid.NullUUID
+	ParentUid          uuid.NullUUID
+	ParentContent      string
 }
 
 func (q *Queries) ListCommentInboxMessages(ctx context.Context, arg ListCommentInboxMessagesParams) ([]ListCommentInboxMessagesRow, error) {
@@ -237,6 +443,8 @@ func (q *Queries) ListCommentInboxMessages(ctx context.Context, arg ListCommentI
 			&i.Status,
 			&i.CommentUid,
 			&i.CommentContent,
+			&i.CommentImages,
+			&i.CommentAttachments,
 			&i.PostUid,
 			&i.ParentUid,
 			&i.ParentContent,
@@ -340,6 +548,208 @@ func (q *Queries) ListFollowInboxMessages(ctx context.Context, arg ListFollowInb
 	return items, nil
 }
 
+const listLikeInboxMessages = `-- name: ListLikeInboxMessages :many
+SELECT m.uid,
+  m.receiver_uid,
+  m.type,
+  m.is_read,
+  m.actor_uid,
+  u.nickname AS actor_nickname,
+  u.avatar_url AS actor_avatar_url,
+  m.created_at,
+  m.status,
+  m.post_uid,
+  p.text AS post_text,
+  m.comment_uid,
+  c.content AS comment_content
+FROM inbox_messages m
+  JOIN users u ON u.uid = m.actor_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN posts p ON p.uid = m.post_uid
+  AND p.status = 'NORMAL'::post_status
+  LEFT JOIN post_comments c ON c.uid = m.comment_uid
+  AND c.status = 'NORMAL'::comment_status
+WHERE m.receiver_uid = $1
+  AND m.status = 'NORMAL'::message_status
+  AND m.type IN ('LIKE'::message_type, 'COLLECT'::message_type)
+  AND (
+    $2::boolean IS NULL
+    OR m.is_read = $2::boolean
+  )
+  AND (
+    (
+      $3::timestamptz IS NULL
+      AND $4::uuid IS NULL
+    )
+    OR (m.created_at, m.uid) < (
+      $3::timestamptz,
+      $4::uuid
+    )
+  )
+ORDER BY m.created_at DESC,
+  m.uid DESC
+LIMIT 20
+`
+
+type ListLikeInboxMessagesParams struct {
+	ReceiverUid     uuid.UUID
+	IsRead          pgtype.Bool
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.NullUUID
+}
+
+type ListLikeInboxMessagesRow struct {
+	Uid            uuid.UUID
+	ReceiverUid    uuid.UUID
+	Type           MessageType
+	IsRead         bool
+	ActorUid       uuid.UUID
+	ActorNickname  string
+	ActorAvatarUrl string
+	CreatedAt      pgtype.Timestamptz
+	Status         MessageStatus
+	PostUid        uuid.NullUUID
+	PostText       pgtype.Text
+	CommentUid     uuid.NullUUID
+	CommentContent pgtype.Text
+}
+
+func (q *Queries) ListLikeInboxMessages(ctx context.Context, arg ListLikeInboxMessagesParams) ([]ListLikeInboxMessagesRow, error) {
+	rows, err := q.db.Query(ctx, listLikeInboxMessages,
+		arg.ReceiverUid,
+		arg.IsRead,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLikeInboxMessagesRow
+	for rows.Next() {
+		var i ListLikeInboxMessagesRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.ReceiverUid,
+			&i.Type,
+			&i.IsRead,
+			&i.ActorUid,
+			&i.ActorNickname,
+			&i.ActorAvatarUrl,
+			&i.CreatedAt,
+			&i.Status,
+			&i.PostUid,
+			&i.PostText,
+			&i.CommentUid,
+			&i.CommentContent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSystemInboxMessages = `-- name: ListSystemInboxMessages :many
+SELECT m.uid,
+  m.type,
+  m.is_read,
+  m.created_at,
+  fi.uid AS follow_import_uid,
+  fi.status AS follow_import_status,
+  fi.total_count AS follow_import_total_count,
+  fi.followed_count AS follow_import_followed_count,
+  fi.already_following_count AS follow_import_already_following_count,
+  fi.not_found_count AS follow_import_not_found_count,
+  fi.skipped_count AS follow_import_skipped_count,
+  fi.error AS follow_import_error
+FROM inbox_messages m
+  JOIN follow_imports fi ON fi.uid = m.follow_import_uid
+WHERE m.receiver_uid = $1
+  AND m.status = 'NORMAL'::message_status
+  AND m.type = 'FOLLOW_IMPORT'::message_type
+  AND (
+    $2::boolean IS NULL
+    OR m.is_read = $2::boolean
+  )
+  AND (
+    (
+      $3::timestamptz IS NULL
+      AND $4::uuid IS NULL
+    )
+    OR (m.created_at, m.uid) < (
+      $3::timestamptz,
+      $4::uuid
+    )
+  )
+ORDER BY m.created_at DESC,
+  m.uid DESC
+LIMIT 20
+`
+
+type ListSystemInboxMessagesParams struct {
+	ReceiverUid     uuid.UUID
+	IsRead          pgtype.Bool
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.NullUUID
+}
+
+type ListSystemInboxMessagesRow struct {
+	Uid                               uuid.UUID
+	Type                              MessageType
+	IsRead                            bool
+	CreatedAt                         pgtype.Timestamptz
+	FollowImportUid                   uuid.UUID
+	FollowImportStatus                FollowImportStatus
+	FollowImportTotalCount            int32
+	FollowImportFollowedCount         int32
+	FollowImportAlreadyFollowingCount int32
+	FollowImportNotFoundCount         int32
+	FollowImportSkippedCount          int32
+	FollowImportError                 string
+}
+
+func (q *Queries) ListSystemInboxMessages(ctx context.Context, arg ListSystemInboxMessagesParams) ([]ListSystemInboxMessagesRow, error) {
+	rows, err := q.db.Query(ctx, listSystemInboxMessages,
+		arg.ReceiverUid,
+		arg.IsRead,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSystemInboxMessagesRow
+	for rows.Next() {
+		var i ListSystemInboxMessagesRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Type,
+			&i.IsRead,
+			&i.CreatedAt,
+			&i.FollowImportUid,
+			&i.FollowImportStatus,
+			&i.FollowImportTotalCount,
+			&i.FollowImportFollowedCount,
+			&i.FollowImportAlreadyFollowingCount,
+			&i.FollowImportNotFoundCount,
+			&i.FollowImportSkippedCount,
+			&i.FollowImportError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markAllInboxMessagesReadByReceiver = `-- name: MarkAllInboxMessagesReadByReceiver :execrows
 UPDATE inbox_messages
 SET is_read = true
@@ -0,0 +1,119 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: link_preview.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getLinkPreviewFetchedAt = `-- name: GetLinkPreviewFetchedAt :one
+SELECT fetched_at
+FROM link_previews
+WHERE url = $1
+`
+
+func (q *Queries) GetLinkPreviewFetchedAt(ctx context.Context, url string) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, getLinkPreviewFetchedAt, url)
+	var fetched_at pgtype.Timestamptz
+	err := row.Scan(&fetched_at)
+	return fetched_at, err
+}
+
+const listReadyLinkPreviewsByURLs = `-- name: ListReadyLinkPreviewsByURLs :many
+SELECT url,
+  title,
+  description,
+  site_name,
+  image_url
+FROM link_previews
+WHERE url = ANY($1::text [])
+  AND status = 'READY'::link_preview_status
+`
+
+type ListReadyLinkPreviewsByURLsRow struct {
+	Url         string
+	Title       string
+	Description string
+	SiteName    string
+	ImageUrl    string
+}
+
+func (q *Queries) ListReadyLinkPreviewsByURLs(ctx context.Context, urls []string) ([]ListReadyLinkPreviewsByURLsRow, error) {
+	rows, err := q.db.Query(ctx, listReadyLinkPreviewsByURLs, urls)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReadyLinkPreviewsByURLsRow
+	for rows.Next() {
+		var i ListReadyLinkPreviewsByURLsRow
+		if err := rows.Scan(
+			&i.Url,
+			&i.Title,
+			&i.Description,
+			&i.SiteName,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertLinkPreview = `-- name: UpsertLinkPreview :exec
+INSERT INTO link_previews (
+    url,
+    status,
+    title,
+    description,
+    site_name,
+    image_url,
+    fetched_at
+  )
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    now()
+  ) ON CONFLICT (url) DO
+UPDATE
+SET status = EXCLUDED.status,
+  title = EXCLUDED.title,
+  description = EXCLUDED.description,
+  site_name = EXCLUDED.site_name,
+  image_url = EXCLUDED.image_url,
+  fetched_at = EXCLUDED.fetched_at
+`
+
+type UpsertLinkPreviewParams struct {
+	Url         string
+	Status      LinkPreviewStatus
+	Title       string
+	Description string
+	SiteName    string
+	ImageUrl    string
+}
+
+func (q *Queries) UpsertLinkPreview(ctx context.Context, arg UpsertLinkPreviewParams) error {
+	_, err := q.db.Exec(ctx, upsertLinkPreview,
+		arg.Url,
+		arg.Status,
+		arg.Title,
+		arg.Description,
+		arg.SiteName,
+		arg.ImageUrl,
+	)
+	return err
+}
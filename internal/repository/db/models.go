@@ -96,6 +96,91 @@ func (ns NullFileStatus) Value() (driver.Value, error) {
 	return string(ns.FileStatus), nil
 }
 
+type FollowImportStatus string
+
+const (
+	FollowImportStatusRUNNING FollowImportStatus = "RUNNING"
+	FollowImportStatusDONE    FollowImportStatus = "DONE"
+	FollowImportStatusFAILED  FollowImportStatus = "FAILED"
+)
+
+func (e *FollowImportStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FollowImportStatus(s)
+	case string:
+		*e = FollowImportStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FollowImportStatus: %T", src)
+	}
+	return nil
+}
+
+type NullFollowImportStatus struct {
+	FollowImportStatus FollowImportStatus
+	Valid              bool // Valid is true if FollowImportStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFollowImportStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.FollowImportStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FollowImportStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFollowImportStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FollowImportStatus), nil
+}
+
+type LinkPreviewStatus string
+
+const (
+	LinkPreviewStatusREADY  LinkPreviewStatus = "READY"
+	LinkPreviewStatusFAILED LinkPreviewStatus = "FAILED"
+)
+
+func (e *LinkPreviewStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LinkPreviewStatus(s)
+	case string:
+		*e = LinkPreviewStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LinkPreviewStatus: %T", src)
+	}
+	return nil
+}
+
+type NullLinkPreviewStatus struct {
+	LinkPreviewStatus LinkPreviewStatus
+	Valid             bool // Valid is true if LinkPreviewStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLinkPreviewStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.LinkPreviewStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LinkPreviewStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLinkPreviewStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LinkPreviewStatus), nil
+}
+
 type MessageStatus string
 
 const (
@@ -141,8 +226,11 @@ func (ns NullMessageStatus) Value() (driver.Value, error) {
 type MessageType string
 
 const (
-	MessageTypeCOMMENT MessageType = "COMMENT"
-	MessageTypeFOLLOW  MessageType = "FOLLOW"
+	MessageTypeCOMMENT      MessageType = "COMMENT"
+	MessageTypeFOLLOW       MessageType = "FOLLOW"
+	MessageTypeLIKE         MessageType = "LIKE"
+	MessageTypeCOLLECT      MessageType = "COLLECT"
+	MessageTypeFOLLOWIMPORT MessageType = "FOLLOW_IMPORT"
 )
 
 func (e *MessageType) Scan(src interface{}) error {
@@ -180,6 +268,91 @@ func (ns NullMessageType) Value() (driver.Value, error) {
 	return string(ns.MessageType), nil
 }
 
+type PostCommentPolicy string
+
+const (
+	PostCommentPolicyEVERYONE  PostCommentPolicy = "EVERYONE"
+	PostCommentPolicyFOLLOWERS PostCommentPolicy = "FOLLOWERS"
+	PostCommentPolicyLOCKED    PostCommentPolicy = "LOCKED"
+)
+
+func (e *PostCommentPolicy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PostCommentPolicy(s)
+	case string:
+		*e = PostCommentPolicy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PostCommentPolicy: %T", src)
+	}
+	return nil
+}
+
+type NullPostCommentPolicy struct {
+	PostCommentPolicy PostCommentPolicy
+	Valid             bool // Valid is true if PostCommentPolicy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPostCommentPolicy) Scan(value interface{}) error {
+	if value == nil {
+		ns.PostCommentPolicy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PostCommentPolicy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPostCommentPolicy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PostCommentPolicy), nil
+}
+
+type PostFileKind string
+
+const (
+	PostFileKindIMAGE      PostFileKind = "IMAGE"
+	PostFileKindATTACHMENT PostFileKind = "ATTACHMENT"
+)
+
+func (e *PostFileKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PostFileKind(s)
+	case string:
+		*e = PostFileKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PostFileKind: %T", src)
+	}
+	return nil
+}
+
+type NullPostFileKind struct {
+	PostFileKind PostFileKind
+	Valid        bool // Valid is true if PostFileKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPostFileKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.PostFileKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PostFileKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPostFileKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PostFileKind), nil
+}
+
 type PostStatus string
 
 const (
@@ -349,6 +522,49 @@ func (ns NullReportTargetType) Value() (driver.Value, error) {
 	return string(ns.ReportTargetType), nil
 }
 
+type SensitiveMediaPreference string
+
+const (
+	SensitiveMediaPreferenceSHOW   SensitiveMediaPreference = "SHOW"
+	SensitiveMediaPreferenceHIDE   SensitiveMediaPreference = "HIDE"
+	SensitiveMediaPreferenceFILTER SensitiveMediaPreference = "FILTER"
+)
+
+func (e *SensitiveMediaPreference) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SensitiveMediaPreference(s)
+	case string:
+		*e = SensitiveMediaPreference(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SensitiveMediaPreference: %T", src)
+	}
+	return nil
+}
+
+type NullSensitiveMediaPreference struct {
+	SensitiveMediaPreference SensitiveMediaPreference
+	Valid                    bool // Valid is true if SensitiveMediaPreference is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSensitiveMediaPreference) Scan(value interface{}) error {
+	if value == nil {
+		ns.SensitiveMediaPreference, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SensitiveMediaPreference.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSensitiveMediaPreference) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SensitiveMediaPreference), nil
+}
+
 type UserRole string
 
 const (
@@ -434,12 +650,53 @@ func (ns NullUserStatus) Value() (driver.Value, error) {
 	return string(ns.UserStatus), nil
 }
 
+type CollectionFolder struct {
+	ID        int32
+	Uid       uuid.UUID
+	OwnerUid  uuid.UUID
+	Name      string
+	IsDefault bool
+	IsPublic  bool
+	Position  int32
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type CollectionFolderItem struct {
+	FolderUid uuid.UUID
+	PostUid   uuid.UUID
+	UserUid   uuid.UUID
+	Note      string
+	CreatedAt pgtype.Timestamptz
+}
+
+type CommentFile struct {
+	CommentUid uuid.UUID
+	FileID     int32
+	Kind       PostFileKind
+	CreatedAt  pgtype.Timestamptz
+}
+
 type CommentLike struct {
 	CommentUid uuid.UUID
 	UserUid    uuid.UUID
 	CreatedAt  pgtype.Timestamptz
 }
 
+type CommentReaction struct {
+	CommentUid uuid.UUID
+	UserUid    uuid.UUID
+	Emoji      string
+	CreatedAt  pgtype.Timestamptz
+}
+
+type CommentRevision struct {
+	ID         int32
+	CommentUid uuid.UUID
+	Content    string
+	CreatedAt  pgtype.Timestamptz
+}
+
 type File struct {
 	ID          int32
 	Url         string
@@ -452,18 +709,54 @@ type File struct {
 	CreatedAt   pgtype.Timestamptz
 }
 
+type FollowImport struct {
+	ID                    int32
+	Uid                   uuid.UUID
+	UserUid               uuid.UUID
+	FileUrl               string
+	Status                FollowImportStatus
+	TotalCount            int32
+	ProcessedCount        int32
+	FollowedCount         int32
+	AlreadyFollowingCount int32
+	NotFoundCount         int32
+	SkippedCount          int32
+	Error                 string
+	ParsedAt              pgtype.Timestamptz
+	CreatedAt             pgtype.Timestamptz
+	FinishedAt            pgtype.Timestamptz
+}
+
+type FollowImportEntry struct {
+	ImportUid uuid.UUID
+	Position  int32
+	Uid       uuid.NullUUID
+	Nickname  string
+}
+
 type InboxMessage struct {
-	ID          int32
-	Uid         uuid.UUID
-	ReceiverUid uuid.UUID
-	Type        MessageType
-	IsRead      bool
-	ActorUid    uuid.UUID
-	CreatedAt   pgtype.Timestamptz
-	Status      MessageStatus
-	CommentUid  uuid.NullUUID
-	PostUid     uuid.NullUUID
-	ParentUid   uuid.NullUUID
+	ID              int32
+	Uid             uuid.UUID
+	ReceiverUid     uuid.UUID
+	Type            MessageType
+	IsRead          bool
+	ActorUid        uuid.UUID
+	CreatedAt       pgtype.Timestamptz
+	Status          MessageStatus
+	CommentUid      uuid.NullUUID
+	PostUid         uuid.NullUUID
+	ParentUid       uuid.NullUUID
+	FollowImportUid uuid.NullUUID
+}
+
+type LinkPreview struct {
+	Url         string
+	Status      LinkPreviewStatus
+	Title       string
+	Description string
+	SiteName    string
+	ImageUrl    string
+	FetchedAt   pgtype.Timestamptz
 }
 
 type Post struct {
@@ -483,6 +776,14 @@ type Post struct {
 	Status          PostStatus
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
+	HotScore        float64
+	ContentWarning  string
+	SensitiveMedia  bool
+	LinkUrl         string
+	ViewCount       int64
+	IpRegion        string
+	ArchivedAt      pgtype.Timestamptz
+	CommentPolicy   PostCommentPolicy
 }
 
 type PostCollection struct {
@@ -507,6 +808,20 @@ type PostComment struct {
 	Status           CommentStatus
 	CreatedAt        pgtype.Timestamptz
 	UpdatedAt        pgtype.Timestamptz
+	IpRegion         string
+	ArchivedAt       pgtype.Timestamptz
+	Edited           bool
+	BestScore        float64
+	PinnedAt         pgtype.Timestamptz
+	ArchivedBy       uuid.NullUUID
+	Attachments      []string
+}
+
+type PostFile struct {
+	PostUid   uuid.UUID
+	FileID    int32
+	Kind      PostFileKind
+	CreatedAt pgtype.Timestamptz
 }
 
 type PostLike struct {
@@ -515,6 +830,13 @@ type PostLike struct {
 	CreatedAt pgtype.Timestamptz
 }
 
+type PostReaction struct {
+	PostUid   uuid.UUID
+	UserUid   uuid.UUID
+	Emoji     string
+	CreatedAt pgtype.Timestamptz
+}
+
 type PostTag struct {
 	PostID int32
 	TagID  int32
@@ -541,25 +863,49 @@ type Report struct {
 }
 
 type Tag struct {
-	ID   int32
-	Name string
+	ID            int32
+	Name          string
+	PostCount     int32
+	FollowerCount int32
+	Banned        bool
+}
+
+type TagActivityBucket struct {
+	TagID           int32
+	BucketStart     pgtype.Timestamptz
+	PostCount       int32
+	EngagementCount int32
+}
+
+type TagAlias struct {
+	Alias     string
+	TagID     int32
+	CreatedAt pgtype.Timestamptz
+}
+
+type TagFollow struct {
+	UserUid   uuid.UUID
+	TagID     int32
+	CreatedAt pgtype.Timestamptz
 }
 
 type User struct {
-	ID             int32
-	Uid            uuid.UUID
-	Username       string
-	Role           UserRole
-	Email          string
-	Nickname       string
-	PasswordHash   string
-	AvatarUrl      string
-	FollowersCount int32
-	FollowingCount int32
-	Description    string
-	Status         UserStatus
-	CreatedAt      pgtype.Timestamptz
-	UpdatedAt      pgtype.Timestamptz
+	ID                       int32
+	Uid                      uuid.UUID
+	Username                 string
+	Role                     UserRole
+	Email                    string
+	Nickname                 string
+	PasswordHash             string
+	AvatarUrl                string
+	FollowersCount           int32
+	FollowingCount           int32
+	Description              string
+	Status                   UserStatus
+	CreatedAt                pgtype.Timestamptz
+	UpdatedAt                pgtype.Timestamptz
+	SensitiveMediaPreference SensitiveMediaPreference
+	HideFollowLists          bool
 }
 
 type UserFollow struct {
@@ -567,3 +913,18 @@ type UserFollow struct {
 	FolloweeUid uuid.UUID
 	CreatedAt   pgtype.Timestamptz
 }
+
+type UserFollowSuggestion struct {
+	UserUid        uuid.UUID
+	SuggestedUid   uuid.UUID
+	Score          float64
+	MutualCount    int32
+	SharedTagCount int32
+	ComputedAt     pgtype.Timestamptz
+}
+
+type UserFollowSuggestionDismissal struct {
+	UserUid      uuid.UUID
+	SuggestedUid uuid.UUID
+	CreatedAt    pgtype.Timestamptz
+}
@@ -15,6 +15,7 @@ import (
 const archivePostByUidAndAuthor = `-- name: ArchivePostByUidAndAuthor :execrows
 UPDATE posts
 SET status = 'ARCHIVED'::post_status,
+  archived_at = now(),
   updated_at = now()
 WHERE uid = $1
   AND author = $2
@@ -43,7 +44,12 @@ INSERT INTO posts (
     attachments,
     visibility,
     pinned,
-    ip
+    ip,
+    ip_region,
+    content_warning,
+    sensitive_media,
+    comment_policy,
+    link_url
   )
 VALUES (
     $1,
@@ -56,21 +62,34 @@ VALUES (
       'PUBLIC'::post_visibility
     ),
     $7,
-    $8
+    $8,
+    $9,
+    $10,
+    $11,
+    COALESCE(
+      $12::post_comment_policy,
+      'EVERYONE'::post_comment_policy
+    ),
+    $13
   )
 RETURNING id,
   uid
 `
 
 type CreatePostParams struct {
-	Uid         uuid.UUID
-	Author      uuid.UUID
-	Text        string
-	Images      []string
-	Attachments []string
-	Visibility  NullPostVisibility
-	Pinned      bool
-	Ip          string
+	Uid            uuid.UUID
+	Author         uuid.UUID
+	Text           string
+	Images         []string
+	Attachments    []string
+	Visibility     NullPostVisibility
+	Pinned         bool
+	Ip             string
+	IpRegion       string
+	ContentWarning string
+	SensitiveMedia bool
+	CommentPolicy  NullPostCommentPolicy
+	LinkUrl        string
 }
 
 type CreatePostRow struct {
@@ -88,6 +107,11 @@ func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (CreateP
 		arg.Visibility,
 		arg.Pinned,
 		arg.Ip,
+		arg.IpRegion,
+		arg.ContentWarning,
+		arg.SensitiveMedia,
+		arg.CommentPolicy,
+		arg.LinkUrl,
 	)
 	var i CreatePostRow
 	err := row.Scan(&i.ID, &i.Uid)
@@ -105,6 +129,12 @@ WHERE pt.post_id = $1
     ) i ON i.name = t.name
     WHERE t.id = pt.tag_id
   )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM tags b
+    WHERE b.id = pt.tag_id
+      AND b.banned
+  )
 `
 
 type DeletePostTagsNotInNamesParams struct {
@@ -117,6 +147,31 @@ func (q *Queries) DeletePostTagsNotInNames(ctx context.Context, arg DeletePostTa
 	return err
 }
 
+const deletePostsByUids = `-- name: DeletePostsByUids :exec
+DELETE FROM posts
+WHERE uid = ANY($1::uuid [])
+`
+
+func (q *Queries) DeletePostsByUids(ctx context.Context, uids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deletePostsByUids, uids)
+	return err
+}
+
+const getPostAuthorByUid = `-- name: GetPostAuthorByUid :one
+SELECT author
+FROM posts
+WHERE uid = $1
+  AND status = 'NORMAL'::post_status
+LIMIT 1
+`
+
+func (q *Queries) GetPostAuthorByUid(ctx context.Context, uid uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getPostAuthorByUid, uid)
+	var author uuid.UUID
+	err := row.Scan(&author)
+	return author, err
+}
+
 const getPostByUid = `-- name: GetPostByUid :one
 SELECT p.uid,
   p.author,
@@ -129,11 +184,18 @@ SELECT p.uid,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.view_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
   p.ip,
+  p.ip_region,
   p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
   p.created_at,
   p.updated_at,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
@@ -147,6 +209,7 @@ SELECT p.uid,
         )
       FROM post_tags pt
         JOIN tags t ON t.id = pt.tag_id
+          AND NOT t.banned
       WHERE pt.post_id = p.id
     ),
     '{}'::text []
@@ -182,11 +245,18 @@ type GetPostByUidRow struct {
 	CommentCount    int32
 	CollectionCount int32
 	LikeCount       int32
+	ViewCount       int64
 	Pinned          bool
 	Visibility      PostVisibility
 	LatestRepliedOn pgtype.Timestamptz
 	Ip              string
+	IpRegion        string
 	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
 	Liked           bool
@@ -210,11 +280,18 @@ func (q *Queries) GetPostByUid(ctx context.Context, arg GetPostByUidParams) (Get
 		&i.CommentCount,
 		&i.CollectionCount,
 		&i.LikeCount,
+		&i.ViewCount,
 		&i.Pinned,
 		&i.Visibility,
 		&i.LatestRepliedOn,
 		&i.Ip,
+		&i.IpRegion,
 		&i.Status,
+		&i.ContentWarning,
+		&i.SensitiveMedia,
+		&i.CommentPolicy,
+		&i.LinkUrl,
+		&i.HotScore,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Liked,
@@ -298,6 +375,73 @@ func (q *Queries) GetPostSearchExtrasByUids(ctx context.Context, arg GetPostSear
 	return items, nil
 }
 
+const getPostVisibilityByUid = `-- name: GetPostVisibilityByUid :one
+SELECT author,
+  visibility,
+  comment_policy
+FROM posts
+WHERE uid = $1
+  AND status = 'NORMAL'::post_status
+LIMIT 1
+`
+
+type GetPostVisibilityByUidRow struct {
+	Author        uuid.UUID
+	Visibility    PostVisibility
+	CommentPolicy PostCommentPolicy
+}
+
+func (q *Queries) GetPostVisibilityByUid(ctx context.Context, uid uuid.UUID) (GetPostVisibilityByUidRow, error) {
+	row := q.db.QueryRow(ctx, getPostVisibilityByUid, uid)
+	var i GetPostVisibilityByUidRow
+	err := row.Scan(&i.Author, &i.Visibility, &i.CommentPolicy)
+	return i, err
+}
+
+const incrementPostViewCounts = `-- name: IncrementPostViewCounts :many
+WITH input AS (
+  SELECT unnest($1::uuid []) AS uid,
+    unnest($2::bigint []) AS count
+)
+UPDATE posts p
+SET view_count = p.view_count + i.count
+FROM input i
+WHERE p.uid = i.uid
+  AND p.status = 'NORMAL'::post_status
+RETURNING p.uid,
+  p.view_count
+`
+
+type IncrementPostViewCountsParams struct {
+	Uids   []uuid.UUID
+	Counts []int64
+}
+
+type IncrementPostViewCountsRow struct {
+	Uid       uuid.UUID
+	ViewCount int64
+}
+
+func (q *Queries) IncrementPostViewCounts(ctx context.Context, arg IncrementPostViewCountsParams) ([]IncrementPostViewCountsRow, error) {
+	rows, err := q.db.Query(ctx, incrementPostViewCounts, arg.Uids, arg.Counts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IncrementPostViewCountsRow
+	for rows.Next() {
+		var i IncrementPostViewCountsRow
+		if err := rows.Scan(&i.Uid, &i.ViewCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertPostTagsByNames = `-- name: InsertPostTagsByNames :exec
 WITH input AS (
   SELECT DISTINCT unnest($2::text[]) AS name
@@ -334,6 +478,400 @@ func (q *Queries) InsertTagsIfNotExists(ctx context.Context, tags []string) erro
 	return err
 }
 
+const listExpiredTrashedPosts = `-- name: ListExpiredTrashedPosts :many
+SELECT uid,
+  images,
+  attachments
+FROM posts
+WHERE status = 'ARCHIVED'::post_status
+  AND archived_at <= $1::timestamptz
+ORDER BY archived_at
+LIMIT $2
+`
+
+type ListExpiredTrashedPostsParams struct {
+	ArchivedBefore pgtype.Timestamptz
+	BatchSize      int32
+}
+
+type ListExpiredTrashedPostsRow struct {
+	Uid         uuid.UUID
+	Images      []string
+	Attachments []string
+}
+
+func (q *Queries) ListExpiredTrashedPosts(ctx context.Context, arg ListExpiredTrashedPostsParams) ([]ListExpiredTrashedPostsRow, error) {
+	rows, err := q.db.Query(ctx, listExpiredTrashedPosts, arg.ArchivedBefore, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExpiredTrashedPostsRow
+	for rows.Next() {
+		var i ListExpiredTrashedPostsRow
+		if err := rows.Scan(&i.Uid, &i.Images, &i.Attachments); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostCollectors = `-- name: ListPostCollectors :many
+SELECT pc.created_at AS collected_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (myf.follower_uid IS NOT NULL)::boolean AS following
+FROM post_collections pc
+  JOIN users u ON u.uid = pc.user_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows myf ON myf.follower_uid = $1::uuid
+  AND myf.followee_uid = pc.user_uid
+WHERE pc.post_uid = $2
+  AND (
+    (
+      $3::timestamptz IS NULL
+      AND $4::uuid IS NULL
+    )
+    OR (pc.created_at, pc.user_uid) < (
+      $3::timestamptz,
+      $4::uuid
+    )
+  )
+ORDER BY pc.created_at DESC,
+  pc.user_uid DESC
+LIMIT 20
+`
+
+type ListPostCollectorsParams struct {
+	Viewer          uuid.NullUUID
+	PostUid         uuid.UUID
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.NullUUID
+}
+
+type ListPostCollectorsRow struct {
+	CollectedAt    pgtype.Timestamptz
+	Uid            uuid.UUID
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	Following      bool
+}
+
+func (q *Queries) ListPostCollectors(ctx context.Context, arg ListPostCollectorsParams) ([]ListPostCollectorsRow, error) {
+	rows, err := q.db.Query(ctx, listPostCollectors,
+		arg.Viewer,
+		arg.PostUid,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostCollectorsRow
+	for rows.Next() {
+		var i ListPostCollectorsRow
+		if err := rows.Scan(
+			&i.CollectedAt,
+			&i.Uid,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.Following,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostLikers = `-- name: ListPostLikers :many
+SELECT pl.created_at AS liked_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (myf.follower_uid IS NOT NULL)::boolean AS following
+FROM post_likes pl
+  JOIN users u ON u.uid = pl.user_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows myf ON myf.follower_uid = $1::uuid
+  AND myf.followee_uid = pl.user_uid
+WHERE pl.post_uid = $2
+  AND (
+    (
+      $3::timestamptz IS NULL
+      AND $4::uuid IS NULL
+    )
+    OR (pl.created_at, pl.user_uid) < (
+      $3::timestamptz,
+      $4::uuid
+    )
+  )
+ORDER BY pl.created_at DESC,
+  pl.user_uid DESC
+LIMIT 20
+`
+
+type ListPostLikersParams struct {
+	Viewer          uuid.NullUUID
+	PostUid         uuid.UUID
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.NullUUID
+}
+
+type ListPostLikersRow struct {
+	LikedAt        pgtype.Timestamptz
+	Uid            uuid.UUID
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	Following      bool
+}
+
+func (q *Queries) ListPostLikers(ctx context.Context, arg ListPostLikersParams) ([]ListPostLikersRow, error) {
+	rows, err := q.db.Query(ctx, listPostLikers,
+		arg.Viewer,
+		arg.PostUid,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostLikersRow
+	for rows.Next() {
+		var i ListPostLikersRow
+		if err := rows.Scan(
+			&i.LikedAt,
+			&i.Uid,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.Following,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrashedPostsByAuthor = `-- name: ListTrashedPostsByAuthor :many
+SELECT p.uid,
+  p.text,
+  p.images,
+  p.created_at,
+  p.archived_at,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+          AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM posts p
+WHERE p.author = $1
+  AND p.status = 'ARCHIVED'::post_status
+  AND p.archived_at > $2::timestamptz
+  AND (
+    (
+      $3::timestamptz IS NULL
+      AND $4::uuid IS NULL
+    )
+    OR (p.archived_at, p.uid) < (
+      $3::timestamptz,
+      $4::uuid
+    )
+  )
+ORDER BY p.archived_at DESC,
+  p.uid DESC
+LIMIT 20
+`
+
+type ListTrashedPostsByAuthorParams struct {
+	Author           uuid.UUID
+	ArchivedAfter    pgtype.Timestamptz
+	CursorArchivedAt pgtype.Timestamptz
+	CursorID         uuid.NullUUID
+}
+
+type ListTrashedPostsByAuthorRow struct {
+	Uid        uuid.UUID
+	Text       string
+	Images     []string
+	CreatedAt  pgtype.Timestamptz
+	ArchivedAt pgtype.Timestamptz
+	TagNames   []string
+}
+
+func (q *Queries) ListTrashedPostsByAuthor(ctx context.Context, arg ListTrashedPostsByAuthorParams) ([]ListTrashedPostsByAuthorRow, error) {
+	rows, err := q.db.Query(ctx, listTrashedPostsByAuthor,
+		arg.Author,
+		arg.ArchivedAfter,
+		arg.CursorArchivedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTrashedPostsByAuthorRow
+	for rows.Next() {
+		var i ListTrashedPostsByAuthorRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Text,
+			&i.Images,
+			&i.CreatedAt,
+			&i.ArchivedAt,
+			&i.TagNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockPostByUid = `-- name: LockPostByUid :one
+SELECT author
+FROM posts
+WHERE uid = $1
+  AND status = 'NORMAL'::post_status
+LIMIT 1 FOR UPDATE
+`
+
+// Serializes comment pinning on a post.
+func (q *Queries) LockPostByUid(ctx context.Context, uid uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, lockPostByUid, uid)
+	var author uuid.UUID
+	err := row.Scan(&author)
+	return author, err
+}
+
+const refreshPostHotScores = `-- name: RefreshPostHotScores :many
+WITH scored AS (
+  SELECT p.uid,
+    CASE
+      WHEN p.created_at > now() - make_interval(hours => $1::int) THEN (
+        p.like_count + 2 * p.comment_count + 3 * p.collection_count
+      )::float8 / power(
+        extract(
+          epoch
+          FROM now() - p.created_at
+        )::float8 / 3600 + 2,
+        $2::float8
+      )
+      ELSE 0
+    END AS score
+  FROM posts p
+  WHERE p.status = 'NORMAL'::post_status
+    AND (
+      p.created_at > now() - make_interval(hours => $1::int)
+      OR p.hot_score <> 0
+    )
+)
+UPDATE posts p
+SET hot_score = s.score
+FROM scored s
+WHERE p.uid = s.uid
+  AND p.hot_score <> s.score
+RETURNING p.uid,
+  p.hot_score
+`
+
+type RefreshPostHotScoresParams struct {
+	WindowHours int32
+	Gravity     float64
+}
+
+type RefreshPostHotScoresRow struct {
+	Uid      uuid.UUID
+	HotScore float64
+}
+
+func (q *Queries) RefreshPostHotScores(ctx context.Context, arg RefreshPostHotScoresParams) ([]RefreshPostHotScoresRow, error) {
+	rows, err := q.db.Query(ctx, refreshPostHotScores, arg.WindowHours, arg.Gravity)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RefreshPostHotScoresRow
+	for rows.Next() {
+		var i RefreshPostHotScoresRow
+		if err := rows.Scan(&i.Uid, &i.HotScore); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restorePostByUidAndAuthor = `-- name: RestorePostByUidAndAuthor :execrows
+UPDATE posts
+SET status = 'NORMAL'::post_status,
+  archived_at = NULL,
+  updated_at = now()
+WHERE uid = $1
+  AND author = $2
+  AND status = 'ARCHIVED'::post_status
+  AND archived_at > $3::timestamptz
+`
+
+type RestorePostByUidAndAuthorParams struct {
+	Uid           uuid.UUID
+	Author        uuid.UUID
+	ArchivedAfter pgtype.Timestamptz
+}
+
+func (q *Queries) RestorePostByUidAndAuthor(ctx context.Context, arg RestorePostByUidAndAuthorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restorePostByUidAndAuthor, arg.Uid, arg.Author, arg.ArchivedAfter)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updatePostByUidAndAuthor = `-- name: UpdatePostByUidAndAuthor :one
 UPDATE posts
 SET text = COALESCE($1, text),
@@ -344,21 +882,35 @@ SET text = COALESCE($1, text),
     visibility
   ),
   pinned = COALESCE($5::boolean, pinned),
+  content_warning = COALESCE($6, content_warning),
+  sensitive_media = COALESCE(
+    $7::boolean,
+    sensitive_media
+  ),
+  comment_policy = COALESCE(
+    $8::post_comment_policy,
+    comment_policy
+  ),
+  link_url = COALESCE($9, link_url),
   updated_at = now()
-WHERE uid = $6
-  AND author = $7
+WHERE uid = $10
+  AND author = $11
   AND status = 'NORMAL'::post_status
 RETURNING id
 `
 
 type UpdatePostByUidAndAuthorParams struct {
-	Text        pgtype.Text
-	Images      []string
-	Attachments []string
-	Visibility  NullPostVisibility
-	Pinned      pgtype.Bool
-	Uid         uuid.UUID
-	Author      uuid.UUID
+	Text           pgtype.Text
+	Images         []string
+	Attachments    []string
+	Visibility     NullPostVisibility
+	Pinned         pgtype.Bool
+	ContentWarning pgtype.Text
+	SensitiveMedia pgtype.Bool
+	CommentPolicy  NullPostCommentPolicy
+	LinkUrl        pgtype.Text
+	Uid            uuid.UUID
+	Author         uuid.UUID
 }
 
 func (q *Queries) UpdatePostByUidAndAuthor(ctx context.Context, arg UpdatePostByUidAndAuthorParams) (int32, error) {
@@ -368,6 +920,10 @@ func (q *Queries) UpdatePostByUidAndAuthor(ctx context.Context, arg UpdatePostBy
 		arg.Attachments,
 		arg.Visibility,
 		arg.Pinned,
+		arg.ContentWarning,
+		arg.SensitiveMedia,
+		arg.CommentPolicy,
+		arg.LinkUrl,
 		arg.Uid,
 		arg.Author,
 	)
@@ -93,6 +93,170 @@ func (q *Queries) InsertPostCollectionEdge(ctx context.Context, arg InsertPostCo
 	return result.RowsAffected(), nil
 }
 
+const listPostsByCollectionFolder = `-- name: ListPostsByCollectionFolder :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.created_at,
+  p.updated_at,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+          AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM collection_folder_items c
+  JOIN posts p ON p.uid = c.post_uid
+  JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = $1::uuid
+  LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = $1::uuid
+  LEFT JOIN user_follows uf ON uf.follower_uid = $1::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND c.folder_uid = $2
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = $1::uuid
+  )
+  AND (
+    (
+      $3::timestamptz IS NULL
+      AND $4::uuid IS NULL
+    )
+    OR (p.created_at, p.uid) < (
+      $3::timestamptz,
+      $4::uuid
+    )
+  )
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT 20
+`
+
+type ListPostsByCollectionFolderParams struct {
+	Viewer          uuid.NullUUID
+	FolderUid       uuid.UUID
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.NullUUID
+}
+
+type ListPostsByCollectionFolderRow struct {
+	Uid             uuid.UUID
+	Author          uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	ViewCount       int64
+	Pinned          bool
+	Visibility      PostVisibility
+	LatestRepliedOn pgtype.Timestamptz
+	Ip              string
+	IpRegion        string
+	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	Collected       bool
+	Liked           bool
+	Following       bool
+	TagNames        []string
+}
+
+func (q *Queries) ListPostsByCollectionFolder(ctx context.Context, arg ListPostsByCollectionFolderParams) ([]ListPostsByCollectionFolderRow, error) {
+	rows, err := q.db.Query(ctx, listPostsByCollectionFolder,
+		arg.Viewer,
+		arg.FolderUid,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostsByCollectionFolderRow
+	for rows.Next() {
+		var i ListPostsByCollectionFolderRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Images,
+			&i.Attachments,
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.ViewCount,
+			&i.Pinned,
+			&i.Visibility,
+			&i.LatestRepliedOn,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Collected,
+			&i.Liked,
+			&i.Following,
+			&i.TagNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPostsByCollector = `-- name: ListPostsByCollector :many
 SELECT p.uid,
   p.author,
@@ -105,11 +269,17 @@ SELECT p.uid,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.view_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
   p.ip,
+  p.ip_region,
   p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
   p.created_at,
   p.updated_at,
   true AS collected,
@@ -123,6 +293,7 @@ SELECT p.uid,
         )
       FROM post_tags pt
         JOIN tags t ON t.id = pt.tag_id
+          AND NOT t.banned
       WHERE pt.post_id = p.id
     ),
     '{}'::text []
@@ -174,11 +345,17 @@ type ListPostsByCollectorRow struct {
 	CommentCount    int32
 	CollectionCount int32
 	LikeCount       int32
+	ViewCount       int64
 	Pinned          bool
 	Visibility      PostVisibility
 	LatestRepliedOn pgtype.Timestamptz
 	Ip              string
+	IpRegion        string
 	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
 	Collected       bool
@@ -208,11 +385,17 @@ func (q *Queries) ListPostsByCollector(ctx context.Context, arg ListPostsByColle
 			&i.CommentCount,
 			&i.CollectionCount,
 			&i.LikeCount,
+			&i.ViewCount,
 			&i.Pinned,
 			&i.Visibility,
 			&i.LatestRepliedOn,
 			&i.Ip,
+			&i.IpRegion,
 			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Collected,
@@ -2,17 +2,27 @@ package db
 
 import (
 	"context"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const (
+	ListPostsSortLatest = "latest"
+	ListPostsSortActive = "active"
+	ListPostsSortHot    = "hot"
+)
+
 type ListPostsParams struct {
 	Viewer          uuid.NullUUID
 	AuthorUid       uuid.NullUUID
 	TagName         pgtype.Text
+	Sort            string // "" or "latest", "active", "hot"
 	CursorCreatedAt pgtype.Timestamptz
+	CursorRepliedOn pgtype.Timestamptz
+	CursorHotScore  pgtype.Float8
 	CursorID        uuid.NullUUID
 }
 
@@ -28,11 +38,18 @@ type ListPostsRow struct {
 	CommentCount    int32
 	CollectionCount int32
 	LikeCount       int32
+	ViewCount       int64
 	Pinned          bool
 	Visibility      PostVisibility
 	LatestRepliedOn pgtype.Timestamptz
 	Ip              string
+	IpRegion        string
 	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
 	Liked           bool
@@ -45,42 +62,59 @@ func (q *Queries) ListPosts(ctx context.Context, arg ListPostsParams) ([]ListPos
 	var (
 		listPosts string
 		params    []interface{}
+		queries   [3]string
+		cursor    interface{}
 	)
-	cursorCreatedAt := arg.CursorCreatedAt
 	cursorID := arg.CursorID
-	if !cursorCreatedAt.Valid || !cursorID.Valid {
-		cursorCreatedAt = pgtype.Timestamptz{
-			Time:  time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
-			Valid: true,
+	switch arg.Sort {
+	case ListPostsSortActive:
+		queries = [3]string{listPostsByAuthorActive, listPostsByTagActive, listPostsPublicActive}
+		cursorRepliedOn := arg.CursorRepliedOn
+		if !cursorRepliedOn.Valid || !cursorID.Valid {
+			cursorRepliedOn = maxCursorTime()
+			cursorID = maxCursorID()
 		}
-		cursorID = uuid.NullUUID{
-			UUID:  uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff"),
-			Valid: true,
+		cursor = cursorRepliedOn
+	case ListPostsSortHot:
+		queries = [3]string{listPostsByAuthorHot, listPostsByTagHot, listPostsPublicHot}
+		cursorHotScore := arg.CursorHotScore
+		if !cursorHotScore.Valid || !cursorID.Valid {
+			cursorHotScore = pgtype.Float8{Float64: math.MaxFloat64, Valid: true}
+			cursorID = maxCursorID()
 		}
+		cursor = cursorHotScore
+	default:
+		queries = [3]string{listPostsByAuthor, listPostsByTag, listPostsPublic}
+		cursorCreatedAt := arg.CursorCreatedAt
+		if !cursorCreatedAt.Valid || !cursorID.Valid {
+			cursorCreatedAt = maxCursorTime()
+			cursorID = maxCursorID()
+		}
+		cursor = cursorCreatedAt
 	}
 
 	switch {
 	case arg.AuthorUid.Valid:
-		listPosts = listPostsByAuthor
+		listPosts = queries[0]
 		params = []interface{}{
 			arg.Viewer,
 			arg.AuthorUid.UUID,
-			cursorCreatedAt,
+			cursor,
 			cursorID.UUID,
 		}
 	case arg.TagName.Valid:
-		listPosts = listPostsByTag
+		listPosts = queries[1]
 		params = []interface{}{
 			arg.Viewer,
 			arg.TagName.String,
-			cursorCreatedAt,
+			cursor,
 			cursorID.UUID,
 		}
 	default:
-		listPosts = listPostsPublic
+		listPosts = queries[2]
 		params = []interface{}{
 			arg.Viewer,
-			cursorCreatedAt,
+			cursor,
 			cursorID.UUID,
 		}
 	}
@@ -105,11 +139,18 @@ func (q *Queries) ListPosts(ctx context.Context, arg ListPostsParams) ([]ListPos
 			&i.CommentCount,
 			&i.CollectionCount,
 			&i.LikeCount,
+			&i.ViewCount,
 			&i.Pinned,
 			&i.Visibility,
 			&i.LatestRepliedOn,
 			&i.Ip,
+			&i.IpRegion,
 			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.HotScore,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Liked,
@@ -126,3 +167,17 @@ func (q *Queries) ListPosts(ctx context.Context, arg ListPostsParams) ([]ListPos
 	}
 	return items, nil
 }
+
+func maxCursorTime() pgtype.Timestamptz {
+	return pgtype.Timestamptz{
+		Time:  time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+		Valid: true,
+	}
+}
+
+func maxCursorID() uuid.NullUUID {
+	return uuid.NullUUID{
+		UUID:  uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff"),
+		Valid: true,
+	}
+}
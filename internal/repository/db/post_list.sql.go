@@ -12,6 +12,189 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const listFeedPosts = `-- name: ListFeedPosts :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = $1
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = $1
+LEFT JOIN user_follows uf ON uf.follower_uid = $1
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = $1
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = $1
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = $1
+  )
+  AND (
+    p.author = $1
+    OR EXISTS (
+      SELECT 1
+      FROM user_follows f
+      WHERE f.follower_uid = $1
+        AND f.followee_uid = p.author
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM post_tags pt
+      JOIN tag_follows tf ON tf.tag_id = pt.tag_id
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+        AND tf.user_uid = $1
+    )
+  )
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (p.created_at, p.uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY p.created_at DESC, p.uid DESC
+LIMIT 20
+`
+
+type ListFeedPostsParams struct {
+	ViewerUid       uuid.UUID
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.NullUUID
+}
+
+type ListFeedPostsRow struct {
+	Uid             uuid.UUID
+	Author          uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	ViewCount       int64
+	Pinned          bool
+	Visibility      PostVisibility
+	LatestRepliedOn pgtype.Timestamptz
+	Ip              string
+	IpRegion        string
+	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	Liked           bool
+	Collected       bool
+	Following       bool
+	TagNames        []string
+}
+
+func (q *Queries) ListFeedPosts(ctx context.Context, arg ListFeedPostsParams) ([]ListFeedPostsRow, error) {
+	rows, err := q.db.Query(ctx, listFeedPosts, arg.ViewerUid, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFeedPostsRow
+	for rows.Next() {
+		var i ListFeedPostsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Images,
+			&i.Attachments,
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.ViewCount,
+			&i.Pinned,
+			&i.Visibility,
+			&i.LatestRepliedOn,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.HotScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Liked,
+			&i.Collected,
+			&i.Following,
+			&i.TagNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPostsByAuthor = `-- name: ListPostsByAuthor :many
 SELECT p.uid,
   p.author,
@@ -24,11 +207,18 @@ SELECT p.uid,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.view_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
   p.ip,
+  p.ip_region,
   p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
   p.created_at,
   p.updated_at,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
@@ -39,6 +229,7 @@ SELECT p.uid,
       SELECT array_agg(t.name ORDER BY t.name)
       FROM post_tags pt
       JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
       WHERE pt.post_id = p.id
     ),
     '{}'::text[]
@@ -53,6 +244,16 @@ LEFT JOIN post_collections pc ON pc.post_uid = p.uid
 LEFT JOIN user_follows uf ON uf.follower_uid = $1::uuid
   AND uf.followee_uid = p.author
 WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = $1::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = $1::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
   AND p.author = $2
   AND (
     p.visibility = 'PUBLIC'::post_visibility
@@ -85,11 +286,18 @@ type ListPostsByAuthorRow struct {
 	CommentCount    int32
 	CollectionCount int32
 	LikeCount       int32
+	ViewCount       int64
 	Pinned          bool
 	Visibility      PostVisibility
 	LatestRepliedOn pgtype.Timestamptz
 	Ip              string
+	IpRegion        string
 	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
 	Liked           bool
@@ -124,11 +332,18 @@ func (q *Queries) ListPostsByAuthor(ctx context.Context, arg ListPostsByAuthorPa
 			&i.CommentCount,
 			&i.CollectionCount,
 			&i.LikeCount,
+			&i.ViewCount,
 			&i.Pinned,
 			&i.Visibility,
 			&i.LatestRepliedOn,
 			&i.Ip,
+			&i.IpRegion,
 			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.HotScore,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Liked,
@@ -146,7 +361,7 @@ func (q *Queries) ListPostsByAuthor(ctx context.Context, arg ListPostsByAuthorPa
 	return items, nil
 }
 
-const listPostsByTag = `-- name: ListPostsByTag :many
+const listPostsByAuthorActive = `-- name: ListPostsByAuthorActive :many
 SELECT p.uid,
   p.author,
   u.uid AS author_uid,
@@ -158,11 +373,18 @@ SELECT p.uid,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.view_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
   p.ip,
+  p.ip_region,
   p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
   p.created_at,
   p.updated_at,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
@@ -173,6 +395,7 @@ SELECT p.uid,
       SELECT array_agg(t.name ORDER BY t.name)
       FROM post_tags pt
       JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
       WHERE pt.post_id = p.id
     ),
     '{}'::text[]
@@ -188,32 +411,36 @@ LEFT JOIN user_follows uf ON uf.follower_uid = $1::uuid
   AND uf.followee_uid = p.author
 WHERE p.status = 'NORMAL'::post_status
   AND (
-    p.visibility = 'PUBLIC'::post_visibility
+    NOT p.sensitive_media
     OR p.author = $1::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = $1::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
   )
-  AND EXISTS (
-    SELECT 1
-    FROM post_tags pt
-    JOIN tags t ON t.id = pt.tag_id
-    WHERE pt.post_id = p.id
-      AND t.name = $2
+  AND p.author = $2
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = $1::uuid
   )
-  AND (p.created_at, p.uid) < (
+  AND (p.latest_replied_on, p.uid) < (
     $3::timestamptz,
     $4::uuid
   )
-ORDER BY p.created_at DESC, p.uid DESC
+ORDER BY p.latest_replied_on DESC, p.uid DESC
 LIMIT 20
 `
 
-type ListPostsByTagParams struct {
+type ListPostsByAuthorActiveParams struct {
 	Viewer          uuid.NullUUID
-	TagName         string
-	CursorCreatedAt pgtype.Timestamptz
+	AuthorUid       uuid.UUID
+	CursorRepliedOn pgtype.Timestamptz
 	CursorID        uuid.UUID
 }
 
-type ListPostsByTagRow struct {
+type ListPostsByAuthorActiveRow struct {
 	Uid             uuid.UUID
 	Author          uuid.UUID
 	AuthorUid       uuid.UUID
@@ -225,11 +452,18 @@ type ListPostsByTagRow struct {
 	CommentCount    int32
 	CollectionCount int32
 	LikeCount       int32
+	ViewCount       int64
 	Pinned          bool
 	Visibility      PostVisibility
 	LatestRepliedOn pgtype.Timestamptz
 	Ip              string
+	IpRegion        string
 	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
 	Liked           bool
@@ -238,20 +472,20 @@ type ListPostsByTagRow struct {
 	TagNames        []string
 }
 
-func (q *Queries) ListPostsByTag(ctx context.Context, arg ListPostsByTagParams) ([]ListPostsByTagRow, error) {
-	rows, err := q.db.Query(ctx, listPostsByTag,
+func (q *Queries) ListPostsByAuthorActive(ctx context.Context, arg ListPostsByAuthorActiveParams) ([]ListPostsByAuthorActiveRow, error) {
+	rows, err := q.db.Query(ctx, listPostsByAuthorActive,
 		arg.Viewer,
-		arg.TagName,
-		arg.CursorCreatedAt,
+		arg.AuthorUid,
+		arg.CursorRepliedOn,
 		arg.CursorID,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListPostsByTagRow
+	var items []ListPostsByAuthorActiveRow
 	for rows.Next() {
-		var i ListPostsByTagRow
+		var i ListPostsByAuthorActiveRow
 		if err := rows.Scan(
 			&i.Uid,
 			&i.Author,
@@ -264,11 +498,18 @@ func (q *Queries) ListPostsByTag(ctx context.Context, arg ListPostsByTagParams)
 			&i.CommentCount,
 			&i.CollectionCount,
 			&i.LikeCount,
+			&i.ViewCount,
 			&i.Pinned,
 			&i.Visibility,
 			&i.LatestRepliedOn,
 			&i.Ip,
+			&i.IpRegion,
 			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.HotScore,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Liked,
@@ -286,7 +527,7 @@ func (q *Queries) ListPostsByTag(ctx context.Context, arg ListPostsByTagParams)
 	return items, nil
 }
 
-const listPostsPublic = `-- name: ListPostsPublic :many
+const listPostsByAuthorHot = `-- name: ListPostsByAuthorHot :many
 SELECT p.uid,
   p.author,
   u.uid AS author_uid,
@@ -298,11 +539,18 @@ SELECT p.uid,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.view_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
   p.ip,
+  p.ip_region,
   p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
   p.created_at,
   p.updated_at,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
@@ -313,6 +561,7 @@ SELECT p.uid,
       SELECT array_agg(t.name ORDER BY t.name)
       FROM post_tags pt
       JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
       WHERE pt.post_id = p.id
     ),
     '{}'::text[]
@@ -327,22 +576,37 @@ LEFT JOIN post_collections pc ON pc.post_uid = p.uid
 LEFT JOIN user_follows uf ON uf.follower_uid = $1::uuid
   AND uf.followee_uid = p.author
 WHERE p.status = 'NORMAL'::post_status
-  AND p.visibility = 'PUBLIC'::post_visibility
-  AND (p.created_at, p.uid) < (
-    $2::timestamptz,
-    $3::uuid
+  AND (
+    NOT p.sensitive_media
+    OR p.author = $1::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = $1::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
   )
-ORDER BY p.created_at DESC, p.uid DESC
+  AND p.author = $2
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = $1::uuid
+  )
+  AND (p.hot_score, p.uid) < (
+    $3::float8,
+    $4::uuid
+  )
+ORDER BY p.hot_score DESC, p.uid DESC
 LIMIT 20
 `
 
-type ListPostsPublicParams struct {
-	Viewer          uuid.NullUUID
-	CursorCreatedAt pgtype.Timestamptz
-	CursorID        uuid.UUID
+type ListPostsByAuthorHotParams struct {
+	Viewer         uuid.NullUUID
+	AuthorUid      uuid.UUID
+	CursorHotScore float64
+	CursorID       uuid.UUID
 }
 
-type ListPostsPublicRow struct {
+type ListPostsByAuthorHotRow struct {
 	Uid             uuid.UUID
 	Author          uuid.UUID
 	AuthorUid       uuid.UUID
@@ -354,11 +618,18 @@ type ListPostsPublicRow struct {
 	CommentCount    int32
 	CollectionCount int32
 	LikeCount       int32
+	ViewCount       int64
 	Pinned          bool
 	Visibility      PostVisibility
 	LatestRepliedOn pgtype.Timestamptz
 	Ip              string
+	IpRegion        string
 	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
 	Liked           bool
@@ -367,15 +638,1007 @@ type ListPostsPublicRow struct {
 	TagNames        []string
 }
 
-func (q *Queries) ListPostsPublic(ctx context.Context, arg ListPostsPublicParams) ([]ListPostsPublicRow, error) {
-	rows, err := q.db.Query(ctx, listPostsPublic, arg.Viewer, arg.CursorCreatedAt, arg.CursorID)
+func (q *Queries) ListPostsByAuthorHot(ctx context.Context, arg ListPostsByAuthorHotParams) ([]ListPostsByAuthorHotRow, error) {
+	rows, err := q.db.Query(ctx, listPostsByAuthorHot,
+		arg.Viewer,
+		arg.AuthorUid,
+		arg.CursorHotScore,
+		arg.CursorID,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListPostsPublicRow
+	var items []ListPostsByAuthorHotRow
 	for rows.Next() {
-		var i ListPostsPublicRow
+		var i ListPostsByAuthorHotRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Images,
+			&i.Attachments,
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.ViewCount,
+			&i.Pinned,
+			&i.Visibility,
+			&i.LatestRepliedOn,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.HotScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Liked,
+			&i.Collected,
+			&i.Following,
+			&i.TagNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsByTag = `-- name: ListPostsByTag :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = $1::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = $1::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = $1::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = $1::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = $1::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = $1::uuid
+  )
+  AND EXISTS (
+    SELECT 1
+    FROM post_tags pt
+    JOIN tags t ON t.id = pt.tag_id
+      AND NOT t.banned
+    WHERE pt.post_id = p.id
+      AND t.name = $2
+  )
+  AND (p.created_at, p.uid) < (
+    $3::timestamptz,
+    $4::uuid
+  )
+ORDER BY p.created_at DESC, p.uid DESC
+LIMIT 20
+`
+
+type ListPostsByTagParams struct {
+	Viewer          uuid.NullUUID
+	TagName         string
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.UUID
+}
+
+type ListPostsByTagRow struct {
+	Uid             uuid.UUID
+	Author          uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	ViewCount       int64
+	Pinned          bool
+	Visibility      PostVisibility
+	LatestRepliedOn pgtype.Timestamptz
+	Ip              string
+	IpRegion        string
+	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	Liked           bool
+	Collected       bool
+	Following       bool
+	TagNames        []string
+}
+
+func (q *Queries) ListPostsByTag(ctx context.Context, arg ListPostsByTagParams) ([]ListPostsByTagRow, error) {
+	rows, err := q.db.Query(ctx, listPostsByTag,
+		arg.Viewer,
+		arg.TagName,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostsByTagRow
+	for rows.Next() {
+		var i ListPostsByTagRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Images,
+			&i.Attachments,
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.ViewCount,
+			&i.Pinned,
+			&i.Visibility,
+			&i.LatestRepliedOn,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.HotScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Liked,
+			&i.Collected,
+			&i.Following,
+			&i.TagNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsByTagActive = `-- name: ListPostsByTagActive :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = $1::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = $1::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = $1::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = $1::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = $1::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = $1::uuid
+  )
+  AND EXISTS (
+    SELECT 1
+    FROM post_tags pt
+    JOIN tags t ON t.id = pt.tag_id
+      AND NOT t.banned
+    WHERE pt.post_id = p.id
+      AND t.name = $2
+  )
+  AND (p.latest_replied_on, p.uid) < (
+    $3::timestamptz,
+    $4::uuid
+  )
+ORDER BY p.latest_replied_on DESC, p.uid DESC
+LIMIT 20
+`
+
+type ListPostsByTagActiveParams struct {
+	Viewer          uuid.NullUUID
+	TagName         string
+	CursorRepliedOn pgtype.Timestamptz
+	CursorID        uuid.UUID
+}
+
+type ListPostsByTagActiveRow struct {
+	Uid             uuid.UUID
+	Author          uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	ViewCount       int64
+	Pinned          bool
+	Visibility      PostVisibility
+	LatestRepliedOn pgtype.Timestamptz
+	Ip              string
+	IpRegion        string
+	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	Liked           bool
+	Collected       bool
+	Following       bool
+	TagNames        []string
+}
+
+func (q *Queries) ListPostsByTagActive(ctx context.Context, arg ListPostsByTagActiveParams) ([]ListPostsByTagActiveRow, error) {
+	rows, err := q.db.Query(ctx, listPostsByTagActive,
+		arg.Viewer,
+		arg.TagName,
+		arg.CursorRepliedOn,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostsByTagActiveRow
+	for rows.Next() {
+		var i ListPostsByTagActiveRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Images,
+			&i.Attachments,
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.ViewCount,
+			&i.Pinned,
+			&i.Visibility,
+			&i.LatestRepliedOn,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.HotScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Liked,
+			&i.Collected,
+			&i.Following,
+			&i.TagNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsByTagHot = `-- name: ListPostsByTagHot :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = $1::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = $1::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = $1::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = $1::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = $1::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = $1::uuid
+  )
+  AND EXISTS (
+    SELECT 1
+    FROM post_tags pt
+    JOIN tags t ON t.id = pt.tag_id
+      AND NOT t.banned
+    WHERE pt.post_id = p.id
+      AND t.name = $2
+  )
+  AND (p.hot_score, p.uid) < (
+    $3::float8,
+    $4::uuid
+  )
+ORDER BY p.hot_score DESC, p.uid DESC
+LIMIT 20
+`
+
+type ListPostsByTagHotParams struct {
+	Viewer         uuid.NullUUID
+	TagName        string
+	CursorHotScore float64
+	CursorID       uuid.UUID
+}
+
+type ListPostsByTagHotRow struct {
+	Uid             uuid.UUID
+	Author          uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	ViewCount       int64
+	Pinned          bool
+	Visibility      PostVisibility
+	LatestRepliedOn pgtype.Timestamptz
+	Ip              string
+	IpRegion        string
+	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	Liked           bool
+	Collected       bool
+	Following       bool
+	TagNames        []string
+}
+
+func (q *Queries) ListPostsByTagHot(ctx context.Context, arg ListPostsByTagHotParams) ([]ListPostsByTagHotRow, error) {
+	rows, err := q.db.Query(ctx, listPostsByTagHot,
+		arg.Viewer,
+		arg.TagName,
+		arg.CursorHotScore,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostsByTagHotRow
+	for rows.Next() {
+		var i ListPostsByTagHotRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Images,
+			&i.Attachments,
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.ViewCount,
+			&i.Pinned,
+			&i.Visibility,
+			&i.LatestRepliedOn,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.HotScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Liked,
+			&i.Collected,
+			&i.Following,
+			&i.TagNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsPublic = `-- name: ListPostsPublic :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = $1::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = $1::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = $1::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = $1::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = $1::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND p.visibility = 'PUBLIC'::post_visibility
+  AND (p.created_at, p.uid) < (
+    $2::timestamptz,
+    $3::uuid
+  )
+ORDER BY p.created_at DESC, p.uid DESC
+LIMIT 20
+`
+
+type ListPostsPublicParams struct {
+	Viewer          uuid.NullUUID
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.UUID
+}
+
+type ListPostsPublicRow struct {
+	Uid             uuid.UUID
+	Author          uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	ViewCount       int64
+	Pinned          bool
+	Visibility      PostVisibility
+	LatestRepliedOn pgtype.Timestamptz
+	Ip              string
+	IpRegion        string
+	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	Liked           bool
+	Collected       bool
+	Following       bool
+	TagNames        []string
+}
+
+func (q *Queries) ListPostsPublic(ctx context.Context, arg ListPostsPublicParams) ([]ListPostsPublicRow, error) {
+	rows, err := q.db.Query(ctx, listPostsPublic, arg.Viewer, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostsPublicRow
+	for rows.Next() {
+		var i ListPostsPublicRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Images,
+			&i.Attachments,
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.ViewCount,
+			&i.Pinned,
+			&i.Visibility,
+			&i.LatestRepliedOn,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.HotScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Liked,
+			&i.Collected,
+			&i.Following,
+			&i.TagNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsPublicActive = `-- name: ListPostsPublicActive :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = $1::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = $1::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = $1::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = $1::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = $1::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND p.visibility = 'PUBLIC'::post_visibility
+  AND (p.latest_replied_on, p.uid) < (
+    $2::timestamptz,
+    $3::uuid
+  )
+ORDER BY p.latest_replied_on DESC, p.uid DESC
+LIMIT 20
+`
+
+type ListPostsPublicActiveParams struct {
+	Viewer          uuid.NullUUID
+	CursorRepliedOn pgtype.Timestamptz
+	CursorID        uuid.UUID
+}
+
+type ListPostsPublicActiveRow struct {
+	Uid             uuid.UUID
+	Author          uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	ViewCount       int64
+	Pinned          bool
+	Visibility      PostVisibility
+	LatestRepliedOn pgtype.Timestamptz
+	Ip              string
+	IpRegion        string
+	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	Liked           bool
+	Collected       bool
+	Following       bool
+	TagNames        []string
+}
+
+func (q *Queries) ListPostsPublicActive(ctx context.Context, arg ListPostsPublicActiveParams) ([]ListPostsPublicActiveRow, error) {
+	rows, err := q.db.Query(ctx, listPostsPublicActive, arg.Viewer, arg.CursorRepliedOn, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostsPublicActiveRow
+	for rows.Next() {
+		var i ListPostsPublicActiveRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Images,
+			&i.Attachments,
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.ViewCount,
+			&i.Pinned,
+			&i.Visibility,
+			&i.LatestRepliedOn,
+			&i.Ip,
+			&i.IpRegion,
+			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.HotScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Liked,
+			&i.Collected,
+			&i.Following,
+			&i.TagNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsPublicHot = `-- name: ListPostsPublicHot :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = $1::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = $1::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = $1::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = $1::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = $1::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND p.visibility = 'PUBLIC'::post_visibility
+  AND (p.hot_score, p.uid) < (
+    $2::float8,
+    $3::uuid
+  )
+ORDER BY p.hot_score DESC, p.uid DESC
+LIMIT 20
+`
+
+type ListPostsPublicHotParams struct {
+	Viewer         uuid.NullUUID
+	CursorHotScore float64
+	CursorID       uuid.UUID
+}
+
+type ListPostsPublicHotRow struct {
+	Uid             uuid.UUID
+	Author          uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	ViewCount       int64
+	Pinned          bool
+	Visibility      PostVisibility
+	LatestRepliedOn pgtype.Timestamptz
+	Ip              string
+	IpRegion        string
+	Status          PostStatus
+	ContentWarning  string
+	SensitiveMedia  bool
+	CommentPolicy   PostCommentPolicy
+	LinkUrl         string
+	HotScore        float64
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	Liked           bool
+	Collected       bool
+	Following       bool
+	TagNames        []string
+}
+
+func (q *Queries) ListPostsPublicHot(ctx context.Context, arg ListPostsPublicHotParams) ([]ListPostsPublicHotRow, error) {
+	rows, err := q.db.Query(ctx, listPostsPublicHot, arg.Viewer, arg.CursorHotScore, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostsPublicHotRow
+	for rows.Next() {
+		var i ListPostsPublicHotRow
 		if err := rows.Scan(
 			&i.Uid,
 			&i.Author,
@@ -388,11 +1651,18 @@ func (q *Queries) ListPostsPublic(ctx context.Context, arg ListPostsPublicParams
 			&i.CommentCount,
 			&i.CollectionCount,
 			&i.LikeCount,
+			&i.ViewCount,
 			&i.Pinned,
 			&i.Visibility,
 			&i.LatestRepliedOn,
 			&i.Ip,
+			&i.IpRegion,
 			&i.Status,
+			&i.ContentWarning,
+			&i.SensitiveMedia,
+			&i.CommentPolicy,
+			&i.LinkUrl,
+			&i.HotScore,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Liked,
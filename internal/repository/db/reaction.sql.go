@@ -0,0 +1,427 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: reaction.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteCommentReactionEdge = `-- name: DeleteCommentReactionEdge :execrows
+DELETE FROM comment_reactions
+WHERE comment_uid = $1
+  AND user_uid = $2
+  AND emoji = $3
+`
+
+type DeleteCommentReactionEdgeParams struct {
+	CommentUid uuid.UUID
+	UserUid    uuid.UUID
+	Emoji      string
+}
+
+func (q *Queries) DeleteCommentReactionEdge(ctx context.Context, arg DeleteCommentReactionEdgeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCommentReactionEdge, arg.CommentUid, arg.UserUid, arg.Emoji)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePostReactionEdge = `-- name: DeletePostReactionEdge :execrows
+DELETE FROM post_reactions
+WHERE post_uid = $1
+  AND user_uid = $2
+  AND emoji = $3
+`
+
+type DeletePostReactionEdgeParams struct {
+	PostUid uuid.UUID
+	UserUid uuid.UUID
+	Emoji   string
+}
+
+func (q *Queries) DeletePostReactionEdge(ctx context.Context, arg DeletePostReactionEdgeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePostReactionEdge, arg.PostUid, arg.UserUid, arg.Emoji)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertCommentReactionEdge = `-- name: InsertCommentReactionEdge :execrows
+INSERT INTO comment_reactions (comment_uid, user_uid, emoji)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type InsertCommentReactionEdgeParams struct {
+	CommentUid uuid.UUID
+	UserUid    uuid.UUID
+	Emoji      string
+}
+
+func (q *Queries) InsertCommentReactionEdge(ctx context.Context, arg InsertCommentReactionEdgeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertCommentReactionEdge, arg.CommentUid, arg.UserUid, arg.Emoji)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertPostReactionEdge = `-- name: InsertPostReactionEdge :execrows
+INSERT INTO post_reactions (post_uid, user_uid, emoji)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type InsertPostReactionEdgeParams struct {
+	PostUid uuid.UUID
+	UserUid uuid.UUID
+	Emoji   string
+}
+
+func (q *Queries) InsertPostReactionEdge(ctx context.Context, arg InsertPostReactionEdgeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertPostReactionEdge, arg.PostUid, arg.UserUid, arg.Emoji)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listCommentReactionCounts = `-- name: ListCommentReactionCounts :many
+SELECT r.comment_uid,
+  r.emoji,
+  count(*)::int4 AS count,
+  COALESCE(bool_or(r.user_uid = $1::uuid), false)::boolean AS reacted
+FROM (
+    SELECT cl.comment_uid,
+      cl.user_uid,
+      $2::text AS emoji
+    FROM comment_likes cl
+    WHERE cl.comment_uid = ANY($3::uuid [])
+    UNION ALL
+    SELECT cr.comment_uid,
+      cr.user_uid,
+      cr.emoji
+    FROM comment_reactions cr
+    WHERE cr.comment_uid = ANY($3::uuid [])
+      AND cr.emoji = ANY($4::text [])
+  ) r
+GROUP BY r.comment_uid,
+  r.emoji
+`
+
+type ListCommentReactionCountsParams struct {
+	Viewer      uuid.NullUUID
+	LikeEmoji   string
+	CommentUids []uuid.UUID
+	Emojis      []string
+}
+
+type ListCommentReactionCountsRow struct {
+	CommentUid uuid.UUID
+	Emoji      string
+	Count      int32
+	Reacted    bool
+}
+
+// Likes are counted under like_emoji next to the stored reactions.
+func (q *Queries) ListCommentReactionCounts(ctx context.Context, arg ListCommentReactionCountsParams) ([]ListCommentReactionCountsRow, error) {
+	rows, err := q.db.Query(ctx, listCommentReactionCounts,
+		arg.Viewer,
+		arg.LikeEmoji,
+		arg.CommentUids,
+		arg.Emojis,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCommentReactionCountsRow
+	for rows.Next() {
+		var i ListCommentReactionCountsRow
+		if err := rows.Scan(
+			&i.CommentUid,
+			&i.Emoji,
+			&i.Count,
+			&i.Reacted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommentReactors = `-- name: ListCommentReactors :many
+SELECT r.reacted_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (myf.follower_uid IS NOT NULL)::boolean AS following
+FROM (
+    SELECT cl.user_uid,
+      cl.created_at AS reacted_at
+    FROM comment_likes cl
+    WHERE cl.comment_uid = $1
+      AND $2::text = $3::text
+    UNION ALL
+    SELECT cr.user_uid,
+      cr.created_at AS reacted_at
+    FROM comment_reactions cr
+    WHERE cr.comment_uid = $1
+      AND cr.emoji = $2::text
+      AND $2::text <> $3::text
+  ) r
+  JOIN users u ON u.uid = r.user_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows myf ON myf.follower_uid = $4::uuid
+  AND myf.followee_uid = r.user_uid
+WHERE (
+    (
+      $5::timestamptz IS NULL
+      AND $6::uuid IS NULL
+    )
+    OR (r.reacted_at, r.user_uid) < (
+      $5::timestamptz,
+      $6::uuid
+    )
+  )
+ORDER BY r.reacted_at DESC,
+  r.user_uid DESC
+LIMIT 20
+`
+
+type ListCommentReactorsParams struct {
+	CommentUid      uuid.UUID
+	Emoji           string
+	LikeEmoji       string
+	Viewer          uuid.NullUUID
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.NullUUID
+}
+
+type ListCommentReactorsRow struct {
+	ReactedAt      pgtype.Timestamptz
+	Uid            uuid.UUID
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	Following      bool
+}
+
+func (q *Queries) ListCommentReactors(ctx context.Context, arg ListCommentReactorsParams) ([]ListCommentReactorsRow, error) {
+	rows, err := q.db.Query(ctx, listCommentReactors,
+		arg.CommentUid,
+		arg.Emoji,
+		arg.LikeEmoji,
+		arg.Viewer,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCommentReactorsRow
+	for rows.Next() {
+		var i ListCommentReactorsRow
+		if err := rows.Scan(
+			&i.ReactedAt,
+			&i.Uid,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.Following,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostReactionCounts = `-- name: ListPostReactionCounts :many
+SELECT r.post_uid,
+  r.emoji,
+  count(*)::int4 AS count,
+  COALESCE(bool_or(r.user_uid = $1::uuid), false)::boolean AS reacted
+FROM (
+    SELECT pl.post_uid,
+      pl.user_uid,
+      $2::text AS emoji
+    FROM post_likes pl
+    WHERE pl.post_uid = ANY($3::uuid [])
+    UNION ALL
+    SELECT pr.post_uid,
+      pr.user_uid,
+      pr.emoji
+    FROM post_reactions pr
+    WHERE pr.post_uid = ANY($3::uuid [])
+      AND pr.emoji = ANY($4::text [])
+  ) r
+GROUP BY r.post_uid,
+  r.emoji
+`
+
+type ListPostReactionCountsParams struct {
+	Viewer    uuid.NullUUID
+	LikeEmoji string
+	PostUids  []uuid.UUID
+	Emojis    []string
+}
+
+type ListPostReactionCountsRow struct {
+	PostUid uuid.UUID
+	Emoji   string
+	Count   int32
+	Reacted bool
+}
+
+// Likes are counted under like_emoji next to the stored reactions.
+func (q *Queries) ListPostReactionCounts(ctx context.Context, arg ListPostReactionCountsParams) ([]ListPostReactionCountsRow, error) {
+	rows, err := q.db.Query(ctx, listPostReactionCounts,
+		arg.Viewer,
+		arg.LikeEmoji,
+		arg.PostUids,
+		arg.Emojis,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostReactionCountsRow
+	for rows.Next() {
+		var i ListPostReactionCountsRow
+		if err := rows.Scan(
+			&i.PostUid,
+			&i.Emoji,
+			&i.Count,
+			&i.Reacted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostReactors = `-- name: ListPostReactors :many
+SELECT r.reacted_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (myf.follower_uid IS NOT NULL)::boolean AS following
+FROM (
+    SELECT pl.user_uid,
+      pl.created_at AS reacted_at
+    FROM post_likes pl
+    WHERE pl.post_uid = $1
+      AND $2::text = $3::text
+    UNION ALL
+    SELECT pr.user_uid,
+      pr.created_at AS reacted_at
+    FROM post_reactions pr
+    WHERE pr.post_uid = $1
+      AND pr.emoji = $2::text
+      AND $2::text <> $3::text
+  ) r
+  JOIN users u ON u.uid = r.user_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows myf ON myf.follower_uid = $4::uuid
+  AND myf.followee_uid = r.user_uid
+WHERE (
+    (
+      $5::timestamptz IS NULL
+      AND $6::uuid IS NULL
+    )
+    OR (r.reacted_at, r.user_uid) < (
+      $5::timestamptz,
+      $6::uuid
+    )
+  )
+ORDER BY r.reacted_at DESC,
+  r.user_uid DESC
+LIMIT 20
+`
+
+type ListPostReactorsParams struct {
+	PostUid         uuid.UUID
+	Emoji           string
+	LikeEmoji       string
+	Viewer          uuid.NullUUID
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        uuid.NullUUID
+}
+
+type ListPostReactorsRow struct {
+	ReactedAt      pgtype.Timestamptz
+	Uid            uuid.UUID
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	Following      bool
+}
+
+func (q *Queries) ListPostReactors(ctx context.Context, arg ListPostReactorsParams) ([]ListPostReactorsRow, error) {
+	rows, err := q.db.Query(ctx, listPostReactors,
+		arg.PostUid,
+		arg.Emoji,
+		arg.LikeEmoji,
+		arg.Viewer,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostReactorsRow
+	for rows.Next() {
+		var i ListPostReactorsRow
+		if err := rows.Scan(
+			&i.ReactedAt,
+			&i.Uid,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.Following,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
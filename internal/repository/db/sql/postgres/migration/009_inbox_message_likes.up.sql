@@ -0,0 +1,5 @@
+-- like and collect inbox messages
+ALTER TYPE message_type ADD VALUE IF NOT EXISTS 'LIKE';
+ALTER TYPE message_type ADD VALUE IF NOT EXISTS 'COLLECT';
+CREATE INDEX idx_inbox_messages_actor_post_normal ON inbox_messages (receiver_uid, actor_uid, post_uid)
+WHERE status = 'NORMAL'::message_status;
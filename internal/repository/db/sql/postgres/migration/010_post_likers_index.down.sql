@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_post_collections_post_uid_created_at;
+DROP INDEX IF EXISTS idx_post_likes_post_uid_created_at;
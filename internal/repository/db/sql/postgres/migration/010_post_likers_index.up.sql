@@ -0,0 +1,2 @@
+CREATE INDEX idx_post_likes_post_uid_created_at ON post_likes (post_uid, created_at DESC, user_uid DESC);
+CREATE INDEX idx_post_collections_post_uid_created_at ON post_collections (post_uid, created_at DESC, user_uid DESC);
@@ -0,0 +1,43 @@
+-- collection_folders table
+CREATE TABLE collection_folders (
+    id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    uid uuid NOT NULL UNIQUE,
+    owner_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    name text NOT NULL,
+    is_default boolean NOT NULL DEFAULT false,
+    is_public boolean NOT NULL DEFAULT false,
+    position integer NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX uq_collection_folders_owner_default ON collection_folders (owner_uid)
+WHERE is_default;
+CREATE INDEX idx_collection_folders_owner_position ON collection_folders (owner_uid, position, id);
+-- collection_folder_items table
+CREATE TABLE collection_folder_items (
+    folder_uid uuid NOT NULL REFERENCES collection_folders(uid) ON DELETE CASCADE,
+    post_uid uuid NOT NULL REFERENCES posts(uid) ON DELETE CASCADE,
+    user_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    note text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (folder_uid, post_uid)
+);
+CREATE INDEX idx_collection_folder_items_user_post ON collection_folder_items (user_uid, post_uid);
+-- move existing collections into a default folder per user
+INSERT INTO collection_folders (uid, owner_uid, name, is_default)
+SELECT gen_random_uuid(),
+    c.user_uid,
+    'Default',
+    true
+FROM (
+        SELECT DISTINCT user_uid
+        FROM post_collections
+    ) c;
+INSERT INTO collection_folder_items (folder_uid, post_uid, user_uid, created_at)
+SELECT f.uid,
+    pc.post_uid,
+    pc.user_uid,
+    pc.created_at
+FROM post_collections pc
+    JOIN collection_folders f ON f.owner_uid = pc.user_uid
+    AND f.is_default;
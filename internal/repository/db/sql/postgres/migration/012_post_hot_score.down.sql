@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_posts_hot_keyset_normal;
+DROP INDEX IF EXISTS idx_posts_active_keyset_normal;
+ALTER TABLE posts DROP COLUMN IF EXISTS hot_score;
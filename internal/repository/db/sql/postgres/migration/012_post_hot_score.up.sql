@@ -0,0 +1,6 @@
+ALTER TABLE posts
+ADD COLUMN hot_score double precision NOT NULL DEFAULT 0;
+CREATE INDEX idx_posts_active_keyset_normal ON posts (latest_replied_on DESC, uid DESC)
+WHERE status = 'NORMAL'::post_status;
+CREATE INDEX idx_posts_hot_keyset_normal ON posts (hot_score DESC, uid DESC)
+WHERE status = 'NORMAL'::post_status;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_post_collections_created_at;
+DROP INDEX IF EXISTS idx_post_likes_created_at;
+DROP INDEX IF EXISTS idx_post_comments_created_at;
+DROP TABLE IF EXISTS tag_activity_buckets;
+ALTER TABLE tags DROP COLUMN IF EXISTS post_count;
@@ -0,0 +1,14 @@
+ALTER TABLE tags
+ADD COLUMN post_count integer NOT NULL DEFAULT 0;
+-- tag_activity_buckets table: per-tag activity in 5 minute buckets, kept for 7 days
+CREATE TABLE tag_activity_buckets (
+    tag_id integer NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    bucket_start timestamptz NOT NULL,
+    post_count integer NOT NULL DEFAULT 0,
+    engagement_count integer NOT NULL DEFAULT 0,
+    PRIMARY KEY (tag_id, bucket_start)
+);
+CREATE INDEX idx_tag_activity_buckets_bucket_start ON tag_activity_buckets (bucket_start);
+CREATE INDEX idx_post_comments_created_at ON post_comments (created_at);
+CREATE INDEX idx_post_likes_created_at ON post_likes (created_at);
+CREATE INDEX idx_post_collections_created_at ON post_collections (created_at);
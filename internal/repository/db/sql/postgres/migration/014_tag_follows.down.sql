@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS tag_follows;
+ALTER TABLE tags DROP COLUMN IF EXISTS follower_count;
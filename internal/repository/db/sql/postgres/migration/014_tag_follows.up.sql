@@ -0,0 +1,11 @@
+ALTER TABLE tags
+ADD COLUMN follower_count integer NOT NULL DEFAULT 0;
+-- tag_follows table
+CREATE TABLE tag_follows (
+    user_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    tag_id integer NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_uid, tag_id)
+);
+CREATE INDEX idx_tag_follows_tag_id ON tag_follows (tag_id);
+CREATE INDEX idx_tag_follows_user_keyset ON tag_follows (user_uid, created_at DESC);
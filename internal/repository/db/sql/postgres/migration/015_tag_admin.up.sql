@@ -0,0 +1,9 @@
+ALTER TABLE tags
+ADD COLUMN banned boolean NOT NULL DEFAULT false;
+-- tag_aliases table: alternative spellings resolved to a canonical tag at post time
+CREATE TABLE tag_aliases (
+    alias text PRIMARY KEY,
+    tag_id integer NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX idx_tag_aliases_tag_id ON tag_aliases (tag_id);
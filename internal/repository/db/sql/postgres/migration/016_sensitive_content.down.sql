@@ -0,0 +1,4 @@
+ALTER TABLE users DROP COLUMN IF EXISTS sensitive_media_preference;
+DROP TYPE IF EXISTS sensitive_media_preference;
+ALTER TABLE posts DROP COLUMN IF EXISTS sensitive_media,
+  DROP COLUMN IF EXISTS content_warning;
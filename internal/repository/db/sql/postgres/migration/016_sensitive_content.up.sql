@@ -0,0 +1,7 @@
+ALTER TABLE posts
+ADD COLUMN content_warning text NOT NULL DEFAULT '',
+  ADD COLUMN sensitive_media boolean NOT NULL DEFAULT false;
+-- how a user wants posts with sensitive media presented in feeds
+CREATE TYPE sensitive_media_preference AS ENUM ('SHOW', 'HIDE', 'FILTER');
+ALTER TABLE users
+ADD COLUMN sensitive_media_preference sensitive_media_preference NOT NULL DEFAULT 'HIDE';
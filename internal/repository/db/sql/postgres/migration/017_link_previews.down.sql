@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS link_previews;
+DROP TYPE IF EXISTS link_preview_status;
+ALTER TABLE posts DROP COLUMN IF EXISTS link_url;
@@ -0,0 +1,13 @@
+ALTER TABLE posts
+ADD COLUMN link_url text NOT NULL DEFAULT '';
+-- link_previews table: OpenGraph / Twitter card metadata cached per URL
+CREATE TYPE link_preview_status AS ENUM ('READY', 'FAILED');
+CREATE TABLE link_previews (
+    url text PRIMARY KEY,
+    status link_preview_status NOT NULL,
+    title text NOT NULL DEFAULT '',
+    description text NOT NULL DEFAULT '',
+    site_name text NOT NULL DEFAULT '',
+    image_url text NOT NULL DEFAULT '',
+    fetched_at timestamptz NOT NULL DEFAULT now()
+);
@@ -0,0 +1,2 @@
+ALTER TABLE posts
+ADD COLUMN view_count bigint NOT NULL DEFAULT 0;
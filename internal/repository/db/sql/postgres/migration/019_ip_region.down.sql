@@ -0,0 +1,2 @@
+ALTER TABLE post_comments DROP COLUMN IF EXISTS ip_region;
+ALTER TABLE posts DROP COLUMN IF EXISTS ip_region;
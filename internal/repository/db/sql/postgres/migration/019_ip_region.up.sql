@@ -0,0 +1,5 @@
+-- coarse GeoIP region resolved from the client IP at write time
+ALTER TABLE posts
+ADD COLUMN ip_region text NOT NULL DEFAULT '';
+ALTER TABLE post_comments
+ADD COLUMN ip_region text NOT NULL DEFAULT '';
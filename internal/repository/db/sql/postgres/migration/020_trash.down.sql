@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_post_comments_root_uid;
+DROP INDEX IF EXISTS idx_post_comments_post_uid;
+DROP INDEX IF EXISTS idx_post_comments_archived_at;
+DROP INDEX IF EXISTS idx_post_comments_author_trash;
+DROP INDEX IF EXISTS idx_posts_archived_at;
+DROP INDEX IF EXISTS idx_posts_author_trash;
+ALTER TABLE post_comments DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS archived_at;
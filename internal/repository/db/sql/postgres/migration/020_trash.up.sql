@@ -0,0 +1,23 @@
+-- when a post or comment was moved to the trash; purged after the retention window
+ALTER TABLE posts
+ADD COLUMN archived_at timestamptz;
+ALTER TABLE post_comments
+ADD COLUMN archived_at timestamptz;
+-- existing trash gets the full retention window from deploy time instead of
+-- being purged on the first run
+UPDATE posts
+SET archived_at = now()
+WHERE status = 'ARCHIVED'::post_status;
+UPDATE post_comments
+SET archived_at = now()
+WHERE status = 'ARCHIVED'::comment_status;
+CREATE INDEX idx_posts_author_trash ON posts (author, archived_at DESC, uid DESC)
+WHERE status = 'ARCHIVED'::post_status;
+CREATE INDEX idx_posts_archived_at ON posts (archived_at)
+WHERE status = 'ARCHIVED'::post_status;
+CREATE INDEX idx_post_comments_author_trash ON post_comments (author_uid, archived_at DESC, uid DESC)
+WHERE status = 'ARCHIVED'::comment_status;
+CREATE INDEX idx_post_comments_archived_at ON post_comments (archived_at)
+WHERE status = 'ARCHIVED'::comment_status;
+CREATE INDEX idx_post_comments_post_uid ON post_comments (post_uid);
+CREATE INDEX idx_post_comments_root_uid ON post_comments (root_uid);
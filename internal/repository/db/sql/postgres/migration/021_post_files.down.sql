@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS post_files;
+DROP TYPE IF EXISTS post_file_kind;
@@ -0,0 +1,27 @@
+-- post_files table: files referenced by posts.images and posts.attachments
+CREATE TYPE post_file_kind AS ENUM ('IMAGE', 'ATTACHMENT');
+CREATE TABLE post_files (
+    post_uid uuid NOT NULL REFERENCES posts(uid) ON DELETE CASCADE,
+    file_id integer NOT NULL REFERENCES files(id),
+    kind post_file_kind NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (post_uid, kind, file_id)
+);
+CREATE INDEX idx_post_files_file_id ON post_files (file_id);
+-- link files that existing posts already point at
+INSERT INTO post_files (post_uid, file_id, kind)
+SELECT p.uid,
+    f.id,
+    'IMAGE'::post_file_kind
+FROM posts p
+    CROSS JOIN LATERAL unnest(p.images) AS i(url)
+    JOIN files f ON f.url = i.url
+ON CONFLICT DO NOTHING;
+INSERT INTO post_files (post_uid, file_id, kind)
+SELECT p.uid,
+    f.id,
+    'ATTACHMENT'::post_file_kind
+FROM posts p
+    CROSS JOIN LATERAL unnest(p.attachments) AS a(url)
+    JOIN files f ON f.url = a.url
+ON CONFLICT DO NOTHING;
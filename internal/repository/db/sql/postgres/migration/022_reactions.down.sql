@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_comment_likes_comment_created_at;
+DROP TABLE IF EXISTS comment_reactions;
+DROP TABLE IF EXISTS post_reactions;
@@ -0,0 +1,18 @@
+-- emoji reactions other than the default one, which is stored as a like
+CREATE TABLE post_reactions (
+    post_uid uuid NOT NULL REFERENCES posts(uid) ON DELETE CASCADE,
+    user_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    emoji text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (post_uid, emoji, user_uid)
+);
+CREATE INDEX idx_post_reactions_post_emoji_created_at ON post_reactions (post_uid, emoji, created_at DESC, user_uid DESC);
+CREATE TABLE comment_reactions (
+    comment_uid uuid NOT NULL REFERENCES post_comments(uid) ON DELETE CASCADE,
+    user_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    emoji text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (comment_uid, emoji, user_uid)
+);
+CREATE INDEX idx_comment_reactions_comment_emoji_created_at ON comment_reactions (comment_uid, emoji, created_at DESC, user_uid DESC);
+CREATE INDEX idx_comment_likes_comment_created_at ON comment_likes (comment_uid, created_at DESC, user_uid DESC);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS comment_revisions;
+ALTER TABLE post_comments DROP COLUMN IF EXISTS edited;
@@ -0,0 +1,10 @@
+-- comment edits keep the replaced text as revisions
+ALTER TABLE post_comments
+ADD COLUMN edited boolean NOT NULL DEFAULT false;
+CREATE TABLE comment_revisions (
+    id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    comment_uid uuid NOT NULL REFERENCES post_comments(uid) ON DELETE CASCADE,
+    content text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX idx_comment_revisions_comment ON comment_revisions (comment_uid, id DESC);
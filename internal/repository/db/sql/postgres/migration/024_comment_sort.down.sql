@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_post_comments_top_updated_at_normal;
+DROP INDEX IF EXISTS idx_post_comments_top_created_at_normal;
+DROP INDEX IF EXISTS idx_post_comments_post_best_keyset_normal;
+DROP INDEX IF EXISTS idx_post_comments_post_likes_keyset_normal;
+ALTER TABLE post_comments DROP COLUMN IF EXISTS best_score;
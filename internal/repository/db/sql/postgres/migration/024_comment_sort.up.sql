@@ -0,0 +1,29 @@
+-- top-level comment sorting: most liked and best (likes plus replies with time decay)
+ALTER TABLE post_comments
+ADD COLUMN best_score double precision NOT NULL DEFAULT 0;
+UPDATE post_comments
+SET best_score = (like_count + 2 * reply_count)::float8 / power(
+        LEAST(
+            extract(
+                epoch
+                FROM now() - created_at
+            )::float8 / 3600,
+            168
+        ) + 2,
+        1.5
+    )
+WHERE parent_uid IS NULL
+    AND status = 'NORMAL'::comment_status
+    AND (like_count > 0 OR reply_count > 0);
+CREATE INDEX idx_post_comments_post_likes_keyset_normal ON post_comments (post_uid, like_count DESC, uid DESC)
+WHERE status = 'NORMAL'::comment_status
+    AND parent_uid IS NULL;
+CREATE INDEX idx_post_comments_post_best_keyset_normal ON post_comments (post_uid, best_score DESC, uid DESC)
+WHERE status = 'NORMAL'::comment_status
+    AND parent_uid IS NULL;
+CREATE INDEX idx_post_comments_top_created_at_normal ON post_comments (created_at)
+WHERE status = 'NORMAL'::comment_status
+    AND parent_uid IS NULL;
+CREATE INDEX idx_post_comments_top_updated_at_normal ON post_comments (updated_at)
+WHERE status = 'NORMAL'::comment_status
+    AND parent_uid IS NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_post_comments_post_pinned_normal;
+ALTER TABLE post_comments DROP COLUMN IF EXISTS archived_by;
+ALTER TABLE post_comments DROP COLUMN IF EXISTS pinned_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS comment_policy;
+DROP TYPE IF EXISTS post_comment_policy;
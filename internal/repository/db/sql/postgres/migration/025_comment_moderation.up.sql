@@ -0,0 +1,14 @@
+-- post authors moderate their comment sections
+CREATE TYPE post_comment_policy AS ENUM ('EVERYONE', 'FOLLOWERS', 'LOCKED');
+ALTER TABLE posts
+ADD COLUMN comment_policy post_comment_policy NOT NULL DEFAULT 'EVERYONE';
+ALTER TABLE post_comments
+ADD COLUMN pinned_at timestamptz,
+    -- who archived the comment; only its own author can restore it
+ADD COLUMN archived_by uuid;
+UPDATE post_comments
+SET archived_by = author_uid
+WHERE status = 'ARCHIVED'::comment_status;
+CREATE INDEX idx_post_comments_post_pinned_normal ON post_comments (post_uid, pinned_at DESC)
+WHERE status = 'NORMAL'::comment_status
+    AND pinned_at IS NOT NULL;
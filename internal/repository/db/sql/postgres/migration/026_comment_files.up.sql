@@ -0,0 +1,20 @@
+-- comment_files table: files referenced by post_comments.images and post_comments.attachments
+ALTER TABLE post_comments
+ADD COLUMN attachments text [] NOT NULL DEFAULT ARRAY []::text [];
+CREATE TABLE comment_files (
+    comment_uid uuid NOT NULL REFERENCES post_comments(uid) ON DELETE CASCADE,
+    file_id integer NOT NULL REFERENCES files(id),
+    kind post_file_kind NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (comment_uid, kind, file_id)
+);
+CREATE INDEX idx_comment_files_file_id ON comment_files (file_id);
+-- link files that existing comments already point at
+INSERT INTO comment_files (comment_uid, file_id, kind)
+SELECT c.uid,
+    f.id,
+    'IMAGE'::post_file_kind
+FROM post_comments c
+    CROSS JOIN LATERAL unnest(c.images) AS i(url)
+    JOIN files f ON f.url = i.url
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,3 @@
+-- lets a user hide their follower and following lists from everyone else
+ALTER TABLE users
+ADD COLUMN hide_follow_lists boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_follow_suggestion_dismissals;
+DROP TABLE IF EXISTS user_follow_suggestions;
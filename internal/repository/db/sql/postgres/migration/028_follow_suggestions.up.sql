@@ -0,0 +1,18 @@
+-- follow suggestions, precomputed per user by a background job
+CREATE TABLE user_follow_suggestions (
+    user_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    suggested_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    score double precision NOT NULL,
+    mutual_count integer NOT NULL DEFAULT 0,
+    shared_tag_count integer NOT NULL DEFAULT 0,
+    computed_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_uid, suggested_uid)
+);
+CREATE INDEX idx_user_follow_suggestions_rank ON user_follow_suggestions (user_uid, score DESC, suggested_uid);
+-- suggestions a user dismissed are never suggested again
+CREATE TABLE user_follow_suggestion_dismissals (
+    user_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    suggested_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_uid, suggested_uid)
+);
@@ -0,0 +1,15 @@
+DELETE FROM inbox_messages
+WHERE type::text = 'FOLLOW_IMPORT';
+ALTER TABLE inbox_messages DROP COLUMN IF EXISTS follow_import_uid;
+DROP INDEX IF EXISTS idx_inbox_messages_follow_exists_normal;
+ALTER TYPE message_type RENAME TO message_type_old;
+CREATE TYPE message_type AS ENUM ('COMMENT', 'FOLLOW', 'LIKE', 'COLLECT');
+ALTER TABLE inbox_messages
+ALTER COLUMN type TYPE message_type USING type::text::message_type;
+DROP TYPE message_type_old;
+CREATE INDEX idx_inbox_messages_follow_exists_normal ON inbox_messages (receiver_uid, actor_uid)
+WHERE status = 'NORMAL'::message_status
+    AND type = 'FOLLOW'::message_type;
+DROP TABLE IF EXISTS follow_import_entries;
+DROP TABLE IF EXISTS follow_imports;
+DROP TYPE IF EXISTS follow_import_status;
@@ -0,0 +1,34 @@
+-- follow list imports, processed in rate-limited batches by a background job
+CREATE TYPE follow_import_status AS ENUM ('RUNNING', 'DONE', 'FAILED');
+CREATE TABLE follow_imports (
+    id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    uid uuid NOT NULL UNIQUE,
+    user_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    file_url text NOT NULL,
+    status follow_import_status NOT NULL DEFAULT 'RUNNING',
+    total_count integer NOT NULL DEFAULT 0,
+    processed_count integer NOT NULL DEFAULT 0,
+    followed_count integer NOT NULL DEFAULT 0,
+    already_following_count integer NOT NULL DEFAULT 0,
+    not_found_count integer NOT NULL DEFAULT 0,
+    skipped_count integer NOT NULL DEFAULT 0,
+    error text NOT NULL DEFAULT '',
+    parsed_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    finished_at timestamptz
+);
+-- at most one running import per user
+CREATE UNIQUE INDEX idx_follow_imports_user_running ON follow_imports (user_uid)
+WHERE status = 'RUNNING'::follow_import_status;
+-- rows parsed from the uploaded file, kept until the import finishes
+CREATE TABLE follow_import_entries (
+    import_uid uuid NOT NULL REFERENCES follow_imports(uid) ON DELETE CASCADE,
+    position integer NOT NULL,
+    uid uuid,
+    nickname text NOT NULL DEFAULT '',
+    PRIMARY KEY (import_uid, position)
+);
+-- import result summaries delivered to the inbox
+ALTER TYPE message_type ADD VALUE IF NOT EXISTS 'FOLLOW_IMPORT';
+ALTER TABLE inbox_messages
+ADD COLUMN follow_import_uid uuid;
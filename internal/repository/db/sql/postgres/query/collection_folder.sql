@@ -0,0 +1,140 @@
+-- name: EnsureDefaultCollectionFolder :one
+INSERT INTO collection_folders (uid, owner_uid, name, is_default)
+VALUES (@uid, @owner_uid, @name, true)
+ON CONFLICT (owner_uid)
+WHERE is_default DO
+UPDATE
+SET owner_uid = EXCLUDED.owner_uid
+RETURNING uid;
+-- name: CreateCollectionFolder :one
+INSERT INTO collection_folders (uid, owner_uid, name, is_public, position)
+SELECT @uid,
+  @owner_uid,
+  @name,
+  @is_public,
+  COALESCE(MAX(f.position) + 1, 0)::int4
+FROM collection_folders f
+WHERE f.owner_uid = @owner_uid
+RETURNING uid,
+  owner_uid,
+  name,
+  is_default,
+  is_public,
+  position,
+  created_at,
+  updated_at;
+-- name: GetCollectionFolderByUid :one
+SELECT uid,
+  owner_uid,
+  name,
+  is_default,
+  is_public
+FROM collection_folders
+WHERE uid = @uid
+LIMIT 1;
+-- name: ListCollectionFoldersByOwner :many
+SELECT f.uid,
+  f.owner_uid,
+  f.name,
+  f.is_default,
+  f.is_public,
+  f.position,
+  f.created_at,
+  f.updated_at,
+  (
+    SELECT count(*)
+    FROM collection_folder_items i
+      JOIN posts p ON p.uid = i.post_uid
+      AND p.status = 'NORMAL'::post_status
+    WHERE i.folder_uid = f.uid
+  )::int4 AS post_count
+FROM collection_folders f
+WHERE f.owner_uid = @owner_uid
+  AND (
+    NOT @public_only::boolean
+    OR f.is_public
+  )
+ORDER BY f.position,
+  f.id;
+-- name: CountCollectionFoldersByOwner :one
+SELECT count(*)::int4
+FROM collection_folders
+WHERE owner_uid = @owner_uid;
+-- name: UpdateCollectionFolderByUidAndOwner :execrows
+UPDATE collection_folders
+SET name = COALESCE(sqlc.narg(name), name),
+  is_public = COALESCE(sqlc.narg(is_public)::boolean, is_public),
+  updated_at = now()
+WHERE uid = @uid
+  AND owner_uid = @owner_uid;
+-- name: UpdateCollectionFolderPositions :execrows
+UPDATE collection_folders f
+SET position = (x.ord - 1)::int4,
+  updated_at = now()
+FROM unnest(@uids::uuid []) WITH ORDINALITY AS x(uid, ord)
+WHERE f.uid = x.uid
+  AND f.owner_uid = @owner_uid;
+-- name: MoveOrphanCollectionFolderItems :exec
+INSERT INTO collection_folder_items (folder_uid, post_uid, user_uid, note, created_at)
+SELECT @target_folder_uid,
+  i.post_uid,
+  i.user_uid,
+  i.note,
+  i.created_at
+FROM collection_folder_items i
+WHERE i.folder_uid = @folder_uid
+  AND NOT EXISTS (
+    SELECT 1
+    FROM collection_folder_items o
+    WHERE o.user_uid = i.user_uid
+      AND o.post_uid = i.post_uid
+      AND o.folder_uid <> i.folder_uid
+  ) ON CONFLICT DO NOTHING;
+-- name: DeleteCollectionFolderByUidAndOwner :execrows
+DELETE FROM collection_folders
+WHERE uid = @uid
+  AND owner_uid = @owner_uid
+  AND NOT is_default;
+-- name: InsertCollectionFolderItem :exec
+INSERT INTO collection_folder_items (folder_uid, post_uid, user_uid)
+VALUES (@folder_uid, @post_uid, @user_uid)
+ON CONFLICT DO NOTHING;
+-- name: UpsertCollectionFolderItem :execrows
+INSERT INTO collection_folder_items (folder_uid, post_uid, user_uid, note)
+SELECT @folder_uid,
+  @post_uid,
+  @user_uid,
+  @note
+WHERE EXISTS (
+    SELECT 1
+    FROM post_collections pc
+    WHERE pc.post_uid = @post_uid
+      AND pc.user_uid = @user_uid
+  ) ON CONFLICT (folder_uid, post_uid) DO
+UPDATE
+SET note = EXCLUDED.note;
+-- name: DeleteCollectionFolderItem :execrows
+DELETE FROM collection_folder_items
+WHERE folder_uid = @folder_uid
+  AND post_uid = @post_uid
+  AND user_uid = @user_uid;
+-- name: DeleteCollectionFolderItemsByUserAndPost :exec
+DELETE FROM collection_folder_items
+WHERE user_uid = @user_uid
+  AND post_uid = @post_uid;
+-- name: CountCollectionFolderItemsByUserAndPost :one
+SELECT count(*)::int4
+FROM collection_folder_items
+WHERE user_uid = @user_uid
+  AND post_uid = @post_uid;
+-- name: ListCollectionFoldersByUserAndPost :many
+SELECT f.uid AS folder_uid,
+  f.name AS folder_name,
+  i.note,
+  i.created_at
+FROM collection_folder_items i
+  JOIN collection_folders f ON f.uid = i.folder_uid
+WHERE i.user_uid = @user_uid
+  AND i.post_uid = @post_uid
+ORDER BY f.position,
+  f.id;
@@ -8,7 +8,9 @@ INSERT INTO post_comments (
     reply_to_author_uid,
     content,
     images,
-    ip
+    attachments,
+    ip,
+    ip_region
   )
 VALUES (
     @uid,
@@ -19,17 +21,103 @@ VALUES (
     @reply_to_author_uid,
     @content,
     COALESCE(@images::text [], '{}'::text []),
-    @ip
+    COALESCE(@attachments::text [], '{}'::text []),
+    @ip,
+    @ip_region
   )
 RETURNING id,
   uid;
 -- name: ArchiveCommentByUidAndAuthor :execrows
 UPDATE post_comments
 SET status = 'ARCHIVED'::comment_status,
+  archived_at = now(),
+  archived_by = author_uid,
+  pinned_at = NULL,
   updated_at = now()
 WHERE uid = @uid
   AND author_uid = @author_uid
   AND status = 'NORMAL'::comment_status;
+-- name: ArchiveCommentByUidAndPostAuthor :execrows
+-- Post authors may remove any comment under their own posts.
+UPDATE post_comments c
+SET status = 'ARCHIVED'::comment_status,
+  archived_at = now(),
+  archived_by = p.author,
+  pinned_at = NULL,
+  updated_at = now()
+FROM posts p
+WHERE c.uid = @uid
+  AND c.status = 'NORMAL'::comment_status
+  AND p.uid = c.post_uid
+  AND p.author = @post_author;
+-- name: GetTrashedCommentMetaByUidAndAuthor :one
+SELECT post_uid,
+  root_uid
+FROM post_comments
+WHERE uid = @uid
+  AND author_uid = @author_uid
+  AND status = 'ARCHIVED'::comment_status
+  AND archived_by = author_uid
+  AND archived_at > @archived_after::timestamptz
+LIMIT 1;
+-- name: RestoreCommentByUidAndAuthor :execrows
+UPDATE post_comments
+SET status = 'NORMAL'::comment_status,
+  archived_at = NULL,
+  updated_at = now()
+WHERE uid = @uid
+  AND author_uid = @author_uid
+  AND status = 'ARCHIVED'::comment_status
+  AND archived_by = author_uid
+  AND archived_at > @archived_after::timestamptz;
+-- name: ListTrashedCommentsByAuthor :many
+SELECT c.uid,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.content,
+  c.images,
+  c.created_at,
+  c.archived_at
+FROM post_comments c
+WHERE c.author_uid = @author_uid
+  AND c.status = 'ARCHIVED'::comment_status
+  AND c.archived_by = c.author_uid
+  AND c.archived_at > @archived_after::timestamptz
+  AND (
+    (
+      sqlc.narg(cursor_archived_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (c.archived_at, c.uid) < (
+      sqlc.narg(cursor_archived_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY c.archived_at DESC,
+  c.uid DESC
+LIMIT 20;
+-- name: ListExpiredTrashedCommentUids :many
+SELECT uid
+FROM post_comments
+WHERE status = 'ARCHIVED'::comment_status
+  AND archived_at <= @archived_before::timestamptz
+ORDER BY archived_at
+LIMIT @batch_size;
+-- name: DeleteCommentsByUidsOrRoots :many
+-- Deleting a top-level comment takes its replies with it.
+DELETE FROM post_comments
+WHERE uid = ANY(@uids::uuid [])
+  OR root_uid = ANY(@uids::uuid [])
+RETURNING uid,
+  images,
+  attachments;
+-- name: DeleteCommentsByPostUids :many
+DELETE FROM post_comments
+WHERE post_uid = ANY(@post_uids::uuid [])
+RETURNING uid,
+  images,
+  attachments;
 -- name: GetCommentMetaByUid :one
 SELECT post_uid,
   author_uid,
@@ -52,9 +140,14 @@ SELECT c.uid,
   c.reply_to_author_uid,
   c.content,
   c.images,
+  c.attachments,
   c.reply_count,
   c.like_count,
+  c.ip,
+  c.ip_region,
   (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
   c.created_at,
   c.updated_at
 FROM post_comments c
@@ -67,6 +160,48 @@ FROM post_comments c
 WHERE c.status = 'NORMAL'::comment_status
   AND c.uid = @uid
 LIMIT 1;
+-- name: GetCommentForUpdateByUid :one
+SELECT author_uid,
+  content,
+  created_at
+FROM post_comments
+WHERE uid = @uid
+  AND status = 'NORMAL'::comment_status
+LIMIT 1 FOR UPDATE;
+-- name: InsertCommentRevision :exec
+INSERT INTO comment_revisions (comment_uid, content)
+VALUES (@comment_uid, @content);
+-- name: UpdateCommentContent :one
+UPDATE post_comments
+SET content = @content,
+  edited = true,
+  updated_at = now()
+WHERE uid = @uid
+  AND status = 'NORMAL'::comment_status
+RETURNING updated_at;
+-- name: ListCommentRevisions :many
+SELECT r.content,
+  r.created_at
+FROM comment_revisions r
+  JOIN post_comments c ON c.uid = r.comment_uid
+  AND c.status = 'NORMAL'::comment_status
+WHERE r.comment_uid = @comment_uid
+ORDER BY r.id DESC;
+-- name: CountPinnedComments :one
+SELECT COUNT(*)::int4
+FROM post_comments
+WHERE post_uid = @post_uid
+  AND status = 'NORMAL'::comment_status
+  AND pinned_at IS NOT NULL;
+-- name: SetCommentPinned :execrows
+UPDATE post_comments
+SET pinned_at = CASE
+    WHEN @pinned::boolean THEN COALESCE(pinned_at, now())
+  END
+WHERE uid = @uid
+  AND post_uid = @post_uid
+  AND parent_uid IS NULL
+  AND status = 'NORMAL'::comment_status;
 -- name: InsertCommentLikeEdge :execrows
 INSERT INTO comment_likes (comment_uid, user_uid)
 VALUES (@comment_uid, @user_uid)
@@ -91,7 +226,7 @@ RETURNING like_count::int4;
 SELECT like_count::int4
 FROM post_comments
 WHERE uid = @comment_uid;
--- name: ListTopComments :many
+-- name: ListPinnedComments :many
 SELECT c.uid,
   u.uid AS author_uid,
   u.nickname AS author_nickname,
@@ -104,9 +239,15 @@ SELECT c.uid,
   c.reply_to_author_uid,
   c.content,
   c.images,
+  c.attachments,
   c.reply_count,
   c.like_count,
+  c.ip,
+  c.ip_region,
   (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.best_score,
   c.created_at,
   c.updated_at
 FROM post_comments c
@@ -119,19 +260,176 @@ FROM post_comments c
 WHERE c.status = 'NORMAL'::comment_status
   AND c.post_uid = @post_uid
   AND c.parent_uid IS NULL
-  AND (
-    (
-      sqlc.narg(cursor_created_at)::timestamptz IS NULL
-      AND sqlc.narg(cursor_id)::uuid IS NULL
-    )
-    OR (c.created_at, c.uid) < (
-      sqlc.narg(cursor_created_at)::timestamptz,
-      sqlc.narg(cursor_id)::uuid
-    )
+  AND c.pinned_at IS NOT NULL
+ORDER BY c.pinned_at DESC;
+-- name: ListTopCommentsNewest :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.best_score,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = sqlc.narg(viewer)::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.post_uid = @post_uid
+  AND c.parent_uid IS NULL
+  AND c.pinned_at IS NULL
+  AND (c.created_at, c.uid) < (
+    sqlc.arg(cursor_created_at)::timestamptz,
+    sqlc.arg(cursor_id)::uuid
   )
 ORDER BY c.created_at DESC,
   c.uid DESC
 LIMIT 20;
+-- name: ListTopCommentsOldest :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.best_score,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = sqlc.narg(viewer)::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.post_uid = @post_uid
+  AND c.parent_uid IS NULL
+  AND c.pinned_at IS NULL
+  AND (c.created_at, c.uid) > (
+    sqlc.arg(cursor_created_at)::timestamptz,
+    sqlc.arg(cursor_id)::uuid
+  )
+ORDER BY c.created_at ASC,
+  c.uid ASC
+LIMIT 20;
+-- name: ListTopCommentsMostLiked :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.best_score,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = sqlc.narg(viewer)::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.post_uid = @post_uid
+  AND c.parent_uid IS NULL
+  AND c.pinned_at IS NULL
+  AND (c.like_count, c.uid) < (
+    sqlc.arg(cursor_like_count)::int4,
+    sqlc.arg(cursor_id)::uuid
+  )
+ORDER BY c.like_count DESC,
+  c.uid DESC
+LIMIT 20;
+-- name: ListTopCommentsBest :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.best_score,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = sqlc.narg(viewer)::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.post_uid = @post_uid
+  AND c.parent_uid IS NULL
+  AND c.pinned_at IS NULL
+  AND (c.best_score, c.uid) < (
+    sqlc.arg(cursor_best_score)::float8,
+    sqlc.arg(cursor_id)::uuid
+  )
+ORDER BY c.best_score DESC,
+  c.uid DESC
+LIMIT 20;
 -- name: ListReplies :many
 SELECT c.uid,
   u.uid AS author_uid,
@@ -145,9 +443,13 @@ SELECT c.uid,
   c.reply_to_author_uid,
   c.content,
   c.images,
+  c.attachments,
   c.reply_count,
   c.like_count,
+  c.ip,
+  c.ip_region,
   (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
   c.created_at,
   c.updated_at,
   COUNT(*) OVER ()::int AS total
@@ -164,6 +466,107 @@ WHERE c.status = 'NORMAL'::comment_status
 ORDER BY c.created_at ASC,
   c.uid ASC
 LIMIT 10 OFFSET (sqlc.arg(page)::int - 1) * 10;
+-- name: ListCommentAncestors :many
+-- Walks parent_uid up from the comment, returning the chain root first.
+-- Deleted ancestors are skipped but still walked through.
+WITH RECURSIVE chain AS (
+  SELECT p.uid,
+    p.parent_uid,
+    1 AS depth
+  FROM post_comments t
+    JOIN post_comments p ON p.uid = t.parent_uid
+  WHERE t.uid = @uid
+  UNION ALL
+  SELECT p.uid,
+    p.parent_uid,
+    ch.depth + 1
+  FROM chain ch
+    JOIN post_comments p ON p.uid = ch.parent_uid
+)
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.created_at,
+  c.updated_at
+FROM chain ch
+  JOIN post_comments c ON c.uid = ch.uid
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = sqlc.narg(viewer)::uuid
+WHERE c.status = 'NORMAL'::comment_status
+ORDER BY ch.depth DESC;
+-- name: ListDirectReplies :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = sqlc.narg(viewer)::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.parent_uid = @parent_uid::uuid
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (c.created_at, c.uid) > (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY c.created_at ASC,
+  c.uid ASC
+LIMIT 10;
+-- name: CountDirectReplies :one
+SELECT COUNT(*)::int
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.parent_uid = @parent_uid::uuid;
 -- name: IncrementPostCommentCount :one
 UPDATE posts
 SET comment_count = comment_count + 1,
@@ -193,3 +596,132 @@ SET reply_count = GREATEST(reply_count - 1, 0),
 WHERE uid = @comment_uid
   AND status = 'NORMAL'::comment_status
 RETURNING reply_count;
+-- name: RefreshCommentBestScores :exec
+-- Only top-level comments are ranked. Age stops counting after the window,
+-- so older comments keep a score comparable to each other and are refreshed
+-- only when their likes or replies change.
+WITH scored AS (
+  SELECT c.uid,
+    (c.like_count + 2 * c.reply_count)::float8 / power(
+      LEAST(
+        extract(
+          epoch
+          FROM now() - c.created_at
+        )::float8 / 3600,
+        @window_hours::int
+      ) + 2,
+      @gravity::float8
+    ) AS score
+  FROM post_comments c
+  WHERE c.status = 'NORMAL'::comment_status
+    AND c.parent_uid IS NULL
+    AND (
+      c.created_at > now() - make_interval(hours => @window_hours::int)
+      OR c.updated_at > @changed_since::timestamptz
+    )
+)
+UPDATE post_comments c
+SET best_score = s.score
+FROM scored s
+WHERE c.uid = s.uid
+  AND c.best_score <> s.score;
+-- name: GetCommentSearchDocByUid :one
+SELECT c.uid,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.author_uid,
+  u.nickname AS author_nickname,
+  c.content,
+  c.images,
+  c.reply_count,
+  c.like_count,
+  c.status,
+  p.author AS post_author_uid,
+  p.visibility AS post_visibility,
+  p.status AS post_status,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  JOIN posts p ON p.uid = c.post_uid
+WHERE c.uid = @uid
+LIMIT 1;
+-- name: ListCommentSearchDocsByPostUid :many
+SELECT c.uid,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.author_uid,
+  u.nickname AS author_nickname,
+  c.content,
+  c.images,
+  c.reply_count,
+  c.like_count,
+  c.status,
+  p.author AS post_author_uid,
+  p.visibility AS post_visibility,
+  p.status AS post_status,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  JOIN posts p ON p.uid = c.post_uid
+WHERE c.post_uid = @post_uid
+  AND c.status = 'NORMAL'::comment_status
+ORDER BY c.created_at;
+-- name: ListPostUidsWithCommentsAfter :many
+SELECT DISTINCT c.post_uid
+FROM post_comments c
+WHERE c.status = 'NORMAL'::comment_status
+  AND c.post_uid > @after_uid::uuid
+ORDER BY c.post_uid
+LIMIT @limit_count;
+-- name: ListSearchedCommentsByUids :many
+-- Search hits are re-checked here so a stale index never leaks comments
+-- under posts the viewer can no longer see.
+WITH input AS (
+  SELECT DISTINCT ON (x.uid) x.uid,
+    x.ord
+  FROM unnest(@uids::uuid []) WITH ORDINALITY AS x(uid, ord)
+  ORDER BY x.uid,
+    x.ord
+)
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  ru.nickname AS reply_to_author_nickname,
+  ru.avatar_url AS reply_to_author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.images,
+  c.attachments,
+  c.reply_count,
+  c.like_count,
+  c.ip,
+  c.ip_region,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.edited,
+  (c.pinned_at IS NOT NULL)::boolean AS pinned,
+  c.created_at,
+  c.updated_at
+FROM input i
+  JOIN post_comments c ON c.uid = i.uid
+  JOIN posts p ON p.uid = c.post_uid
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN users ru ON ru.uid = c.reply_to_author_uid
+  AND ru.status = 'NORMAL'::user_status
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = sqlc.narg(viewer)::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND p.status = 'NORMAL'::post_status
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = sqlc.narg(viewer)::uuid
+  )
+ORDER BY i.ord;
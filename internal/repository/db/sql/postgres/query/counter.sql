@@ -0,0 +1,126 @@
+-- name: GetMaxPostID :one
+SELECT COALESCE(max(id), 0)::int4
+FROM posts;
+-- name: GetMaxCommentID :one
+SELECT COALESCE(max(id), 0)::int4
+FROM post_comments;
+-- name: GetMaxUserID :one
+SELECT COALESCE(max(id), 0)::int4
+FROM users;
+-- name: ReconcilePostCounters :many
+WITH actual AS (
+  SELECT p.id,
+    p.like_count AS stored_like_count,
+    p.collection_count AS stored_collection_count,
+    p.comment_count AS stored_comment_count,
+    (
+      SELECT count(*)
+      FROM post_likes pl
+      WHERE pl.post_uid = p.uid
+    )::int4 AS like_count,
+    (
+      SELECT count(*)
+      FROM post_collections pc
+      WHERE pc.post_uid = p.uid
+    )::int4 AS collection_count,
+    (
+      SELECT count(*)
+      FROM post_comments c
+      WHERE c.post_uid = p.uid
+        AND c.parent_uid IS NULL
+        AND c.status = 'NORMAL'::comment_status
+    )::int4 AS comment_count
+  FROM posts p
+  WHERE p.id > @after_id::int4
+    AND p.id <= @through_id::int4
+)
+UPDATE posts p
+SET like_count = a.like_count,
+  collection_count = a.collection_count,
+  comment_count = a.comment_count
+FROM actual a
+WHERE p.id = a.id
+  AND (
+    a.stored_like_count <> a.like_count
+    OR a.stored_collection_count <> a.collection_count
+    OR a.stored_comment_count <> a.comment_count
+  )
+RETURNING p.uid,
+  a.stored_like_count,
+  a.like_count,
+  a.stored_collection_count,
+  a.collection_count,
+  a.stored_comment_count,
+  a.comment_count;
+-- name: ReconcileCommentCounters :many
+WITH actual AS (
+  SELECT c.id,
+    c.like_count AS stored_like_count,
+    c.reply_count AS stored_reply_count,
+    (
+      SELECT count(*)
+      FROM comment_likes cl
+      WHERE cl.comment_uid = c.uid
+    )::int4 AS like_count,
+    CASE
+      WHEN c.parent_uid IS NULL THEN (
+        SELECT count(*)
+        FROM post_comments r
+        WHERE r.root_uid = c.uid
+          AND r.uid <> c.uid
+          AND r.status = 'NORMAL'::comment_status
+      )
+      ELSE 0
+    END::int4 AS reply_count
+  FROM post_comments c
+  WHERE c.id > @after_id::int4
+    AND c.id <= @through_id::int4
+)
+UPDATE post_comments c
+SET like_count = a.like_count,
+  reply_count = a.reply_count
+FROM actual a
+WHERE c.id = a.id
+  AND (
+    a.stored_like_count <> a.like_count
+    OR a.stored_reply_count <> a.reply_count
+  )
+RETURNING c.uid,
+  c.post_uid,
+  a.stored_like_count,
+  a.like_count,
+  a.stored_reply_count,
+  a.reply_count;
+-- name: ReconcileUserCounters :many
+WITH actual AS (
+  SELECT u.id,
+    u.followers_count AS stored_followers_count,
+    u.following_count AS stored_following_count,
+    (
+      SELECT count(*)
+      FROM user_follows uf
+      WHERE uf.followee_uid = u.uid
+    )::int4 AS followers_count,
+    (
+      SELECT count(*)
+      FROM user_follows uf
+      WHERE uf.follower_uid = u.uid
+    )::int4 AS following_count
+  FROM users u
+  WHERE u.id > @after_id::int4
+    AND u.id <= @through_id::int4
+)
+UPDATE users u
+SET followers_count = a.followers_count,
+  following_count = a.following_count
+FROM actual a
+WHERE u.id = a.id
+  AND (
+    a.stored_followers_count <> a.followers_count
+    OR a.stored_following_count <> a.following_count
+  )
+RETURNING u.uid,
+  a.stored_followers_count,
+  a.followers_count,
+  a.stored_following_count,
+  a.following_count;
@@ -33,4 +33,49 @@ SELECT url,
   checksum
 FROM files
 WHERE status = 'NORMAL'::file_status
-  AND url = ANY(@urls::text []);
\ No newline at end of file
+  AND url = ANY(@urls::text []);
+-- name: DeleteUnreferencedFilesByUrls :many
+-- Removes file rows no post, comment or avatar points at anymore.
+DELETE FROM files f
+WHERE f.url = ANY(@urls::text [])
+  AND NOT EXISTS (
+    SELECT 1
+    FROM post_files pf
+    WHERE pf.file_id = f.id
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM comment_files cf
+    WHERE cf.file_id = f.id
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM users u
+    WHERE u.avatar_url = f.url
+  )
+RETURNING f.url;
+-- name: LockFilesByUrls :many
+SELECT id,
+  url,
+  content_type,
+  uploader,
+  status
+FROM files
+WHERE url = ANY(@urls::text [])
+ORDER BY id FOR SHARE;
+-- name: DeletePostFilesByKind :exec
+DELETE FROM post_files
+WHERE post_uid = @post_uid
+  AND kind = @kind;
+-- name: InsertPostFiles :exec
+INSERT INTO post_files (post_uid, file_id, kind)
+SELECT @post_uid,
+  unnest(@file_ids::int4 []),
+  @kind
+ON CONFLICT DO NOTHING;
+-- name: InsertCommentFiles :exec
+INSERT INTO comment_files (comment_uid, file_id, kind)
+SELECT @comment_uid,
+  unnest(@file_ids::int4 []),
+  @kind
+ON CONFLICT DO NOTHING;
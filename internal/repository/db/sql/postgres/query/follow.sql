@@ -72,11 +72,90 @@ SELECT uf.created_at AS followed_at,
   u.avatar_url,
   u.followers_count,
   u.following_count,
+  (back.follower_uid IS NOT NULL)::boolean AS follows_you,
   u.status,
   u.created_at
 FROM user_follows uf
   JOIN users u ON u.uid = uf.followee_uid
   AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows back ON back.follower_uid = uf.followee_uid
+  AND back.followee_uid = @uid
+WHERE uf.follower_uid = @uid
+  AND (
+    sqlc.narg(query)::text IS NULL
+    OR u.nickname ILIKE '%' || sqlc.narg(query)::text || '%'
+  )
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (uf.created_at, uf.followee_uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY uf.created_at DESC,
+  uf.followee_uid DESC
+LIMIT 20;
+-- name: ListUserFollowers :many
+-- Followers of any user, with flags relative to the viewer.
+SELECT uf.created_at AS followed_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (vf.follower_uid IS NOT NULL)::boolean AS is_following,
+  (fv.follower_uid IS NOT NULL)::boolean AS follows_you,
+  u.status,
+  u.created_at
+FROM user_follows uf
+  JOIN users u ON u.uid = uf.follower_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows vf ON vf.follower_uid = sqlc.narg(viewer)::uuid
+  AND vf.followee_uid = uf.follower_uid
+  LEFT JOIN user_follows fv ON fv.follower_uid = uf.follower_uid
+  AND fv.followee_uid = sqlc.narg(viewer)::uuid
+WHERE uf.followee_uid = @uid
+  AND (
+    sqlc.narg(query)::text IS NULL
+    OR u.nickname ILIKE '%' || sqlc.narg(query)::text || '%'
+  )
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (uf.created_at, uf.follower_uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY uf.created_at DESC,
+  uf.follower_uid DESC
+LIMIT 20;
+-- name: ListUserFollowing :many
+-- Users any user follows, with flags relative to the viewer.
+SELECT uf.created_at AS followed_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (vf.follower_uid IS NOT NULL)::boolean AS is_following,
+  (fv.follower_uid IS NOT NULL)::boolean AS follows_you,
+  u.status,
+  u.created_at
+FROM user_follows uf
+  JOIN users u ON u.uid = uf.followee_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows vf ON vf.follower_uid = sqlc.narg(viewer)::uuid
+  AND vf.followee_uid = uf.followee_uid
+  LEFT JOIN user_follows fv ON fv.follower_uid = uf.followee_uid
+  AND fv.followee_uid = sqlc.narg(viewer)::uuid
 WHERE uf.follower_uid = @uid
   AND (
     sqlc.narg(query)::text IS NULL
@@ -102,3 +181,48 @@ SELECT EXISTS(
     WHERE follower_uid = @follower_uid
       AND followee_uid = @followee_uid
   ) AS is_following;
+-- name: ListFollowersAmongUids :many
+-- Which of the given users follow the viewer, for annotating lists in one
+-- round trip.
+SELECT follower_uid
+FROM user_follows
+WHERE followee_uid = @viewer
+  AND follower_uid = ANY(@uids::uuid []);
+-- name: ListMutualFollowers :many
+-- Users the viewer follows who also follow the target user.
+SELECT uf.created_at AS followed_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  u.status,
+  u.created_at
+FROM user_follows mine
+  JOIN user_follows uf ON uf.follower_uid = mine.followee_uid
+  AND uf.followee_uid = @uid
+  JOIN users u ON u.uid = mine.followee_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE mine.follower_uid = @viewer
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (uf.created_at, uf.follower_uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY uf.created_at DESC,
+  uf.follower_uid DESC
+LIMIT 20;
+-- name: CountMutualFollowers :one
+SELECT COUNT(*)::int
+FROM user_follows mine
+  JOIN user_follows uf ON uf.follower_uid = mine.followee_uid
+  AND uf.followee_uid = @uid
+  JOIN users u ON u.uid = mine.followee_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE mine.follower_uid = @viewer;
@@ -0,0 +1,90 @@
+-- name: ExportFollowing :many
+SELECT u.uid,
+  u.nickname,
+  uf.created_at AS followed_at
+FROM user_follows uf
+  JOIN users u ON u.uid = uf.followee_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE uf.follower_uid = @uid
+ORDER BY uf.created_at DESC,
+  uf.followee_uid DESC;
+-- name: ExportFollowers :many
+SELECT u.uid,
+  u.nickname,
+  uf.created_at AS followed_at
+FROM user_follows uf
+  JOIN users u ON u.uid = uf.follower_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE uf.followee_uid = @uid
+ORDER BY uf.created_at DESC,
+  uf.follower_uid DESC;
+-- name: CreateFollowImport :exec
+INSERT INTO follow_imports (uid, user_uid, file_url)
+VALUES (@uid, @user_uid, @file_url);
+-- name: GetFollowImportByUid :one
+SELECT uid,
+  user_uid,
+  file_url,
+  status,
+  total_count,
+  processed_count,
+  followed_count,
+  already_following_count,
+  not_found_count,
+  skipped_count,
+  error,
+  parsed_at,
+  created_at,
+  finished_at
+FROM follow_imports
+WHERE uid = @uid;
+-- name: UpdateFollowImportProgress :exec
+UPDATE follow_imports
+SET status = @status,
+  total_count = @total_count,
+  processed_count = @processed_count,
+  followed_count = followed_count + @followed_count,
+  already_following_count = already_following_count + @already_following_count,
+  not_found_count = not_found_count + @not_found_count,
+  skipped_count = skipped_count + @skipped_count,
+  error = @error,
+  finished_at = CASE
+    WHEN @status::follow_import_status = 'RUNNING'::follow_import_status THEN NULL
+    ELSE now()
+  END
+WHERE uid = @uid
+  AND status = 'RUNNING'::follow_import_status;
+-- name: MarkFollowImportParsed :exec
+UPDATE follow_imports
+SET total_count = @total_count,
+  parsed_at = now()
+WHERE uid = @uid
+  AND status = 'RUNNING'::follow_import_status;
+-- name: CreateFollowImportEntries :exec
+INSERT INTO follow_import_entries (import_uid, position, uid, nickname)
+SELECT @import_uid,
+  x.ord - 1,
+  NULLIF(x.uid, '00000000-0000-0000-0000-000000000000'::uuid),
+  n.nickname
+FROM unnest(@uids::uuid []) WITH ORDINALITY AS x(uid, ord)
+  JOIN unnest(@nicknames::text []) WITH ORDINALITY AS n(nickname, ord) ON n.ord = x.ord;
+-- name: ListFollowImportEntries :many
+SELECT uid,
+  nickname
+FROM follow_import_entries
+WHERE import_uid = @import_uid
+  AND position >= @start_position
+ORDER BY position
+LIMIT @limit_count;
+-- name: DeleteFollowImportEntries :exec
+DELETE FROM follow_import_entries
+WHERE import_uid = @import_uid;
+-- name: ResolveFollowImportUsers :many
+SELECT uid,
+  nickname
+FROM users
+WHERE status = 'NORMAL'::user_status
+  AND (
+    uid = ANY(@uids::uuid [])
+    OR nickname = ANY(@nicknames::text [])
+  );
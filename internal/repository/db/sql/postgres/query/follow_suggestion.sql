@@ -0,0 +1,197 @@
+-- name: ListUserUidsAfter :many
+SELECT uid
+FROM users
+WHERE status = 'NORMAL'::user_status
+  AND (
+    sqlc.narg(after_uid)::uuid IS NULL
+    OR uid > sqlc.narg(after_uid)::uuid
+  )
+ORDER BY uid
+LIMIT @batch_size;
+-- name: DeleteFollowSuggestionsByUsers :exec
+DELETE FROM user_follow_suggestions
+WHERE user_uid = ANY(@user_uids::uuid []);
+-- name: InsertFollowSuggestionsForUsers :exec
+-- Candidates come from friends of friends, authors posting under tags the
+-- user follows, and popular active users as a cold-start fallback. Each
+-- signal is weighted, plus a boost for authors who posted recently.
+WITH viewers AS (
+  SELECT unnest(@user_uids::uuid []) AS uid
+),
+fof AS (
+  SELECT v.uid AS user_uid,
+    f2.followee_uid AS candidate_uid,
+    COUNT(*)::int AS mutual_count,
+    0 AS shared_tag_count
+  FROM viewers v
+    JOIN user_follows f1 ON f1.follower_uid = v.uid
+    JOIN user_follows f2 ON f2.follower_uid = f1.followee_uid
+  GROUP BY v.uid,
+    f2.followee_uid
+),
+tagged AS (
+  SELECT v.uid AS user_uid,
+    p.author AS candidate_uid,
+    0 AS mutual_count,
+    COUNT(DISTINCT pt.tag_id)::int AS shared_tag_count
+  FROM viewers v
+    JOIN tag_follows tf ON tf.user_uid = v.uid
+    JOIN tags t ON t.id = tf.tag_id
+    AND NOT t.banned
+    JOIN post_tags pt ON pt.tag_id = tf.tag_id
+    JOIN posts p ON p.id = pt.post_id
+    AND p.status = 'NORMAL'::post_status
+    AND p.visibility = 'PUBLIC'::post_visibility
+    AND p.created_at > now() - make_interval(days => @activity_days::int)
+  GROUP BY v.uid,
+    p.author
+),
+popular AS (
+  SELECT v.uid AS user_uid,
+    u.uid AS candidate_uid,
+    0 AS mutual_count,
+    0 AS shared_tag_count
+  FROM viewers v
+    CROSS JOIN (
+      SELECT u.uid
+      FROM users u
+      WHERE u.status = 'NORMAL'::user_status
+        AND EXISTS (
+          SELECT 1
+          FROM posts p
+          WHERE p.author = u.uid
+            AND p.status = 'NORMAL'::post_status
+            AND p.created_at > now() - make_interval(days => @activity_days::int)
+        )
+      ORDER BY u.followers_count DESC,
+        u.uid
+      LIMIT @per_user::int
+    ) u
+),
+candidates AS (
+  SELECT x.user_uid,
+    x.candidate_uid,
+    SUM(x.mutual_count)::int AS mutual_count,
+    SUM(x.shared_tag_count)::int AS shared_tag_count
+  FROM (
+      SELECT user_uid,
+        candidate_uid,
+        mutual_count,
+        shared_tag_count
+      FROM fof
+      UNION ALL
+      SELECT user_uid,
+        candidate_uid,
+        mutual_count,
+        shared_tag_count
+      FROM tagged
+      UNION ALL
+      SELECT user_uid,
+        candidate_uid,
+        mutual_count,
+        shared_tag_count
+      FROM popular
+    ) x
+  WHERE x.candidate_uid <> x.user_uid
+    AND NOT EXISTS (
+      SELECT 1
+      FROM user_follows f
+      WHERE f.follower_uid = x.user_uid
+        AND f.followee_uid = x.candidate_uid
+    )
+    AND NOT EXISTS (
+      SELECT 1
+      FROM user_follow_suggestion_dismissals d
+      WHERE d.user_uid = x.user_uid
+        AND d.suggested_uid = x.candidate_uid
+    )
+  GROUP BY x.user_uid,
+    x.candidate_uid
+),
+scored AS (
+  SELECT c.user_uid,
+    c.candidate_uid,
+    c.mutual_count,
+    c.shared_tag_count,
+    (
+      sqlc.arg(mutual_weight)::float8 * c.mutual_count
+      + sqlc.arg(tag_weight)::float8 * c.shared_tag_count
+      + sqlc.arg(popularity_weight)::float8 * ln(1 + u.followers_count)
+      + sqlc.arg(activity_weight)::float8 * COALESCE(lp.recency, 0)
+    ) AS score
+  FROM candidates c
+    JOIN users u ON u.uid = c.candidate_uid
+    AND u.status = 'NORMAL'::user_status
+    LEFT JOIN LATERAL (
+      SELECT GREATEST(
+          0,
+          1 - extract(
+            epoch
+            FROM now() - p.created_at
+          )::float8 / 86400 / @activity_days::int
+        ) AS recency
+      FROM posts p
+      WHERE p.author = c.candidate_uid
+        AND p.status = 'NORMAL'::post_status
+      ORDER BY p.created_at DESC
+      LIMIT 1
+    ) lp ON true
+),
+ranked AS (
+  SELECT s.user_uid,
+    s.candidate_uid,
+    s.mutual_count,
+    s.shared_tag_count,
+    s.score,
+    row_number() OVER (
+      PARTITION BY s.user_uid
+      ORDER BY s.score DESC,
+        s.candidate_uid
+    ) AS rn
+  FROM scored s
+)
+INSERT INTO user_follow_suggestions (
+    user_uid,
+    suggested_uid,
+    score,
+    mutual_count,
+    shared_tag_count
+  )
+SELECT r.user_uid,
+  r.candidate_uid,
+  r.score,
+  r.mutual_count,
+  r.shared_tag_count
+FROM ranked r
+WHERE r.rn <= @per_user::int;
+-- name: ListFollowSuggestions :many
+SELECT s.mutual_count,
+  s.shared_tag_count,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  u.description
+FROM user_follow_suggestions s
+  JOIN users u ON u.uid = s.suggested_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE s.user_uid = @user_uid
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_follows f
+    WHERE f.follower_uid = s.user_uid
+      AND f.followee_uid = s.suggested_uid
+  )
+ORDER BY s.score DESC,
+  s.suggested_uid
+LIMIT 20;
+-- name: InsertFollowSuggestionDismissal :exec
+INSERT INTO user_follow_suggestion_dismissals (user_uid, suggested_uid)
+VALUES (@user_uid, @suggested_uid)
+ON CONFLICT DO NOTHING;
+-- name: DeleteFollowSuggestion :exec
+DELETE FROM user_follow_suggestions
+WHERE user_uid = @user_uid
+  AND suggested_uid = @suggested_uid;
@@ -33,6 +33,76 @@ WHERE NOT EXISTS (
       AND im.type = 'FOLLOW'::message_type
       AND im.status = 'NORMAL'::message_status
   );
+-- name: CreatePostLikeInboxMessage :execrows
+INSERT INTO inbox_messages (uid, receiver_uid, type, actor_uid, post_uid)
+SELECT @uid,
+  @receiver_uid,
+  'LIKE'::message_type,
+  @actor_uid,
+  @post_uid
+WHERE EXISTS (
+    SELECT 1
+    FROM post_likes e
+    WHERE e.post_uid = @post_uid
+      AND e.user_uid = @actor_uid
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM inbox_messages im
+    WHERE im.receiver_uid = @receiver_uid
+      AND im.actor_uid = @actor_uid
+      AND im.type = 'LIKE'::message_type
+      AND im.post_uid = @post_uid
+      AND im.comment_uid IS NULL
+      AND im.status = 'NORMAL'::message_status
+  );
+-- name: CreatePostCollectInboxMessage :execrows
+INSERT INTO inbox_messages (uid, receiver_uid, type, actor_uid, post_uid)
+SELECT @uid,
+  @receiver_uid,
+  'COLLECT'::message_type,
+  @actor_uid,
+  @post_uid
+WHERE EXISTS (
+    SELECT 1
+    FROM post_collections e
+    WHERE e.post_uid = @post_uid
+      AND e.user_uid = @actor_uid
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM inbox_messages im
+    WHERE im.receiver_uid = @receiver_uid
+      AND im.actor_uid = @actor_uid
+      AND im.type = 'COLLECT'::message_type
+      AND im.post_uid = @post_uid
+      AND im.comment_uid IS NULL
+      AND im.status = 'NORMAL'::message_status
+  );
+-- name: CreateCommentLikeInboxMessage :execrows
+INSERT INTO inbox_messages (uid, receiver_uid, type, actor_uid, comment_uid, post_uid)
+SELECT @uid,
+  @receiver_uid,
+  'LIKE'::message_type,
+  @actor_uid,
+  @comment_uid,
+  @post_uid
+WHERE EXISTS (
+    SELECT 1
+    FROM comment_likes e
+    WHERE e.comment_uid = @comment_uid
+      AND e.user_uid = @actor_uid
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM inbox_messages im
+    WHERE im.receiver_uid = @receiver_uid
+      AND im.actor_uid = @actor_uid
+      AND im.type = 'LIKE'::message_type
+      AND im.post_uid = @post_uid
+      AND im.comment_uid = @comment_uid
+      AND im.status = 'NORMAL'::message_status
+  );
 -- name: ArchiveInboxMessageByUidAndReceiver :execrows
 UPDATE inbox_messages
 SET status = 'ARCHIVED'::message_status
@@ -51,6 +121,8 @@ SELECT m.uid,
   m.status,
   m.comment_uid,
   c.content AS comment_content,
+  c.images AS comment_images,
+  c.attachments AS comment_attachments,
   m.post_uid,
   m.parent_uid,
   COALESCE(pc.content, p.text, ''::text) AS parent_content
@@ -116,6 +188,47 @@ WHERE m.receiver_uid = @receiver_uid
 ORDER BY m.created_at DESC,
   m.uid DESC
 LIMIT 20;
+-- name: ListLikeInboxMessages :many
+SELECT m.uid,
+  m.receiver_uid,
+  m.type,
+  m.is_read,
+  m.actor_uid,
+  u.nickname AS actor_nickname,
+  u.avatar_url AS actor_avatar_url,
+  m.created_at,
+  m.status,
+  m.post_uid,
+  p.text AS post_text,
+  m.comment_uid,
+  c.content AS comment_content
+FROM inbox_messages m
+  JOIN users u ON u.uid = m.actor_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN posts p ON p.uid = m.post_uid
+  AND p.status = 'NORMAL'::post_status
+  LEFT JOIN post_comments c ON c.uid = m.comment_uid
+  AND c.status = 'NORMAL'::comment_status
+WHERE m.receiver_uid = @receiver_uid
+  AND m.status = 'NORMAL'::message_status
+  AND m.type IN ('LIKE'::message_type, 'COLLECT'::message_type)
+  AND (
+    sqlc.narg(is_read)::boolean IS NULL
+    OR m.is_read = sqlc.narg(is_read)::boolean
+  )
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (m.created_at, m.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY m.created_at DESC,
+  m.uid DESC
+LIMIT 20;
 -- name: MarkInboxMessagesReadByUidsAndReceiver :execrows
 UPDATE inbox_messages
 SET is_read = true
@@ -136,8 +249,72 @@ SELECT COUNT(*)::int4 AS unread_count,
     )::int4 AS follow_unread_count,
   COUNT(*) FILTER (
       WHERE type = 'COMMENT'::message_type
-    )::int4 AS comment_unread_count
+    )::int4 AS comment_unread_count,
+  COUNT(*) FILTER (
+      WHERE type IN ('LIKE'::message_type, 'COLLECT'::message_type)
+    )::int4 AS like_unread_count,
+  COUNT(*) FILTER (
+      WHERE type = 'FOLLOW_IMPORT'::message_type
+    )::int4 AS system_unread_count
 FROM inbox_messages
 WHERE receiver_uid = @receiver_uid
   AND status = 'NORMAL'::message_status
   AND is_read = false;
+-- name: DeleteInboxMessagesByPostUids :exec
+DELETE FROM inbox_messages
+WHERE post_uid = ANY(@post_uids::uuid [])
+  OR parent_uid = ANY(@post_uids::uuid []);
+-- name: DeleteInboxMessagesByCommentUids :exec
+DELETE FROM inbox_messages
+WHERE comment_uid = ANY(@comment_uids::uuid [])
+  OR parent_uid = ANY(@comment_uids::uuid []);
+-- name: CreateFollowImportInboxMessage :exec
+INSERT INTO inbox_messages (
+    uid,
+    receiver_uid,
+    type,
+    actor_uid,
+    follow_import_uid
+  )
+VALUES (
+    @uid,
+    @receiver_uid,
+    'FOLLOW_IMPORT'::message_type,
+    @receiver_uid,
+    @follow_import_uid
+  );
+-- name: ListSystemInboxMessages :many
+SELECT m.uid,
+  m.type,
+  m.is_read,
+  m.created_at,
+  fi.uid AS follow_import_uid,
+  fi.status AS follow_import_status,
+  fi.total_count AS follow_import_total_count,
+  fi.followed_count AS follow_import_followed_count,
+  fi.already_following_count AS follow_import_already_following_count,
+  fi.not_found_count AS follow_import_not_found_count,
+  fi.skipped_count AS follow_import_skipped_count,
+  fi.error AS follow_import_error
+FROM inbox_messages m
+  JOIN follow_imports fi ON fi.uid = m.follow_import_uid
+WHERE m.receiver_uid = @receiver_uid
+  AND m.status = 'NORMAL'::message_status
+  AND m.type = 'FOLLOW_IMPORT'::message_type
+  AND (
+    sqlc.narg(is_read)::boolean IS NULL
+    OR m.is_read = sqlc.narg(is_read)::boolean
+  )
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (m.created_at, m.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY m.created_at DESC,
+  m.uid DESC
+LIMIT 20;
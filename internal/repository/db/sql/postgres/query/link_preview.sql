@@ -0,0 +1,39 @@
+-- name: GetLinkPreviewFetchedAt :one
+SELECT fetched_at
+FROM link_previews
+WHERE url = @url;
+-- name: UpsertLinkPreview :exec
+INSERT INTO link_previews (
+    url,
+    status,
+    title,
+    description,
+    site_name,
+    image_url,
+    fetched_at
+  )
+VALUES (
+    @url,
+    @status,
+    @title,
+    @description,
+    @site_name,
+    @image_url,
+    now()
+  ) ON CONFLICT (url) DO
+UPDATE
+SET status = EXCLUDED.status,
+  title = EXCLUDED.title,
+  description = EXCLUDED.description,
+  site_name = EXCLUDED.site_name,
+  image_url = EXCLUDED.image_url,
+  fetched_at = EXCLUDED.fetched_at;
+-- name: ListReadyLinkPreviewsByURLs :many
+SELECT url,
+  title,
+  description,
+  site_name,
+  image_url
+FROM link_previews
+WHERE url = ANY(@urls::text [])
+  AND status = 'READY'::link_preview_status;
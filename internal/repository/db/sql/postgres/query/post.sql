@@ -7,7 +7,12 @@ INSERT INTO posts (
     attachments,
     visibility,
     pinned,
-    ip
+    ip,
+    ip_region,
+    content_warning,
+    sensitive_media,
+    comment_policy,
+    link_url
   )
 VALUES (
     @uid,
@@ -20,7 +25,15 @@ VALUES (
       'PUBLIC'::post_visibility
     ),
     @pinned,
-    @ip
+    @ip,
+    @ip_region,
+    @content_warning,
+    @sensitive_media,
+    COALESCE(
+      sqlc.narg(comment_policy)::post_comment_policy,
+      'EVERYONE'::post_comment_policy
+    ),
+    @link_url
   )
 RETURNING id,
   uid;
@@ -42,6 +55,12 @@ WHERE pt.post_id = @post_id
       SELECT DISTINCT unnest(@tags::text[]) AS name
     ) i ON i.name = t.name
     WHERE t.id = pt.tag_id
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM tags b
+    WHERE b.id = pt.tag_id
+      AND b.banned
   );
 -- name: InsertPostTagsByNames :exec
 WITH input AS (
@@ -64,11 +83,18 @@ SELECT p.uid,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.view_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
   p.ip,
+  p.ip_region,
   p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
   p.created_at,
   p.updated_at,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
@@ -82,6 +108,7 @@ SELECT p.uid,
         )
       FROM post_tags pt
         JOIN tags t ON t.id = pt.tag_id
+          AND NOT t.banned
       WHERE pt.post_id = p.id
     ),
     '{}'::text []
@@ -98,6 +125,83 @@ FROM posts p
 WHERE p.uid = @uid
   AND p.status = 'NORMAL'::post_status
 LIMIT 1;
+-- name: GetPostAuthorByUid :one
+SELECT author
+FROM posts
+WHERE uid = @uid
+  AND status = 'NORMAL'::post_status
+LIMIT 1;
+-- name: GetPostVisibilityByUid :one
+SELECT author,
+  visibility,
+  comment_policy
+FROM posts
+WHERE uid = @uid
+  AND status = 'NORMAL'::post_status
+LIMIT 1;
+-- name: LockPostByUid :one
+-- Serializes comment pinning on a post.
+SELECT author
+FROM posts
+WHERE uid = @uid
+  AND status = 'NORMAL'::post_status
+LIMIT 1 FOR UPDATE;
+-- name: ListPostLikers :many
+SELECT pl.created_at AS liked_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (myf.follower_uid IS NOT NULL)::boolean AS following
+FROM post_likes pl
+  JOIN users u ON u.uid = pl.user_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows myf ON myf.follower_uid = sqlc.narg(viewer)::uuid
+  AND myf.followee_uid = pl.user_uid
+WHERE pl.post_uid = @post_uid
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (pl.created_at, pl.user_uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY pl.created_at DESC,
+  pl.user_uid DESC
+LIMIT 20;
+-- name: ListPostCollectors :many
+SELECT pc.created_at AS collected_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (myf.follower_uid IS NOT NULL)::boolean AS following
+FROM post_collections pc
+  JOIN users u ON u.uid = pc.user_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows myf ON myf.follower_uid = sqlc.narg(viewer)::uuid
+  AND myf.followee_uid = pc.user_uid
+WHERE pc.post_uid = @post_uid
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (pc.created_at, pc.user_uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY pc.created_at DESC,
+  pc.user_uid DESC
+LIMIT 20;
 -- name: GetPostSearchExtrasByUids :many
 WITH input AS (
   SELECT DISTINCT ON (x.uid) x.uid,
@@ -138,6 +242,16 @@ SET text = COALESCE(sqlc.narg(text), text),
     visibility
   ),
   pinned = COALESCE(sqlc.narg(pinned)::boolean, pinned),
+  content_warning = COALESCE(sqlc.narg(content_warning), content_warning),
+  sensitive_media = COALESCE(
+    sqlc.narg(sensitive_media)::boolean,
+    sensitive_media
+  ),
+  comment_policy = COALESCE(
+    sqlc.narg(comment_policy)::post_comment_policy,
+    comment_policy
+  ),
+  link_url = COALESCE(sqlc.narg(link_url), link_url),
   updated_at = now()
 WHERE uid = @uid
   AND author = @author
@@ -146,7 +260,106 @@ RETURNING id;
 -- name: ArchivePostByUidAndAuthor :execrows
 UPDATE posts
 SET status = 'ARCHIVED'::post_status,
+  archived_at = now(),
   updated_at = now()
 WHERE uid = @uid
   AND author = @author
   AND status = 'NORMAL'::post_status;
+-- name: RestorePostByUidAndAuthor :execrows
+UPDATE posts
+SET status = 'NORMAL'::post_status,
+  archived_at = NULL,
+  updated_at = now()
+WHERE uid = @uid
+  AND author = @author
+  AND status = 'ARCHIVED'::post_status
+  AND archived_at > @archived_after::timestamptz;
+-- name: ListTrashedPostsByAuthor :many
+SELECT p.uid,
+  p.text,
+  p.images,
+  p.created_at,
+  p.archived_at,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+          AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM posts p
+WHERE p.author = @author
+  AND p.status = 'ARCHIVED'::post_status
+  AND p.archived_at > @archived_after::timestamptz
+  AND (
+    (
+      sqlc.narg(cursor_archived_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (p.archived_at, p.uid) < (
+      sqlc.narg(cursor_archived_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY p.archived_at DESC,
+  p.uid DESC
+LIMIT 20;
+-- name: ListExpiredTrashedPosts :many
+SELECT uid,
+  images,
+  attachments
+FROM posts
+WHERE status = 'ARCHIVED'::post_status
+  AND archived_at <= @archived_before::timestamptz
+ORDER BY archived_at
+LIMIT @batch_size;
+-- name: DeletePostsByUids :exec
+DELETE FROM posts
+WHERE uid = ANY(@uids::uuid []);
+-- name: RefreshPostHotScores :many
+WITH scored AS (
+  SELECT p.uid,
+    CASE
+      WHEN p.created_at > now() - make_interval(hours => @window_hours::int) THEN (
+        p.like_count + 2 * p.comment_count + 3 * p.collection_count
+      )::float8 / power(
+        extract(
+          epoch
+          FROM now() - p.created_at
+        )::float8 / 3600 + 2,
+        @gravity::float8
+      )
+      ELSE 0
+    END AS score
+  FROM posts p
+  WHERE p.status = 'NORMAL'::post_status
+    AND (
+      p.created_at > now() - make_interval(hours => @window_hours::int)
+      OR p.hot_score <> 0
+    )
+)
+UPDATE posts p
+SET hot_score = s.score
+FROM scored s
+WHERE p.uid = s.uid
+  AND p.hot_score <> s.score
+RETURNING p.uid,
+  p.hot_score;
+-- name: IncrementPostViewCounts :many
+WITH input AS (
+  SELECT unnest(@uids::uuid []) AS uid,
+    unnest(@counts::bigint []) AS count
+)
+UPDATE posts p
+SET view_count = p.view_count + i.count
+FROM input i
+WHERE p.uid = i.uid
+  AND p.status = 'NORMAL'::post_status
+RETURNING p.uid,
+  p.view_count;
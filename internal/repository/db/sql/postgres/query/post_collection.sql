@@ -34,11 +34,17 @@ SELECT p.uid,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.view_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
   p.ip,
+  p.ip_region,
   p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
   p.created_at,
   p.updated_at,
   true AS collected,
@@ -52,6 +58,7 @@ SELECT p.uid,
         )
       FROM post_tags pt
         JOIN tags t ON t.id = pt.tag_id
+          AND NOT t.banned
       WHERE pt.post_id = p.id
     ),
     '{}'::text []
@@ -83,3 +90,73 @@ WHERE p.status = 'NORMAL'::post_status
 ORDER BY p.created_at DESC,
   p.uid DESC
 LIMIT 20;
+-- name: ListPostsByCollectionFolder :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.created_at,
+  p.updated_at,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+          AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM collection_folder_items c
+  JOIN posts p ON p.uid = c.post_uid
+  JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = sqlc.narg(viewer)::uuid
+  LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = sqlc.narg(viewer)::uuid
+  LEFT JOIN user_follows uf ON uf.follower_uid = sqlc.narg(viewer)::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND c.folder_uid = @folder_uid
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = sqlc.narg(viewer)::uuid
+  )
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (p.created_at, p.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT 20;
@@ -10,11 +10,18 @@ SELECT p.uid,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.view_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
   p.ip,
+  p.ip_region,
   p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
   p.created_at,
   p.updated_at,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
@@ -25,6 +32,7 @@ SELECT p.uid,
       SELECT array_agg(t.name ORDER BY t.name)
       FROM post_tags pt
       JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
       WHERE pt.post_id = p.id
     ),
     '{}'::text[]
@@ -39,6 +47,16 @@ LEFT JOIN post_collections pc ON pc.post_uid = p.uid
 LEFT JOIN user_follows uf ON uf.follower_uid = sqlc.narg(viewer)::uuid
   AND uf.followee_uid = p.author
 WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = sqlc.narg(viewer)::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = sqlc.narg(viewer)::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
   AND p.visibility = 'PUBLIC'::post_visibility
   AND (p.created_at, p.uid) < (
     sqlc.arg(cursor_created_at)::timestamptz,
@@ -59,11 +77,18 @@ SELECT p.uid,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.view_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
   p.ip,
+  p.ip_region,
   p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
   p.created_at,
   p.updated_at,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
@@ -74,6 +99,7 @@ SELECT p.uid,
       SELECT array_agg(t.name ORDER BY t.name)
       FROM post_tags pt
       JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
       WHERE pt.post_id = p.id
     ),
     '{}'::text[]
@@ -88,6 +114,16 @@ LEFT JOIN post_collections pc ON pc.post_uid = p.uid
 LEFT JOIN user_follows uf ON uf.follower_uid = sqlc.narg(viewer)::uuid
   AND uf.followee_uid = p.author
 WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = sqlc.narg(viewer)::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = sqlc.narg(viewer)::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
   AND p.author = @author_uid
   AND (
     p.visibility = 'PUBLIC'::post_visibility
@@ -112,11 +148,18 @@ SELECT p.uid,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.view_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
   p.ip,
+  p.ip_region,
   p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
   p.created_at,
   p.updated_at,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
@@ -127,6 +170,7 @@ SELECT p.uid,
       SELECT array_agg(t.name ORDER BY t.name)
       FROM post_tags pt
       JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
       WHERE pt.post_id = p.id
     ),
     '{}'::text[]
@@ -141,6 +185,16 @@ LEFT JOIN post_collections pc ON pc.post_uid = p.uid
 LEFT JOIN user_follows uf ON uf.follower_uid = sqlc.narg(viewer)::uuid
   AND uf.followee_uid = p.author
 WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = sqlc.narg(viewer)::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = sqlc.narg(viewer)::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
   AND (
     p.visibility = 'PUBLIC'::post_visibility
     OR p.author = sqlc.narg(viewer)::uuid
@@ -149,6 +203,7 @@ WHERE p.status = 'NORMAL'::post_status
     SELECT 1
     FROM post_tags pt
     JOIN tags t ON t.id = pt.tag_id
+      AND NOT t.banned
     WHERE pt.post_id = p.id
       AND t.name = @tag_name
   )
@@ -158,3 +213,529 @@ WHERE p.status = 'NORMAL'::post_status
   )
 ORDER BY p.created_at DESC, p.uid DESC
 LIMIT 20;
+
+-- name: ListPostsPublicActive :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = sqlc.narg(viewer)::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = sqlc.narg(viewer)::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = sqlc.narg(viewer)::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND p.visibility = 'PUBLIC'::post_visibility
+  AND (p.latest_replied_on, p.uid) < (
+    sqlc.arg(cursor_replied_on)::timestamptz,
+    sqlc.arg(cursor_id)::uuid
+  )
+ORDER BY p.latest_replied_on DESC, p.uid DESC
+LIMIT 20;
+
+-- name: ListPostsByAuthorActive :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = sqlc.narg(viewer)::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = sqlc.narg(viewer)::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = sqlc.narg(viewer)::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND p.author = @author_uid
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = sqlc.narg(viewer)::uuid
+  )
+  AND (p.latest_replied_on, p.uid) < (
+    sqlc.arg(cursor_replied_on)::timestamptz,
+    sqlc.arg(cursor_id)::uuid
+  )
+ORDER BY p.latest_replied_on DESC, p.uid DESC
+LIMIT 20;
+
+-- name: ListPostsByTagActive :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = sqlc.narg(viewer)::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = sqlc.narg(viewer)::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = sqlc.narg(viewer)::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = sqlc.narg(viewer)::uuid
+  )
+  AND EXISTS (
+    SELECT 1
+    FROM post_tags pt
+    JOIN tags t ON t.id = pt.tag_id
+      AND NOT t.banned
+    WHERE pt.post_id = p.id
+      AND t.name = @tag_name
+  )
+  AND (p.latest_replied_on, p.uid) < (
+    sqlc.arg(cursor_replied_on)::timestamptz,
+    sqlc.arg(cursor_id)::uuid
+  )
+ORDER BY p.latest_replied_on DESC, p.uid DESC
+LIMIT 20;
+
+-- name: ListPostsPublicHot :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = sqlc.narg(viewer)::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = sqlc.narg(viewer)::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = sqlc.narg(viewer)::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND p.visibility = 'PUBLIC'::post_visibility
+  AND (p.hot_score, p.uid) < (
+    sqlc.arg(cursor_hot_score)::float8,
+    sqlc.arg(cursor_id)::uuid
+  )
+ORDER BY p.hot_score DESC, p.uid DESC
+LIMIT 20;
+
+-- name: ListPostsByAuthorHot :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = sqlc.narg(viewer)::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = sqlc.narg(viewer)::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = sqlc.narg(viewer)::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND p.author = @author_uid
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = sqlc.narg(viewer)::uuid
+  )
+  AND (p.hot_score, p.uid) < (
+    sqlc.arg(cursor_hot_score)::float8,
+    sqlc.arg(cursor_id)::uuid
+  )
+ORDER BY p.hot_score DESC, p.uid DESC
+LIMIT 20;
+
+-- name: ListPostsByTagHot :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = sqlc.narg(viewer)::uuid
+LEFT JOIN user_follows uf ON uf.follower_uid = sqlc.narg(viewer)::uuid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = sqlc.narg(viewer)::uuid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = sqlc.narg(viewer)::uuid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = sqlc.narg(viewer)::uuid
+  )
+  AND EXISTS (
+    SELECT 1
+    FROM post_tags pt
+    JOIN tags t ON t.id = pt.tag_id
+      AND NOT t.banned
+    WHERE pt.post_id = p.id
+      AND t.name = @tag_name
+  )
+  AND (p.hot_score, p.uid) < (
+    sqlc.arg(cursor_hot_score)::float8,
+    sqlc.arg(cursor_id)::uuid
+  )
+ORDER BY p.hot_score DESC, p.uid DESC
+LIMIT 20;
+
+-- name: ListFeedPosts :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.view_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.ip_region,
+  p.status,
+  p.content_warning,
+  p.sensitive_media,
+  p.comment_policy,
+  p.link_url,
+  p.hot_score,
+  p.created_at,
+  p.updated_at,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  (uf.follower_uid IS NOT NULL)::boolean AS following,
+  COALESCE(
+    (
+      SELECT array_agg(t.name ORDER BY t.name)
+      FROM post_tags pt
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text[]
+  )::text[] AS tag_names
+FROM posts p
+JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = @viewer_uid
+LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = @viewer_uid
+LEFT JOIN user_follows uf ON uf.follower_uid = @viewer_uid
+  AND uf.followee_uid = p.author
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    NOT p.sensitive_media
+    OR p.author = @viewer_uid
+    OR NOT EXISTS (
+      SELECT 1
+      FROM users vu
+      WHERE vu.uid = @viewer_uid
+        AND vu.sensitive_media_preference = 'FILTER'::sensitive_media_preference
+    )
+  )
+  AND (
+    p.visibility = 'PUBLIC'::post_visibility
+    OR p.author = @viewer_uid
+  )
+  AND (
+    p.author = @viewer_uid
+    OR EXISTS (
+      SELECT 1
+      FROM user_follows f
+      WHERE f.follower_uid = @viewer_uid
+        AND f.followee_uid = p.author
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM post_tags pt
+      JOIN tag_follows tf ON tf.tag_id = pt.tag_id
+      JOIN tags t ON t.id = pt.tag_id
+        AND NOT t.banned
+      WHERE pt.post_id = p.id
+        AND tf.user_uid = @viewer_uid
+    )
+  )
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (p.created_at, p.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY p.created_at DESC, p.uid DESC
+LIMIT 20;
@@ -0,0 +1,142 @@
+-- name: InsertPostReactionEdge :execrows
+INSERT INTO post_reactions (post_uid, user_uid, emoji)
+VALUES (@post_uid, @user_uid, @emoji)
+ON CONFLICT DO NOTHING;
+-- name: DeletePostReactionEdge :execrows
+DELETE FROM post_reactions
+WHERE post_uid = @post_uid
+  AND user_uid = @user_uid
+  AND emoji = @emoji;
+-- name: ListPostReactionCounts :many
+-- Likes are counted under like_emoji next to the stored reactions.
+SELECT r.post_uid,
+  r.emoji,
+  count(*)::int4 AS count,
+  COALESCE(bool_or(r.user_uid = sqlc.narg(viewer)::uuid), false)::boolean AS reacted
+FROM (
+    SELECT pl.post_uid,
+      pl.user_uid,
+      @like_emoji::text AS emoji
+    FROM post_likes pl
+    WHERE pl.post_uid = ANY(@post_uids::uuid [])
+    UNION ALL
+    SELECT pr.post_uid,
+      pr.user_uid,
+      pr.emoji
+    FROM post_reactions pr
+    WHERE pr.post_uid = ANY(@post_uids::uuid [])
+      AND pr.emoji = ANY(@emojis::text [])
+  ) r
+GROUP BY r.post_uid,
+  r.emoji;
+-- name: ListPostReactors :many
+SELECT r.reacted_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (myf.follower_uid IS NOT NULL)::boolean AS following
+FROM (
+    SELECT pl.user_uid,
+      pl.created_at AS reacted_at
+    FROM post_likes pl
+    WHERE pl.post_uid = @post_uid
+      AND @emoji::text = @like_emoji::text
+    UNION ALL
+    SELECT pr.user_uid,
+      pr.created_at AS reacted_at
+    FROM post_reactions pr
+    WHERE pr.post_uid = @post_uid
+      AND pr.emoji = @emoji::text
+      AND @emoji::text <> @like_emoji::text
+  ) r
+  JOIN users u ON u.uid = r.user_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows myf ON myf.follower_uid = sqlc.narg(viewer)::uuid
+  AND myf.followee_uid = r.user_uid
+WHERE (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (r.reacted_at, r.user_uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY r.reacted_at DESC,
+  r.user_uid DESC
+LIMIT 20;
+-- name: InsertCommentReactionEdge :execrows
+INSERT INTO comment_reactions (comment_uid, user_uid, emoji)
+VALUES (@comment_uid, @user_uid, @emoji)
+ON CONFLICT DO NOTHING;
+-- name: DeleteCommentReactionEdge :execrows
+DELETE FROM comment_reactions
+WHERE comment_uid = @comment_uid
+  AND user_uid = @user_uid
+  AND emoji = @emoji;
+-- name: ListCommentReactionCounts :many
+-- Likes are counted under like_emoji next to the stored reactions.
+SELECT r.comment_uid,
+  r.emoji,
+  count(*)::int4 AS count,
+  COALESCE(bool_or(r.user_uid = sqlc.narg(viewer)::uuid), false)::boolean AS reacted
+FROM (
+    SELECT cl.comment_uid,
+      cl.user_uid,
+      @like_emoji::text AS emoji
+    FROM comment_likes cl
+    WHERE cl.comment_uid = ANY(@comment_uids::uuid [])
+    UNION ALL
+    SELECT cr.comment_uid,
+      cr.user_uid,
+      cr.emoji
+    FROM comment_reactions cr
+    WHERE cr.comment_uid = ANY(@comment_uids::uuid [])
+      AND cr.emoji = ANY(@emojis::text [])
+  ) r
+GROUP BY r.comment_uid,
+  r.emoji;
+-- name: ListCommentReactors :many
+SELECT r.reacted_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (myf.follower_uid IS NOT NULL)::boolean AS following
+FROM (
+    SELECT cl.user_uid,
+      cl.created_at AS reacted_at
+    FROM comment_likes cl
+    WHERE cl.comment_uid = @comment_uid
+      AND @emoji::text = @like_emoji::text
+    UNION ALL
+    SELECT cr.user_uid,
+      cr.created_at AS reacted_at
+    FROM comment_reactions cr
+    WHERE cr.comment_uid = @comment_uid
+      AND cr.emoji = @emoji::text
+      AND @emoji::text <> @like_emoji::text
+  ) r
+  JOIN users u ON u.uid = r.user_uid
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN user_follows myf ON myf.follower_uid = sqlc.narg(viewer)::uuid
+  AND myf.followee_uid = r.user_uid
+WHERE (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (r.reacted_at, r.user_uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY r.reacted_at DESC,
+  r.user_uid DESC
+LIMIT 20;
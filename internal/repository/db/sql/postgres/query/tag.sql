@@ -0,0 +1,221 @@
+-- name: GetLatestTagActivityBucketStart :one
+SELECT max(bucket_start)::timestamptz
+FROM tag_activity_buckets;
+-- name: DeleteTagActivityBucketsSince :exec
+DELETE FROM tag_activity_buckets
+WHERE bucket_start >= @since;
+-- name: DeleteTagActivityBucketsBefore :exec
+DELETE FROM tag_activity_buckets
+WHERE bucket_start < @before;
+-- name: RebuildTagActivityBucketsSince :exec
+INSERT INTO tag_activity_buckets (tag_id, bucket_start, post_count, engagement_count)
+SELECT e.tag_id,
+  date_bin(
+    '5 minutes'::interval,
+    e.created_at,
+    TIMESTAMPTZ '1970-01-01 00:00:00+00'
+  ) AS bucket_start,
+  count(*) FILTER (
+    WHERE e.is_post
+  )::int4,
+  count(*) FILTER (
+    WHERE NOT e.is_post
+  )::int4
+FROM (
+    SELECT pt.tag_id,
+      p.created_at,
+      true AS is_post
+    FROM posts p
+      JOIN post_tags pt ON pt.post_id = p.id
+    WHERE p.created_at >= @since
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility = 'PUBLIC'::post_visibility
+    UNION ALL
+    SELECT pt.tag_id,
+      pl.created_at,
+      false
+    FROM post_likes pl
+      JOIN posts p ON p.uid = pl.post_uid
+      JOIN post_tags pt ON pt.post_id = p.id
+    WHERE pl.created_at >= @since
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility = 'PUBLIC'::post_visibility
+    UNION ALL
+    SELECT pt.tag_id,
+      pc.created_at,
+      false
+    FROM post_collections pc
+      JOIN posts p ON p.uid = pc.post_uid
+      JOIN post_tags pt ON pt.post_id = p.id
+    WHERE pc.created_at >= @since
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility = 'PUBLIC'::post_visibility
+    UNION ALL
+    SELECT pt.tag_id,
+      c.created_at,
+      false
+    FROM post_comments c
+      JOIN posts p ON p.uid = c.post_uid
+      JOIN post_tags pt ON pt.post_id = p.id
+    WHERE c.created_at >= @since
+      AND c.status = 'NORMAL'::comment_status
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility = 'PUBLIC'::post_visibility
+  ) e
+GROUP BY e.tag_id,
+  bucket_start;
+-- name: ListTrendingTags :many
+SELECT t.name,
+  sum(b.post_count)::int4 AS post_count,
+  sum(b.engagement_count)::int4 AS engagement_count
+FROM tag_activity_buckets b
+  JOIN tags t ON t.id = b.tag_id
+WHERE b.bucket_start >= @since
+  AND NOT t.banned
+GROUP BY t.id,
+  t.name
+ORDER BY sum(b.post_count) * 3 + sum(b.engagement_count) DESC,
+  t.name
+LIMIT 20;
+-- name: RefreshTagPostCounts :many
+WITH counts AS (
+  SELECT t.id,
+    count(p.id)::int4 AS post_count
+  FROM tags t
+    LEFT JOIN post_tags pt ON pt.tag_id = t.id
+    LEFT JOIN posts p ON p.id = pt.post_id
+    AND p.status = 'NORMAL'::post_status
+    AND p.visibility = 'PUBLIC'::post_visibility
+  GROUP BY t.id
+)
+UPDATE tags t
+SET post_count = c.post_count
+FROM counts c
+WHERE t.id = c.id
+  AND t.post_count <> c.post_count
+RETURNING t.name,
+  t.post_count;
+-- name: GetTagsByNames :many
+SELECT name,
+  post_count,
+  banned
+FROM tags
+WHERE name = ANY(@names::text []);
+-- name: GetTagByName :one
+SELECT t.id,
+  t.name,
+  t.post_count,
+  t.follower_count,
+  t.banned,
+  (tf.user_uid IS NOT NULL)::boolean AS following
+FROM tags t
+  LEFT JOIN tag_follows tf ON tf.tag_id = t.id
+  AND tf.user_uid = sqlc.narg(viewer)::uuid
+WHERE t.name = @name
+LIMIT 1;
+-- name: InsertTagFollowEdge :execrows
+INSERT INTO tag_follows (user_uid, tag_id)
+VALUES (@user_uid, @tag_id)
+ON CONFLICT DO NOTHING;
+-- name: DeleteTagFollowEdge :execrows
+DELETE FROM tag_follows
+WHERE user_uid = @user_uid
+  AND tag_id = @tag_id;
+-- name: IncrementTagFollowerCount :one
+UPDATE tags
+SET follower_count = follower_count + 1
+WHERE id = @tag_id
+RETURNING follower_count;
+-- name: DecrementTagFollowerCount :one
+UPDATE tags
+SET follower_count = GREATEST(follower_count - 1, 0)
+WHERE id = @tag_id
+RETURNING follower_count;
+-- name: GetTagFollowerCount :one
+SELECT follower_count
+FROM tags
+WHERE id = @tag_id;
+-- name: ListFollowedTags :many
+SELECT tf.created_at AS followed_at,
+  t.name,
+  t.post_count,
+  t.follower_count
+FROM tag_follows tf
+  JOIN tags t ON t.id = tf.tag_id
+WHERE tf.user_uid = @user_uid
+  AND NOT t.banned
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_name)::text IS NULL
+    )
+    OR (tf.created_at, t.name) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_name)::text
+    )
+  )
+ORDER BY tf.created_at DESC,
+  t.name DESC
+LIMIT 20;
+-- name: ResolveTagNames :many
+WITH input AS (
+  SELECT DISTINCT unnest(@tags::text []) AS name
+)
+SELECT COALESCE(at.name, i.name)::text AS name,
+  COALESCE(at.banned, t.banned, false)::boolean AS banned
+FROM input i
+  LEFT JOIN tag_aliases a ON a.alias = i.name
+  LEFT JOIN tags at ON at.id = a.tag_id
+  LEFT JOIN tags t ON t.name = i.name;
+-- name: ListPostUidsByTagID :many
+SELECT p.uid
+FROM post_tags pt
+  JOIN posts p ON p.id = pt.post_id
+WHERE pt.tag_id = @tag_id;
+-- name: RenameTag :exec
+UPDATE tags
+SET name = @name
+WHERE id = @id;
+-- name: UpsertTagAlias :exec
+INSERT INTO tag_aliases (alias, tag_id)
+VALUES (@alias, @tag_id)
+ON CONFLICT (alias) DO
+UPDATE
+SET tag_id = EXCLUDED.tag_id;
+-- name: DeleteTagAlias :execrows
+DELETE FROM tag_aliases
+WHERE alias = @alias;
+-- name: RepointTagAliases :exec
+UPDATE tag_aliases
+SET tag_id = @target_tag_id
+WHERE tag_id = @source_tag_id;
+-- name: MergePostTags :exec
+INSERT INTO post_tags (post_id, tag_id)
+SELECT pt.post_id,
+  @target_tag_id
+FROM post_tags pt
+WHERE pt.tag_id = @source_tag_id
+ON CONFLICT (post_id, tag_id) DO NOTHING;
+-- name: MergeTagFollows :exec
+INSERT INTO tag_follows (user_uid, tag_id, created_at)
+SELECT tf.user_uid,
+  @target_tag_id,
+  tf.created_at
+FROM tag_follows tf
+WHERE tf.tag_id = @source_tag_id
+ON CONFLICT (user_uid, tag_id) DO NOTHING;
+-- name: RefreshTagFollowerCount :exec
+UPDATE tags
+SET follower_count = (
+    SELECT count(*)
+    FROM tag_follows
+    WHERE tag_id = @id
+  )
+WHERE id = @id;
+-- name: DeleteTagByID :exec
+DELETE FROM tags
+WHERE id = @id;
+-- name: SetTagBanned :exec
+UPDATE tags
+SET banned = @banned
+WHERE id = @id;
@@ -19,6 +19,8 @@ SELECT uid,
   following_count,
   description,
   status,
+  sensitive_media_preference,
+  hide_follow_lists,
   created_at
 FROM users
 WHERE uid = $1
@@ -45,6 +47,14 @@ SET username = COALESCE(sqlc.narg(username), username),
   email = COALESCE(sqlc.narg(email), email),
   nickname = COALESCE(sqlc.narg(nickname), nickname),
   avatar_url = COALESCE(sqlc.narg(avatar_url), avatar_url),
+  sensitive_media_preference = COALESCE(
+    sqlc.narg(sensitive_media_preference)::sensitive_media_preference,
+    sensitive_media_preference
+  ),
+  hide_follow_lists = COALESCE(
+    sqlc.narg(hide_follow_lists)::boolean,
+    hide_follow_lists
+  ),
   updated_at = now()
 WHERE uid = $1
   AND status = 'NORMAL'::user_status;
@@ -59,3 +69,18 @@ SET password_hash = $2,
   updated_at = now()
 WHERE uid = $1
   AND status = 'NORMAL'::user_status;
+-- name: GetUserRoleByUid :one
+SELECT role
+FROM users
+WHERE uid = @uid
+  AND status = 'NORMAL'::user_status;
+-- name: GetUserSensitiveMediaPreference :one
+SELECT sensitive_media_preference
+FROM users
+WHERE uid = @uid
+  AND status = 'NORMAL'::user_status;
+-- name: GetUserHideFollowLists :one
+SELECT hide_follow_lists
+FROM users
+WHERE uid = @uid
+  AND status = 'NORMAL'::user_status;
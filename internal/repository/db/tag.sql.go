@@ -0,0 +1,619 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: tag.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const decrementTagFollowerCount = `-- name: DecrementTagFollowerCount :one
+UPDATE tags
+SET follower_count = GREATEST(follower_count - 1, 0)
+WHERE id = $1
+RETURNING follower_count
+`
+
+func (q *Queries) DecrementTagFollowerCount(ctx context.Context, tagID int32) (int32, error) {
+	row := q.db.QueryRow(ctx, decrementTagFollowerCount, tagID)
+	var follower_count int32
+	err := row.Scan(&follower_count)
+	return follower_count, err
+}
+
+const deleteTagActivityBucketsBefore = `-- name: DeleteTagActivityBucketsBefore :exec
+DELETE FROM tag_activity_buckets
+WHERE bucket_start < $1
+`
+
+func (q *Queries) DeleteTagActivityBucketsBefore(ctx context.Context, before pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteTagActivityBucketsBefore, before)
+	return err
+}
+
+const deleteTagActivityBucketsSince = `-- name: DeleteTagActivityBucketsSince :exec
+DELETE FROM tag_activity_buckets
+WHERE bucket_start >= $1
+`
+
+func (q *Queries) DeleteTagActivityBucketsSince(ctx context.Context, since pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteTagActivityBucketsSince, since)
+	return err
+}
+
+const deleteTagAlias = `-- name: DeleteTagAlias :execrows
+DELETE FROM tag_aliases
+WHERE alias = $1
+`
+
+func (q *Queries) DeleteTagAlias(ctx context.Context, alias string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTagAlias, alias)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTagByID = `-- name: DeleteTagByID :exec
+DELETE FROM tags
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTagByID(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteTagByID, id)
+	return err
+}
+
+const deleteTagFollowEdge = `-- name: DeleteTagFollowEdge :execrows
+DELETE FROM tag_follows
+WHERE user_uid = $1
+  AND tag_id = $2
+`
+
+type DeleteTagFollowEdgeParams struct {
+	UserUid uuid.UUID
+	TagID   int32
+}
+
+func (q *Queries) DeleteTagFollowEdge(ctx context.Context, arg DeleteTagFollowEdgeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTagFollowEdge, arg.UserUid, arg.TagID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getLatestTagActivityBucketStart = `-- name: GetLatestTagActivityBucketStart :one
+SELECT max(bucket_start)::timestamptz
+FROM tag_activity_buckets
+`
+
+func (q *Queries) GetLatestTagActivityBucketStart(ctx context.Context) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, getLatestTagActivityBucketStart)
+	var column_1 pgtype.Timestamptz
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getTagByName = `-- name: GetTagByName :one
+SELECT t.id,
+  t.name,
+  t.post_count,
+  t.follower_count,
+  t.banned,
+  (tf.user_uid IS NOT NULL)::boolean AS following
+FROM tags t
+  LEFT JOIN tag_follows tf ON tf.tag_id = t.id
+  AND tf.user_uid = $1::uuid
+WHERE t.name = $2
+LIMIT 1
+`
+
+type GetTagByNameParams struct {
+	Viewer uuid.NullUUID
+	Name   string
+}
+
+type GetTagByNameRow struct {
+	ID            int32
+	Name          string
+	PostCount     int32
+	FollowerCount int32
+	Banned        bool
+	Following     bool
+}
+
+func (q *Queries) GetTagByName(ctx context.Context, arg GetTagByNameParams) (GetTagByNameRow, error) {
+	row := q.db.QueryRow(ctx, getTagByName, arg.Viewer, arg.Name)
+	var i GetTagByNameRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.PostCount,
+		&i.FollowerCount,
+		&i.Banned,
+		&i.Following,
+	)
+	return i, err
+}
+
+const getTagFollowerCount = `-- name: GetTagFollowerCount :one
+SELECT follower_count
+FROM tags
+WHERE id = $1
+`
+
+func (q *Queries) GetTagFollowerCount(ctx context.Context, tagID int32) (int32, error) {
+	row := q.db.QueryRow(ctx, getTagFollowerCount, tagID)
+	var follower_count int32
+	err := row.Scan(&follower_count)
+	return follower_count, err
+}
+
+const getTagsByNames = `-- name: GetTagsByNames :many
+SELECT name,
+  post_count,
+  banned
+FROM tags
+WHERE name = ANY($1::text [])
+`
+
+type GetTagsByNamesRow struct {
+	Name      string
+	PostCount int32
+	Banned    bool
+}
+
+func (q *Queries) GetTagsByNames(ctx context.Context, names []string) ([]GetTagsByNamesRow, error) {
+	rows, err := q.db.Query(ctx, getTagsByNames, names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTagsByNamesRow
+	for rows.Next() {
+		var i GetTagsByNamesRow
+		if err := rows.Scan(&i.Name, &i.PostCount, &i.Banned); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incrementTagFollowerCount = `-- name: IncrementTagFollowerCount :one
+UPDATE tags
+SET follower_count = follower_count + 1
+WHERE id = $1
+RETURNING follower_count
+`
+
+func (q *Queries) IncrementTagFollowerCount(ctx context.Context, tagID int32) (int32, error) {
+	row := q.db.QueryRow(ctx, incrementTagFollowerCount, tagID)
+	var follower_count int32
+	err := row.Scan(&follower_count)
+	return follower_count, err
+}
+
+const insertTagFollowEdge = `-- name: InsertTagFollowEdge :execrows
+INSERT INTO tag_follows (user_uid, tag_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type InsertTagFollowEdgeParams struct {
+	UserUid uuid.UUID
+	TagID   int32
+}
+
+func (q *Queries) InsertTagFollowEdge(ctx context.Context, arg InsertTagFollowEdgeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertTagFollowEdge, arg.UserUid, arg.TagID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listFollowedTags = `-- name: ListFollowedTags :many
+SELECT tf.created_at AS followed_at,
+  t.name,
+  t.post_count,
+  t.follower_count
+FROM tag_follows tf
+  JOIN tags t ON t.id = tf.tag_id
+WHERE tf.user_uid = $1
+  AND NOT t.banned
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::text IS NULL
+    )
+    OR (tf.created_at, t.name) < (
+      $2::timestamptz,
+      $3::text
+    )
+  )
+ORDER BY tf.created_at DESC,
+  t.name DESC
+LIMIT 20
+`
+
+type ListFollowedTagsParams struct {
+	UserUid         uuid.UUID
+	CursorCreatedAt pgtype.Timestamptz
+	CursorName      pgtype.Text
+}
+
+type ListFollowedTagsRow struct {
+	FollowedAt    pgtype.Timestamptz
+	Name          string
+	PostCount     int32
+	FollowerCount int32
+}
+
+func (q *Queries) ListFollowedTags(ctx context.Context, arg ListFollowedTagsParams) ([]ListFollowedTagsRow, error) {
+	rows, err := q.db.Query(ctx, listFollowedTags, arg.UserUid, arg.CursorCreatedAt, arg.CursorName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFollowedTagsRow
+	for rows.Next() {
+		var i ListFollowedTagsRow
+		if err := rows.Scan(
+			&i.FollowedAt,
+			&i.Name,
+			&i.PostCount,
+			&i.FollowerCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostUidsByTagID = `-- name: ListPostUidsByTagID :many
+SELECT p.uid
+FROM post_tags pt
+  JOIN posts p ON p.id = pt.post_id
+WHERE pt.tag_id = $1
+`
+
+func (q *Queries) ListPostUidsByTagID(ctx context.Context, tagID int32) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listPostUidsByTagID, tagID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var uid uuid.UUID
+		if err := rows.Scan(&uid); err != nil {
+			return nil, err
+		}
+		items = append(items, uid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrendingTags = `-- name: ListTrendingTags :many
+SELECT t.name,
+  sum(b.post_count)::int4 AS post_count,
+  sum(b.engagement_count)::int4 AS engagement_count
+FROM tag_activity_buckets b
+  JOIN tags t ON t.id = b.tag_id
+WHERE b.bucket_start >= $1
+  AND NOT t.banned
+GROUP BY t.id,
+  t.name
+ORDER BY sum(b.post_count) * 3 + sum(b.engagement_count) DESC,
+  t.name
+LIMIT 20
+`
+
+type ListTrendingTagsRow struct {
+	Name            string
+	PostCount       int32
+	EngagementCount int32
+}
+
+func (q *Queries) ListTrendingTags(ctx context.Context, since pgtype.Timestamptz) ([]ListTrendingTagsRow, error) {
+	rows, err := q.db.Query(ctx, listTrendingTags, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTrendingTagsRow
+	for rows.Next() {
+		var i ListTrendingTagsRow
+		if err := rows.Scan(&i.Name, &i.PostCount, &i.EngagementCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const mergePostTags = `-- name: MergePostTags :exec
+INSERT INTO post_tags (post_id, tag_id)
+SELECT pt.post_id,
+  $1
+FROM post_tags pt
+WHERE pt.tag_id = $2
+ON CONFLICT (post_id, tag_id) DO NOTHING
+`
+
+type MergePostTagsParams struct {
+	TargetTagID int32
+	SourceTagID int32
+}
+
+func (q *Queries) MergePostTags(ctx context.Context, arg MergePostTagsParams) error {
+	_, err := q.db.Exec(ctx, mergePostTags, arg.TargetTagID, arg.SourceTagID)
+	return err
+}
+
+const mergeTagFollows = `-- name: MergeTagFollows :exec
+INSERT INTO tag_follows (user_uid, tag_id, created_at)
+SELECT tf.user_uid,
+  $1,
+  tf.created_at
+FROM tag_follows tf
+WHERE tf.tag_id = $2
+ON CONFLICT (user_uid, tag_id) DO NOTHING
+`
+
+type MergeTagFollowsParams struct {
+	TargetTagID int32
+	SourceTagID int32
+}
+
+func (q *Queries) MergeTagFollows(ctx context.Context, arg MergeTagFollowsParams) error {
+	_, err := q.db.Exec(ctx, mergeTagFollows, arg.TargetTagID, arg.SourceTagID)
+	return err
+}
+
+const rebuildTagActivityBucketsSince = `-- name: RebuildTagActivityBucketsSince :exec
+INSERT INTO tag_activity_buckets (tag_id, bucket_start, post_count, engagement_count)
+SELECT e.tag_id,
+  date_bin(
+    '5 minutes'::interval,
+    e.created_at,
+    TIMESTAMPTZ '1970-01-01 00:00:00+00'
+  ) AS bucket_start,
+  count(*) FILTER (
+    WHERE e.is_post
+  )::int4,
+  count(*) FILTER (
+    WHERE NOT e.is_post
+  )::int4
+FROM (
+    SELECT pt.tag_id,
+      p.created_at,
+      true AS is_post
+    FROM posts p
+      JOIN post_tags pt ON pt.post_id = p.id
+    WHERE p.created_at >= $1
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility = 'PUBLIC'::post_visibility
+    UNION ALL
+    SELECT pt.tag_id,
+      pl.created_at,
+      false
+    FROM post_likes pl
+      JOIN posts p ON p.uid = pl.post_uid
+      JOIN post_tags pt ON pt.post_id = p.id
+    WHERE pl.created_at >= $1
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility = 'PUBLIC'::post_visibility
+    UNION ALL
+    SELECT pt.tag_id,
+      pc.created_at,
+      false
+    FROM post_collections pc
+      JOIN posts p ON p.uid = pc.post_uid
+      JOIN post_tags pt ON pt.post_id = p.id
+    WHERE pc.created_at >= $1
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility = 'PUBLIC'::post_visibility
+    UNION ALL
+    SELECT pt.tag_id,
+      c.created_at,
+      false
+    FROM post_comments c
+      JOIN posts p ON p.uid = c.post_uid
+      JOIN post_tags pt ON pt.post_id = p.id
+    WHERE c.created_at >= $1
+      AND c.status = 'NORMAL'::comment_status
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility = 'PUBLIC'::post_visibility
+  ) e
+GROUP BY e.tag_id,
+  bucket_start
+`
+
+func (q *Queries) RebuildTagActivityBucketsSince(ctx context.Context, since pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, rebuildTagActivityBucketsSince, since)
+	return err
+}
+
+const refreshTagFollowerCount = `-- name: RefreshTagFollowerCount :exec
+UPDATE tags
+SET follower_count = (
+    SELECT count(*)
+    FROM tag_follows
+    WHERE tag_id = $1
+  )
+WHERE id = $1
+`
+
+func (q *Queries) RefreshTagFollowerCount(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, refreshTagFollowerCount, id)
+	return err
+}
+
+const refreshTagPostCounts = `-- name: RefreshTagPostCounts :many
+WITH counts AS (
+  SELECT t.id,
+    count(p.id)::int4 AS post_count
+  FROM tags t
+    LEFT JOIN post_tags pt ON pt.tag_id = t.id
+    LEFT JOIN posts p ON p.id = pt.post_id
+    AND p.status = 'NORMAL'::post_status
+    AND p.visibility = 'PUBLIC'::post_visibility
+  GROUP BY t.id
+)
+UPDATE tags t
+SET post_count = c.post_count
+FROM counts c
+WHERE t.id = c.id
+  AND t.post_count <> c.post_count
+RETURNING t.name,
+  t.post_count
+`
+
+type RefreshTagPostCountsRow struct {
+	Name      string
+	PostCount int32
+}
+
+func (q *Queries) RefreshTagPostCounts(ctx context.Context) ([]RefreshTagPostCountsRow, error) {
+	rows, err := q.db.Query(ctx, refreshTagPostCounts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RefreshTagPostCountsRow
+	for rows.Next() {
+		var i RefreshTagPostCountsRow
+		if err := rows.Scan(&i.Name, &i.PostCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const renameTag = `-- name: RenameTag :exec
+UPDATE tags
+SET name = $1
+WHERE id = $2
+`
+
+type RenameTagParams struct {
+	Name string
+	ID   int32
+}
+
+func (q *Queries) RenameTag(ctx context.Context, arg RenameTagParams) error {
+	_, err := q.db.Exec(ctx, renameTag, arg.Name, arg.ID)
+	return err
+}
+
+const repointTagAliases = `-- name: RepointTagAliases :exec
+UPDATE tag_aliases
+SET tag_id = $1
+WHERE tag_id = $2
+`
+
+type RepointTagAliasesParams struct {
+	TargetTagID int32
+	SourceTagID int32
+}
+
+func (q *Queries) RepointTagAliases(ctx context.Context, arg RepointTagAliasesParams) error {
+	_, err := q.db.Exec(ctx, repointTagAliases, arg.TargetTagID, arg.SourceTagID)
+	return err
+}
+
+const resolveTagNames = `-- name: ResolveTagNames :many
+WITH input AS (
+  SELECT DISTINCT unnest($1::text []) AS name
+)
+SELECT COALESCE(at.name, i.name)::text AS name,
+  COALESCE(at.banned, t.banned, false)::boolean AS banned
+FROM input i
+  LEFT JOIN tag_aliases a ON a.alias = i.name
+  LEFT JOIN tags at ON at.id = a.tag_id
+  LEFT JOIN tags t ON t.name = i.name
+`
+
+type ResolveTagNamesRow struct {
+	Name   string
+	Banned bool
+}
+
+func (q *Queries) ResolveTagNames(ctx context.Context, tags []string) ([]ResolveTagNamesRow, error) {
+	rows, err := q.db.Query(ctx, resolveTagNames, tags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResolveTagNamesRow
+	for rows.Next() {
+		var i ResolveTagNamesRow
+		if err := rows.Scan(&i.Name, &i.Banned); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setTagBanned = `-- name: SetTagBanned :exec
+UPDATE tags
+SET banned = $1
+WHERE id = $2
+`
+
+type SetTagBannedParams struct {
+	Banned bool
+	ID     int32
+}
+
+func (q *Queries) SetTagBanned(ctx context.Context, arg SetTagBannedParams) error {
+	_, err := q.db.Exec(ctx, setTagBanned, arg.Banned, arg.ID)
+	return err
+}
+
+const upsertTagAlias = `-- name: UpsertTagAlias :exec
+INSERT INTO tag_aliases (alias, tag_id)
+VALUES ($1, $2)
+ON CONFLICT (alias) DO
+UPDATE
+SET tag_id = EXCLUDED.tag_id
+`
+
+type UpsertTagAliasParams struct {
+	Alias string
+	TagID int32
+}
+
+func (q *Queries) UpsertTagAlias(ctx context.Context, arg UpsertTagAliasParams) error {
+	_, err := q.db.Exec(ctx, upsertTagAlias, arg.Alias, arg.TagID)
+	return err
+}
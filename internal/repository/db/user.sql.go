@@ -56,6 +56,8 @@ SELECT uid,
   following_count,
   description,
   status,
+  sensitive_media_preference,
+  hide_follow_lists,
   created_at
 FROM users
 WHERE uid = $1
@@ -63,17 +65,19 @@ WHERE uid = $1
 `
 
 type GetUserByUidRow struct {
-	Uid            uuid.UUID
-	Username       string
-	Role           UserRole
-	Email          string
-	Nickname       string
-	AvatarUrl      string
-	FollowersCount int32
-	FollowingCount int32
-	Description    string
-	Status         UserStatus
-	CreatedAt      pgtype.Timestamptz
+	Uid                      uuid.UUID
+	Username                 string
+	Role                     UserRole
+	Email                    string
+	Nickname                 string
+	AvatarUrl                string
+	FollowersCount           int32
+	FollowingCount           int32
+	Description              string
+	Status                   UserStatus
+	SensitiveMediaPreference SensitiveMediaPreference
+	HideFollowLists          bool
+	CreatedAt                pgtype.Timestamptz
 }
 
 func (q *Queries) GetUserByUid(ctx context.Context, uid uuid.UUID) (GetUserByUidRow, error) {
@@ -90,6 +94,8 @@ func (q *Queries) GetUserByUid(ctx context.Context, uid uuid.UUID) (GetUserByUid
 		&i.FollowingCount,
 		&i.Description,
 		&i.Status,
+		&i.SensitiveMediaPreference,
+		&i.HideFollowLists,
 		&i.CreatedAt,
 	)
 	return i, err
@@ -148,6 +154,20 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (GetUs
 	return i, err
 }
 
+const getUserHideFollowLists = `-- name: GetUserHideFollowLists :one
+SELECT hide_follow_lists
+FROM users
+WHERE uid = $1
+  AND status = 'NORMAL'::user_status
+`
+
+func (q *Queries) GetUserHideFollowLists(ctx context.Context, uid uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, getUserHideFollowLists, uid)
+	var hide_follow_lists bool
+	err := row.Scan(&hide_follow_lists)
+	return hide_follow_lists, err
+}
+
 const getUserPasswordHashByUid = `-- name: GetUserPasswordHashByUid :one
 SELECT password_hash
 FROM users
@@ -162,23 +182,61 @@ func (q *Queries) GetUserPasswordHashByUid(ctx context.Context, uid uuid.UUID) (
 	return password_hash, err
 }
 
+const getUserRoleByUid = `-- name: GetUserRoleByUid :one
+SELECT role
+FROM users
+WHERE uid = $1
+  AND status = 'NORMAL'::user_status
+`
+
+func (q *Queries) GetUserRoleByUid(ctx context.Context, uid uuid.UUID) (UserRole, error) {
+	row := q.db.QueryRow(ctx, getUserRoleByUid, uid)
+	var role UserRole
+	err := row.Scan(&role)
+	return role, err
+}
+
+const getUserSensitiveMediaPreference = `-- name: GetUserSensitiveMediaPreference :one
+SELECT sensitive_media_preference
+FROM users
+WHERE uid = $1
+  AND status = 'NORMAL'::user_status
+`
+
+func (q *Queries) GetUserSensitiveMediaPreference(ctx context.Context, uid uuid.UUID) (SensitiveMediaPreference, error) {
+	row := q.db.QueryRow(ctx, getUserSensitiveMediaPreference, uid)
+	var sensitive_media_preference SensitiveMediaPreference
+	err := row.Scan(&sensitive_media_preference)
+	return sensitive_media_preference, err
+}
+
 const updateUser = `-- name: UpdateUser :exec
 UPDATE users
 SET username = COALESCE($2, username),
   email = COALESCE($3, email),
   nickname = COALESCE($4, nickname),
   avatar_url = COALESCE($5, avatar_url),
+  sensitive_media_preference = COALESCE(
+    $6::sensitive_media_preference,
+    sensitive_media_preference
+  ),
+  hide_follow_lists = COALESCE(
+    $7::boolean,
+    hide_follow_lists
+  ),
   updated_at = now()
 WHERE uid = $1
   AND status = 'NORMAL'::user_status
 `
 
 type UpdateUserParams struct {
-	Uid       uuid.UUID
-	Username  pgtype.Text
-	Email     pgtype.Text
-	Nickname  pgtype.Text
-	AvatarUrl pgtype.Text
+	Uid                      uuid.UUID
+	Username                 pgtype.Text
+	Email                    pgtype.Text
+	Nickname                 pgtype.Text
+	AvatarUrl                pgtype.Text
+	SensitiveMediaPreference NullSensitiveMediaPreference
+	HideFollowLists          pgtype.Bool
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
@@ -188,6 +246,8 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
 		arg.Email,
 		arg.Nickname,
 		arg.AvatarUrl,
+		arg.SensitiveMediaPreference,
+		arg.HideFollowLists,
 	)
 	return err
 }
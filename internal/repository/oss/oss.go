@@ -82,3 +82,21 @@ func (o *OSS) GetObject(ctx context.Context, objectName string) (io.ReadCloser,
 
 	return obj, info, nil
 }
+
+// RemoveObject deletes an object. Removing a missing object is not an error.
+func (o *OSS) RemoveObject(ctx context.Context, objectName string) error {
+	if o == nil || o.client == nil {
+		return errors.New("oss client is nil")
+	}
+	if o.bucket == "" {
+		return errors.New("bucket is empty")
+	}
+	if objectName == "" {
+		return errors.New("object name is empty")
+	}
+
+	if err := o.client.RemoveObject(ctx, o.bucket, objectName, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("remove object: %w", err)
+	}
+	return nil
+}
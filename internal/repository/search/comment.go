@@ -0,0 +1,181 @@
+package search
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+const IndexComments = "comments"
+
+type SearchCommentsParams struct {
+	Query     string
+	ViewerUID string
+	PostUID   string
+	AuthorUID string
+	// CreatedAfter and CreatedBefore are unix seconds; zero means unbounded.
+	CreatedAfter  int64
+	CreatedBefore int64
+	Limit         int64
+	Offset        int64
+}
+
+type SearchCommentsResult struct {
+	Hits               []CommentDocument `json:"hits"`
+	EstimatedTotalHits int64             `json:"estimated_total_hits"`
+	ProcessingTimeMs   int64             `json:"processing_time_ms"`
+}
+
+func (s *Search) setupComments() error {
+	if err := s.ensureIndex(IndexComments, "uid"); err != nil {
+		return err
+	}
+
+	task, err := s.client.Index(IndexComments).UpdateSettings(&meilisearch.Settings{
+		SearchableAttributes: []string{
+			"content",
+			"author_nickname",
+		},
+		DisplayedAttributes: []string{
+			"uid",
+			"post_uid",
+			"root_uid",
+			"parent_uid",
+			"author_uid",
+			"author_nickname",
+			"content",
+			"images",
+			"reply_count",
+			"like_count",
+			"post_author_uid",
+			"post_visibility",
+			"status",
+			"created_at",
+			"updated_at",
+		},
+		FilterableAttributes: []string{
+			"post_uid",
+			"author_uid",
+			"post_author_uid",
+			"post_visibility",
+			"status",
+			"created_at",
+		},
+		SortableAttributes: []string{
+			"created_at",
+			"like_count",
+		},
+		RankingRules: []string{
+			"words",
+			"typo",
+			"proximity",
+			"attribute",
+			"sort",
+			"exactness",
+			"like_count:desc",
+			"created_at:desc",
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return s.waitTaskSucceeded(task)
+}
+
+func (s *Search) UpsertComments(docs []CommentDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	task, err := s.client.Index(IndexComments).AddDocuments(docs, nil)
+	if err != nil {
+		return err
+	}
+	return s.waitTaskSucceeded(task)
+}
+
+func (s *Search) DeleteCommentsByUIDs(uids []string) error {
+	if len(uids) == 0 {
+		return nil
+	}
+
+	task, err := s.client.Index(IndexComments).DeleteDocuments(uids, nil)
+	if err != nil {
+		return err
+	}
+	return s.waitTaskSucceeded(task)
+}
+
+// SearchComments only returns comments under posts the viewer can see:
+// public posts, or the viewer's own private posts.
+func (s *Search) SearchComments(p SearchCommentsParams) (*SearchCommentsResult, error) {
+	if p.Limit <= 0 || p.Limit > 20 {
+		p.Limit = 20
+	}
+
+	filters := []string{
+		fmt.Sprintf("status = %s", strconv.Quote("NORMAL")),
+	}
+
+	if p.ViewerUID == "" {
+		filters = append(filters, fmt.Sprintf("post_visibility = %s", strconv.Quote("PUBLIC")))
+	} else {
+		filters = append(filters,
+			fmt.Sprintf("(%s OR %s)",
+				fmt.Sprintf("post_visibility = %s", strconv.Quote("PUBLIC")),
+				fmt.Sprintf("post_author_uid = %s", strconv.Quote(p.ViewerUID)),
+			),
+		)
+	}
+
+	if p.PostUID != "" {
+		filters = append(filters, fmt.Sprintf("post_uid = %s", strconv.Quote(p.PostUID)))
+	}
+	if p.AuthorUID != "" {
+		filters = append(filters, fmt.Sprintf("author_uid = %s", strconv.Quote(p.AuthorUID)))
+	}
+	if p.CreatedAfter > 0 {
+		filters = append(filters, fmt.Sprintf("created_at >= %d", p.CreatedAfter))
+	}
+	if p.CreatedBefore > 0 {
+		filters = append(filters, fmt.Sprintf("created_at < %d", p.CreatedBefore))
+	}
+
+	resp, err := s.client.Index(IndexComments).Search(p.Query, &meilisearch.SearchRequest{
+		Offset: p.Offset,
+		Limit:  p.Limit,
+		Filter: filters,
+		AttributesToRetrieve: []string{
+			"uid",
+			"post_uid",
+			"root_uid",
+			"parent_uid",
+			"author_uid",
+			"author_nickname",
+			"content",
+			"images",
+			"reply_count",
+			"like_count",
+			"post_author_uid",
+			"post_visibility",
+			"status",
+			"created_at",
+			"updated_at",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var hits []CommentDocument
+	if err := resp.Hits.DecodeInto(&hits); err != nil {
+		return nil, err
+	}
+
+	return &SearchCommentsResult{
+		Hits:               hits,
+		EstimatedTotalHits: resp.EstimatedTotalHits,
+		ProcessingTimeMs:   resp.ProcessingTimeMs,
+	}, nil
+}
@@ -13,14 +13,35 @@ type PostDocument struct {
 	CommentCount    int      `json:"comment_count"`
 	CollectionCount int      `json:"collection_count"`
 	LikeCount       int      `json:"like_count"`
+	ViewCount       int64    `json:"view_count"`
 	Pinned          bool     `json:"pinned"`
 	Visibility      string   `json:"visibility"` // PUBLIC / PRIVATE
 	Status          string   `json:"status"`     // NORMAL / ARCHIVED
+	ContentWarning  string   `json:"content_warning"`
+	SensitiveMedia  bool     `json:"sensitive_media"`
+	CommentPolicy   string   `json:"comment_policy"` // EVERYONE / FOLLOWERS / LOCKED
+	LinkURL         string   `json:"link_url,omitempty"`
+	IPRegion        string   `json:"ip_region"`
 	LatestRepliedOn int64    `json:"latest_replied_on"`
+	HotScore        float64  `json:"hot_score"`
 	CreatedAt       int64    `json:"created_at"`
 	UpdatedAt       int64    `json:"updated_at"`
 }
 
+// PostHotScoreDocument is a partial PostDocument used to refresh hot_score
+// without reindexing the whole post.
+type PostHotScoreDocument struct {
+	UID      string  `json:"uid"`
+	HotScore float64 `json:"hot_score"`
+}
+
+// PostViewCountDocument is a partial PostDocument used to refresh view_count
+// without reindexing the whole post.
+type PostViewCountDocument struct {
+	UID       string `json:"uid"`
+	ViewCount int64  `json:"view_count"`
+}
+
 type UserDocument struct {
 	UID         string `json:"uid"`
 	Nickname    string `json:"nickname"`
@@ -30,6 +51,25 @@ type UserDocument struct {
 }
 
 type TagDocument struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	PostCount int    `json:"post_count"`
+}
+
+type CommentDocument struct {
+	UID            string   `json:"uid"`
+	PostUID        string   `json:"post_uid"`
+	RootUID        string   `json:"root_uid"`
+	ParentUID      string   `json:"parent_uid,omitempty"`
+	AuthorUID      string   `json:"author_uid"`
+	AuthorNickname string   `json:"author_nickname"`
+	Content        string   `json:"content"`
+	Images         []string `json:"images,omitempty"`
+	ReplyCount     int      `json:"reply_count"`
+	LikeCount      int      `json:"like_count"`
+	PostAuthorUID  string   `json:"post_author_uid"`
+	PostVisibility string   `json:"post_visibility"` // PUBLIC / PRIVATE
+	Status         string   `json:"status"`          // NORMAL / ARCHIVED
+	CreatedAt      int64    `json:"created_at"`
+	UpdatedAt      int64    `json:"updated_at"`
 }
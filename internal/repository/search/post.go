@@ -16,7 +16,10 @@ type SearchPostsParams struct {
 	TagName   string
 	Limit     int64
 	Offset    int64
-	SortBy    string // "", "latest", "active", "hot"
+	SortBy    string // "", "latest", "active", "hot", "views"
+	// ExcludeSensitive drops posts flagged with sensitive media, except the
+	// viewer's own.
+	ExcludeSensitive bool
 }
 
 type SearchPostsResult struct {
@@ -49,9 +52,15 @@ func (s *Search) setupPosts() error {
 			"comment_count",
 			"collection_count",
 			"like_count",
+			"view_count",
 			"pinned",
 			"visibility",
 			"status",
+			"content_warning",
+			"sensitive_media",
+			"comment_policy",
+			"link_url",
+			"ip_region",
 			"latest_replied_on",
 			"created_at",
 			"updated_at",
@@ -62,6 +71,8 @@ func (s *Search) setupPosts() error {
 			"visibility",
 			"status",
 			"pinned",
+			"content_warning",
+			"sensitive_media",
 			"created_at",
 			"latest_replied_on",
 		},
@@ -71,6 +82,8 @@ func (s *Search) setupPosts() error {
 			"comment_count",
 			"collection_count",
 			"like_count",
+			"view_count",
+			"hot_score",
 		},
 		RankingRules: []string{
 			"words",
@@ -101,6 +114,30 @@ func (s *Search) UpsertPosts(docs []PostDocument) error {
 	return s.waitTaskSucceeded(task)
 }
 
+func (s *Search) UpdatePostHotScores(docs []PostHotScoreDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	task, err := s.client.Index(IndexPosts).UpdateDocuments(docs, nil)
+	if err != nil {
+		return err
+	}
+	return s.waitTaskSucceeded(task)
+}
+
+func (s *Search) UpdatePostViewCounts(docs []PostViewCountDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	task, err := s.client.Index(IndexPosts).UpdateDocuments(docs, nil)
+	if err != nil {
+		return err
+	}
+	return s.waitTaskSucceeded(task)
+}
+
 func (s *Search) DeletePostsByUIDs(uids []string) error {
 	if len(uids) == 0 {
 		return nil
@@ -133,6 +170,15 @@ func (s *Search) SearchPosts(p SearchPostsParams) (*SearchPostsResult, error) {
 		)
 	}
 
+	if p.ExcludeSensitive {
+		if p.ViewerUID == "" {
+			filters = append(filters, "sensitive_media = false")
+		} else {
+			filters = append(filters,
+				fmt.Sprintf("(sensitive_media = false OR author_uid = %s)", strconv.Quote(p.ViewerUID)),
+			)
+		}
+	}
 	if p.AuthorUID != "" {
 		filters = append(filters, fmt.Sprintf("author_uid = %s", strconv.Quote(p.AuthorUID)))
 	}
@@ -157,9 +203,15 @@ func (s *Search) SearchPosts(p SearchPostsParams) (*SearchPostsResult, error) {
 			"comment_count",
 			"collection_count",
 			"like_count",
+			"view_count",
 			"pinned",
 			"visibility",
 			"status",
+			"content_warning",
+			"sensitive_media",
+			"comment_policy",
+			"link_url",
+			"ip_region",
 			"latest_replied_on",
 			"created_at",
 			"updated_at",
@@ -174,7 +226,9 @@ func (s *Search) SearchPosts(p SearchPostsParams) (*SearchPostsResult, error) {
 	case "active":
 		req.Sort = []string{"latest_replied_on:desc"}
 	case "hot":
-		req.Sort = []string{"like_count:desc", "comment_count:desc", "created_at:desc"}
+		req.Sort = []string{"hot_score:desc", "created_at:desc"}
+	case "views":
+		req.Sort = []string{"view_count:desc", "created_at:desc"}
 	}
 
 	resp, err := s.client.Index(IndexPosts).Search(p.Query, req)
@@ -29,6 +29,9 @@ func (s *Search) Setup() error {
 	if err := s.setupTags(); err != nil {
 		return err
 	}
+	if err := s.setupComments(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -25,7 +25,17 @@ func (s *Search) setupTags() error {
 
 	task, err := s.client.Index(IndexTags).UpdateSettings(&meilisearch.Settings{
 		SearchableAttributes: []string{"name"},
-		DisplayedAttributes:  []string{"id", "name"},
+		DisplayedAttributes:  []string{"id", "name", "post_count"},
+		SortableAttributes:   []string{"post_count"},
+		RankingRules: []string{
+			"words",
+			"typo",
+			"proximity",
+			"attribute",
+			"sort",
+			"exactness",
+			"post_count:desc",
+		},
 	})
 	if err != nil {
 		return err
@@ -45,6 +55,18 @@ func (s *Search) UpsertTags(docs []TagDocument) error {
 	return s.waitTaskSucceeded(task)
 }
 
+func (s *Search) DeleteTagsByIDs(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	task, err := s.client.Index(IndexTags).DeleteDocuments(ids, nil)
+	if err != nil {
+		return err
+	}
+	return s.waitTaskSucceeded(task)
+}
+
 func (s *Search) SearchTags(p SearchTagsParams) (*SearchTagsResult, error) {
 	if p.Limit <= 0 || p.Limit > 20 {
 		p.Limit = 20
@@ -54,7 +76,7 @@ func (s *Search) SearchTags(p SearchTagsParams) (*SearchTagsResult, error) {
 		Offset: p.Offset,
 		Limit:  p.Limit,
 		AttributesToRetrieve: []string{
-			"id", "name",
+			"id", "name", "post_count",
 		},
 	})
 	if err != nil {
@@ -83,7 +105,9 @@ func (s *Search) SuggestTagsByName(prefix string, limit int64) (*SearchTagsResul
 		AttributesToRetrieve: []string{
 			"id",
 			"name",
+			"post_count",
 		},
+		Sort: []string{"post_count:desc"},
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"aeibi/api"
+	"aeibi/internal/async"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AdminService struct {
+	db       *db.Queries
+	pool     *pgxpool.Pool
+	producer *async.Producer
+}
+
+func NewAdminService(pool *pgxpool.Pool, riverClient *river.Client[pgx.Tx]) *AdminService {
+	return &AdminService{
+		db:       db.New(pool),
+		pool:     pool,
+		producer: async.New(riverClient),
+	}
+}
+
+// RequireAdmin reports a PermissionDenied status unless uid belongs to a HOST
+// or ADMIN account.
+func (s *AdminService) RequireAdmin(ctx context.Context, uid string) error {
+	ok, err := isAdmin(ctx, s.db, uid)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// isAdmin reports whether uid belongs to a HOST or ADMIN account. Anonymous
+// and unknown users are not admins.
+func isAdmin(ctx context.Context, q *db.Queries, uid string) (bool, error) {
+	if uid == "" {
+		return false, nil
+	}
+	role, err := q.GetUserRoleByUid(ctx, util.UUID(uid))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get user role: %w", err)
+	}
+	return role == db.UserRoleHOST || role == db.UserRoleADMIN, nil
+}
+
+func (s *AdminService) RenameTag(ctx context.Context, req *api.RenameTagRequest) error {
+	newName := strings.TrimSpace(req.NewName)
+	if newName == "" {
+		return fmt.Errorf("new_name is required")
+	}
+	if newName == req.Name {
+		return nil
+	}
+
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		tag, err := getTagForAdmin(ctx, qtx, req.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := qtx.GetTagByName(ctx, db.GetTagByNameParams{Name: newName}); err == nil {
+			return fmt.Errorf("tag %q already exists, merge instead", newName)
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("get tag: %w", err)
+		}
+
+		if err := qtx.RenameTag(ctx, db.RenameTagParams{
+			ID:   tag.ID,
+			Name: newName,
+		}); err != nil {
+			return fmt.Errorf("rename tag: %w", err)
+		}
+		// The new name may have been an alias; a real tag always wins.
+		if _, err := qtx.DeleteTagAlias(ctx, newName); err != nil {
+			return fmt.Errorf("delete tag alias: %w", err)
+		}
+		// Keep the old spelling working for new posts.
+		if err := qtx.UpsertTagAlias(ctx, db.UpsertTagAliasParams{
+			Alias: tag.Name,
+			TagID: tag.ID,
+		}); err != nil {
+			return fmt.Errorf("upsert tag alias: %w", err)
+		}
+
+		postUids, err := qtx.ListPostUidsByTagID(ctx, tag.ID)
+		if err != nil {
+			return fmt.Errorf("list post uids by tag: %w", err)
+		}
+		return s.enqueueTagReindexTx(ctx, tx, postUids, []string{tag.Name, newName})
+	})
+}
+
+func (s *AdminService) MergeTags(ctx context.Context, req *api.MergeTagsRequest) error {
+	sources := util.NormalizeStrings(req.SourceNames)
+	if len(sources) == 0 {
+		return fmt.Errorf("source_names is required")
+	}
+
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		target, err := getTagForAdmin(ctx, qtx, req.TargetName)
+		if err != nil {
+			return err
+		}
+
+		postUidSet := make(map[uuid.UUID]struct{})
+		tagNames := []string{target.Name}
+		for _, name := range sources {
+			if name == target.Name {
+				return fmt.Errorf("source_names must not contain target_name")
+			}
+			source, err := getTagForAdmin(ctx, qtx, name)
+			if err != nil {
+				return err
+			}
+
+			postUids, err := qtx.ListPostUidsByTagID(ctx, source.ID)
+			if err != nil {
+				return fmt.Errorf("list post uids by tag: %w", err)
+			}
+			for _, postUid := range postUids {
+				postUidSet[postUid] = struct{}{}
+			}
+
+			if err := qtx.MergePostTags(ctx, db.MergePostTagsParams{
+				SourceTagID: source.ID,
+				TargetTagID: target.ID,
+			}); err != nil {
+				return fmt.Errorf("merge post tags: %w", err)
+			}
+			if err := qtx.MergeTagFollows(ctx, db.MergeTagFollowsParams{
+				SourceTagID: source.ID,
+				TargetTagID: target.ID,
+			}); err != nil {
+				return fmt.Errorf("merge tag follows: %w", err)
+			}
+			if err := qtx.RepointTagAliases(ctx, db.RepointTagAliasesParams{
+				SourceTagID: source.ID,
+				TargetTagID: target.ID,
+			}); err != nil {
+				return fmt.Errorf("repoint tag aliases: %w", err)
+			}
+			if err := qtx.DeleteTagByID(ctx, source.ID); err != nil {
+				return fmt.Errorf("delete tag: %w", err)
+			}
+			if err := qtx.UpsertTagAlias(ctx, db.UpsertTagAliasParams{
+				Alias: source.Name,
+				TagID: target.ID,
+			}); err != nil {
+				return fmt.Errorf("upsert tag alias: %w", err)
+			}
+			tagNames = append(tagNames, source.Name)
+		}
+
+		if err := qtx.RefreshTagFollowerCount(ctx, target.ID); err != nil {
+			return fmt.Errorf("refresh tag follower count: %w", err)
+		}
+
+		postUids := make([]uuid.UUID, 0, len(postUidSet))
+		for postUid := range postUidSet {
+			postUids = append(postUids, postUid)
+		}
+		return s.enqueueTagReindexTx(ctx, tx, postUids, tagNames)
+	})
+}
+
+func (s *AdminService) CreateTagAlias(ctx context.Context, req *api.CreateTagAliasRequest) error {
+	alias := strings.TrimSpace(req.Alias)
+	if alias == "" {
+		return fmt.Errorf("alias is required")
+	}
+
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		tag, err := getTagForAdmin(ctx, qtx, req.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := qtx.GetTagByName(ctx, db.GetTagByNameParams{Name: alias}); err == nil {
+			return fmt.Errorf("tag %q already exists, merge instead", alias)
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("get tag: %w", err)
+		}
+
+		if err := qtx.UpsertTagAlias(ctx, db.UpsertTagAliasParams{
+			Alias: alias,
+			TagID: tag.ID,
+		}); err != nil {
+			return fmt.Errorf("upsert tag alias: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *AdminService) DeleteTagAlias(ctx context.Context, req *api.DeleteTagAliasRequest) error {
+	affected, err := s.db.DeleteTagAlias(ctx, req.Alias)
+	if err != nil {
+		return fmt.Errorf("delete tag alias: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("tag alias not found")
+	}
+	return nil
+}
+
+func (s *AdminService) BanTag(ctx context.Context, req *api.BanTagRequest) error {
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		tag, err := getTagForAdmin(ctx, qtx, req.Name)
+		if err != nil {
+			return err
+		}
+		if tag.Banned {
+			return nil
+		}
+
+		if err := qtx.SetTagBanned(ctx, db.SetTagBannedParams{
+			ID:     tag.ID,
+			Banned: true,
+		}); err != nil {
+			return fmt.Errorf("set tag banned: %w", err)
+		}
+
+		// Posts keep their post_tags rows so an unban can bring the tag back;
+		// reads filter banned tags out, and the reindex drops it from search.
+		postUids, err := qtx.ListPostUidsByTagID(ctx, tag.ID)
+		if err != nil {
+			return fmt.Errorf("list post uids by tag: %w", err)
+		}
+		return s.enqueueTagReindexTx(ctx, tx, postUids, []string{tag.Name})
+	})
+}
+
+func (s *AdminService) UnbanTag(ctx context.Context, req *api.UnbanTagRequest) error {
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		tag, err := getTagForAdmin(ctx, qtx, req.Name)
+		if err != nil {
+			return err
+		}
+		if !tag.Banned {
+			return nil
+		}
+
+		if err := qtx.SetTagBanned(ctx, db.SetTagBannedParams{
+			ID:     tag.ID,
+			Banned: false,
+		}); err != nil {
+			return fmt.Errorf("set tag banned: %w", err)
+		}
+
+		postUids, err := qtx.ListPostUidsByTagID(ctx, tag.ID)
+		if err != nil {
+			return fmt.Errorf("list post uids by tag: %w", err)
+		}
+		return s.enqueueTagReindexTx(ctx, tx, postUids, []string{tag.Name})
+	})
+}
+
+func getTagForAdmin(ctx context.Context, qtx *db.Queries, name string) (db.GetTagByNameRow, error) {
+	tag, err := qtx.GetTagByName(ctx, db.GetTagByNameParams{Name: name})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.GetTagByNameRow{}, fmt.Errorf("tag not found")
+		}
+		return db.GetTagByNameRow{}, fmt.Errorf("get tag: %w", err)
+	}
+	return tag, nil
+}
+
+func (s *AdminService) enqueueTagReindexTx(ctx context.Context, tx pgx.Tx, postUids []uuid.UUID, tagNames []string) error {
+	for _, postUid := range postUids {
+		if err := s.producer.EnqueueUpdatePostSearchTx(ctx, tx, async.UpdatePostSearchArgs{
+			PostUID: postUid,
+			Action:  async.PostSearchActionUpsert,
+		}); err != nil {
+			return err
+		}
+	}
+	return s.producer.EnqueueUpdateTagSearchTx(ctx, tx, async.UpdateTagSearchArgs{
+		TagNames: tagNames,
+	})
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"aeibi/api"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// defaultCollectionFolderName is the name given to the folder that CollectPost
+// files new collections into.
+const defaultCollectionFolderName = "Default"
+
+const maxCollectionFolders = 100
+
+type CollectionService struct {
+	db   *db.Queries
+	pool *pgxpool.Pool
+}
+
+func NewCollectionService(pool *pgxpool.Pool) *CollectionService {
+	return &CollectionService{
+		db:   db.New(pool),
+		pool: pool,
+	}
+}
+
+func (s *CollectionService) CreateCollectionFolder(ctx context.Context, uid string, req *api.CreateCollectionFolderRequest) (*api.CreateCollectionFolderResponse, error) {
+	ownerUid := util.UUID(uid)
+
+	var row db.CreateCollectionFolderRow
+	if err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		if _, err := qtx.EnsureDefaultCollectionFolder(ctx, db.EnsureDefaultCollectionFolderParams{
+			Uid:      uuid.New(),
+			OwnerUid: ownerUid,
+			Name:     defaultCollectionFolderName,
+		}); err != nil {
+			return fmt.Errorf("ensure default collection folder: %w", err)
+		}
+
+		count, err := qtx.CountCollectionFoldersByOwner(ctx, ownerUid)
+		if err != nil {
+			return fmt.Errorf("count collection folders: %w", err)
+		}
+		if count >= maxCollectionFolders {
+			return fmt.Errorf("too many collection folders")
+		}
+
+		row, err = qtx.CreateCollectionFolder(ctx, db.CreateCollectionFolderParams{
+			Uid:      uuid.New(),
+			OwnerUid: ownerUid,
+			Name:     strings.TrimSpace(req.Name),
+			IsPublic: req.IsPublic,
+		})
+		if err != nil {
+			return fmt.Errorf("create collection folder: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &api.CreateCollectionFolderResponse{
+		Folder: &api.CollectionFolder{
+			Uid:       row.Uid.String(),
+			OwnerUid:  row.OwnerUid.String(),
+			Name:      row.Name,
+			IsDefault: row.IsDefault,
+			IsPublic:  row.IsPublic,
+			Position:  row.Position,
+			CreatedAt: row.CreatedAt.Time.Unix(),
+			UpdatedAt: row.UpdatedAt.Time.Unix(),
+		},
+	}, nil
+}
+
+func (s *CollectionService) ListMyCollectionFolders(ctx context.Context, uid string, _ *api.ListMyCollectionFoldersRequest) (*api.ListCollectionFoldersResponse, error) {
+	if _, err := s.db.EnsureDefaultCollectionFolder(ctx, db.EnsureDefaultCollectionFolderParams{
+		Uid:      uuid.New(),
+		OwnerUid: util.UUID(uid),
+		Name:     defaultCollectionFolderName,
+	}); err != nil {
+		return nil, fmt.Errorf("ensure default collection folder: %w", err)
+	}
+
+	return s.listCollectionFolders(ctx, uid, false)
+}
+
+func (s *CollectionService) ListUserCollectionFolders(ctx context.Context, viewerUid string, req *api.ListUserCollectionFoldersRequest) (*api.ListCollectionFoldersResponse, error) {
+	return s.listCollectionFolders(ctx, req.Uid, viewerUid != req.Uid)
+}
+
+func (s *CollectionService) listCollectionFolders(ctx context.Context, ownerUid string, publicOnly bool) (*api.ListCollectionFoldersResponse, error) {
+	rows, err := s.db.ListCollectionFoldersByOwner(ctx, db.ListCollectionFoldersByOwnerParams{
+		OwnerUid:   util.UUID(ownerUid),
+		PublicOnly: publicOnly,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list collection folders: %w", err)
+	}
+
+	folders := make([]*api.CollectionFolder, 0, len(rows))
+	for _, row := range rows {
+		folders = append(folders, &api.CollectionFolder{
+			Uid:       row.Uid.String(),
+			OwnerUid:  row.OwnerUid.String(),
+			Name:      row.Name,
+			IsDefault: row.IsDefault,
+			IsPublic:  row.IsPublic,
+			Position:  row.Position,
+			PostCount: row.PostCount,
+			CreatedAt: row.CreatedAt.Time.Unix(),
+			UpdatedAt: row.UpdatedAt.Time.Unix(),
+		})
+	}
+
+	return &api.ListCollectionFoldersResponse{
+		Folders: folders,
+	}, nil
+}
+
+func (s *CollectionService) UpdateCollectionFolder(ctx context.Context, uid string, req *api.UpdateCollectionFolderRequest) error {
+	params := db.UpdateCollectionFolderByUidAndOwnerParams{
+		Uid:      util.UUID(req.Uid),
+		OwnerUid: util.UUID(uid),
+	}
+
+	paths := make(map[string]struct{}, len(req.UpdateMask.GetPaths()))
+	for _, path := range req.UpdateMask.GetPaths() {
+		paths[path] = struct{}{}
+	}
+	if _, ok := paths["name"]; ok {
+		name := strings.TrimSpace(req.Folder.Name)
+		if name == "" {
+			return fmt.Errorf("name is required")
+		}
+		params.Name = pgtype.Text{String: name, Valid: true}
+	}
+	if _, ok := paths["is_public"]; ok {
+		params.IsPublic = pgtype.Bool{Bool: req.Folder.IsPublic, Valid: true}
+	}
+
+	affected, err := s.db.UpdateCollectionFolderByUidAndOwner(ctx, params)
+	if err != nil {
+		return fmt.Errorf("update collection folder: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("collection folder not found")
+	}
+
+	return nil
+}
+
+func (s *CollectionService) ReorderCollectionFolders(ctx context.Context, uid string, req *api.ReorderCollectionFoldersRequest) error {
+	ownerUid := util.UUID(uid)
+
+	folderUids := make([]uuid.UUID, 0, len(req.FolderUids))
+	seen := make(map[uuid.UUID]struct{}, len(req.FolderUids))
+	for _, folderUid := range req.FolderUids {
+		parsed := util.UUID(folderUid)
+		if _, ok := seen[parsed]; ok {
+			return fmt.Errorf("folder_uids contains duplicates")
+		}
+		seen[parsed] = struct{}{}
+		folderUids = append(folderUids, parsed)
+	}
+
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		count, err := qtx.CountCollectionFoldersByOwner(ctx, ownerUid)
+		if err != nil {
+			return fmt.Errorf("count collection folders: %w", err)
+		}
+		if int(count) != len(folderUids) {
+			return fmt.Errorf("folder_uids must list every collection folder")
+		}
+
+		affected, err := qtx.UpdateCollectionFolderPositions(ctx, db.UpdateCollectionFolderPositionsParams{
+			Uids:     folderUids,
+			OwnerUid: ownerUid,
+		})
+		if err != nil {
+			return fmt.Errorf("update collection folder positions: %w", err)
+		}
+		if int(affected) != len(folderUids) {
+			return fmt.Errorf("folder_uids must list every collection folder")
+		}
+
+		return nil
+	})
+}
+
+func (s *CollectionService) DeleteCollectionFolder(ctx context.Context, uid string, req *api.DeleteCollectionFolderRequest) error {
+	ownerUid := util.UUID(uid)
+	folderUid := util.UUID(req.Uid)
+
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		folder, err := qtx.GetCollectionFolderByUid(ctx, folderUid)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("collection folder not found")
+			}
+			return fmt.Errorf("get collection folder: %w", err)
+		}
+		if folder.OwnerUid != ownerUid {
+			return fmt.Errorf("collection folder not found")
+		}
+		if folder.IsDefault {
+			return fmt.Errorf("default collection folder cannot be deleted")
+		}
+
+		// Posts filed only in this folder stay collected, so they fall back
+		// to the default folder instead of disappearing with it.
+		defaultFolderUid, err := qtx.EnsureDefaultCollectionFolder(ctx, db.EnsureDefaultCollectionFolderParams{
+			Uid:      uuid.New(),
+			OwnerUid: ownerUid,
+			Name:     defaultCollectionFolderName,
+		})
+		if err != nil {
+			return fmt.Errorf("ensure default collection folder: %w", err)
+		}
+		if err := qtx.MoveOrphanCollectionFolderItems(ctx, db.MoveOrphanCollectionFolderItemsParams{
+			TargetFolderUid: defaultFolderUid,
+			FolderUid:       folderUid,
+		}); err != nil {
+			return fmt.Errorf("move collection folder items: %w", err)
+		}
+
+		if _, err := qtx.DeleteCollectionFolderByUidAndOwner(ctx, db.DeleteCollectionFolderByUidAndOwnerParams{
+			Uid:      folderUid,
+			OwnerUid: ownerUid,
+		}); err != nil {
+			return fmt.Errorf("delete collection folder: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (s *CollectionService) FilePostToCollectionFolder(ctx context.Context, uid string, req *api.FilePostToCollectionFolderRequest) error {
+	userUid := util.UUID(uid)
+	folderUid := util.UUID(req.FolderUid)
+
+	folder, err := s.db.GetCollectionFolderByUid(ctx, folderUid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("collection folder not found")
+		}
+		return fmt.Errorf("get collection folder: %w", err)
+	}
+	if folder.OwnerUid != userUid {
+		return fmt.Errorf("collection folder not found")
+	}
+
+	affected, err := s.db.UpsertCollectionFolderItem(ctx, db.UpsertCollectionFolderItemParams{
+		FolderUid: folderUid,
+		PostUid:   util.UUID(req.PostUid),
+		UserUid:   userUid,
+		Note:      strings.TrimSpace(req.Note),
+	})
+	if err != nil {
+		return fmt.Errorf("file post to collection folder: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("post is not collected")
+	}
+
+	return nil
+}
+
+func (s *CollectionService) RemovePostFromCollectionFolder(ctx context.Context, uid string, req *api.RemovePostFromCollectionFolderRequest) error {
+	userUid := util.UUID(uid)
+	postUid := util.UUID(req.PostUid)
+
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		affected, err := qtx.DeleteCollectionFolderItem(ctx, db.DeleteCollectionFolderItemParams{
+			FolderUid: util.UUID(req.FolderUid),
+			PostUid:   postUid,
+			UserUid:   userUid,
+		})
+		if err != nil {
+			return fmt.Errorf("delete collection folder item: %w", err)
+		}
+		if affected == 0 {
+			return fmt.Errorf("post is not in this collection folder")
+		}
+
+		remaining, err := qtx.CountCollectionFolderItemsByUserAndPost(ctx, db.CountCollectionFolderItemsByUserAndPostParams{
+			UserUid: userUid,
+			PostUid: postUid,
+		})
+		if err != nil {
+			return fmt.Errorf("count collection folder items: %w", err)
+		}
+		if remaining == 0 {
+			return fmt.Errorf("post must stay in at least one collection folder, uncollect it instead")
+		}
+
+		return nil
+	})
+}
+
+func (s *CollectionService) ListPostCollectionFolders(ctx context.Context, uid string, req *api.ListPostCollectionFoldersRequest) (*api.ListPostCollectionFoldersResponse, error) {
+	rows, err := s.db.ListCollectionFoldersByUserAndPost(ctx, db.ListCollectionFoldersByUserAndPostParams{
+		UserUid: util.UUID(uid),
+		PostUid: util.UUID(req.PostUid),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list post collection folders: %w", err)
+	}
+
+	folders := make([]*api.PostCollectionFolder, 0, len(rows))
+	for _, row := range rows {
+		folders = append(folders, &api.PostCollectionFolder{
+			FolderUid:  row.FolderUid.String(),
+			FolderName: row.FolderName,
+			Note:       row.Note,
+			FiledAt:    row.CreatedAt.Time.Unix(),
+		})
+	}
+
+	return &api.ListPostCollectionFoldersResponse{
+		Folders: folders,
+	}, nil
+}
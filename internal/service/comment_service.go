@@ -3,7 +3,12 @@ package service
 import (
 	"aeibi/api"
 	"aeibi/internal/async"
+	"aeibi/internal/auth"
+	"aeibi/internal/config"
+	"aeibi/internal/geoip"
+	"aeibi/internal/reaction"
 	"aeibi/internal/repository/db"
+	searchrepo "aeibi/internal/repository/search"
 	"aeibi/util"
 	"context"
 	"encoding/base64"
@@ -22,20 +27,39 @@ import (
 )
 
 type CommentService struct {
-	db       *db.Queries
-	pool     *pgxpool.Pool
-	producer *async.Producer
+	db        *db.Queries
+	pool      *pgxpool.Pool
+	search    *searchrepo.Search
+	producer  *async.Producer
+	geo       *geoip.Resolver
+	reactions *reaction.Set
+	// editWindow limits how long after posting a comment can be edited;
+	// zero means no limit.
+	editWindow time.Duration
+	maxPinned  int
 }
 
-func NewCommentService(pool *pgxpool.Pool, riverClient *river.Client[pgx.Tx]) *CommentService {
+const defaultMaxPinnedComments = 3
+
+func NewCommentService(pool *pgxpool.Pool, search *searchrepo.Search, riverClient *river.Client[pgx.Tx], geo *geoip.Resolver, reactions *reaction.Set, cfg config.CommentsConfig) *CommentService {
+	maxPinned := cfg.MaxPinned
+	if maxPinned <= 0 {
+		maxPinned = defaultMaxPinnedComments
+	}
 	return &CommentService{
-		db:       db.New(pool),
-		pool:     pool,
-		producer: async.New(riverClient),
+		db:         db.New(pool),
+		pool:       pool,
+		search:     search,
+		producer:   async.New(riverClient),
+		geo:        geo,
+		reactions:  reactions,
+		editWindow: cfg.EditWindow,
+		maxPinned:  maxPinned,
 	}
 }
 
 func (s *CommentService) CreateTopComment(ctx context.Context, uid string, req *api.CreateTopCommentRequest) (*api.CreateTopCommentResponse, error) {
+	ip, _ := auth.ClientIPFromContext(ctx)
 	commentUid := uuid.New()
 	postUid := util.UUID(req.PostUid)
 	authorUid := util.UUID(uid)
@@ -53,17 +77,26 @@ func (s *CommentService) CreateTopComment(ctx context.Context, uid string, req *
 		if postRow.Visibility == db.PostVisibilityPRIVATE && postRow.Author != authorUid {
 			return fmt.Errorf("post not found")
 		}
+		if err := checkCommentPolicy(ctx, qtx, postRow.Author, postRow.CommentPolicy, authorUid); err != nil {
+			return err
+		}
 		_, err = qtx.CreateComment(ctx, db.CreateCommentParams{
-			Uid:       commentUid,
-			PostUid:   postUid,
-			AuthorUid: authorUid,
-			RootUid:   commentUid,
-			Content:   req.Content,
-			Images:    req.Images,
+			Uid:         commentUid,
+			PostUid:     postUid,
+			AuthorUid:   authorUid,
+			RootUid:     commentUid,
+			Content:     req.Content,
+			Images:      req.Images,
+			Attachments: req.Attachments,
+			Ip:          ip,
+			IpRegion:    s.geo.Region(ip),
 		})
 		if err != nil {
 			return fmt.Errorf("create comment: %w", err)
 		}
+		if err := linkCommentFiles(ctx, qtx, commentUid, authorUid, req.Images, req.Attachments); err != nil {
+			return err
+		}
 		commentCount, err := qtx.IncrementPostCommentCount(ctx, postUid)
 		if err != nil {
 			return fmt.Errorf("increment post comment count: %w", err)
@@ -86,6 +119,12 @@ func (s *CommentService) CreateTopComment(ctx context.Context, uid string, req *
 		}); err != nil {
 			return fmt.Errorf("enqueue update post search job: %w", err)
 		}
+		if err := s.producer.EnqueueUpdateCommentSearchTx(ctx, tx, async.UpdateCommentSearchArgs{
+			CommentUID: commentUid,
+			Action:     async.CommentSearchActionUpsert,
+		}); err != nil {
+			return fmt.Errorf("enqueue update comment search job: %w", err)
+		}
 		resp = &api.CreateTopCommentResponse{
 			Uid:          commentUid.String(),
 			CommentCount: commentCount,
@@ -98,6 +137,7 @@ func (s *CommentService) CreateTopComment(ctx context.Context, uid string, req *
 }
 
 func (s *CommentService) CreateReply(ctx context.Context, uid string, req *api.CreateReplyRequest) (*api.CreateReplyResponse, error) {
+	ip, _ := auth.ClientIPFromContext(ctx)
 	replyUid := uuid.New()
 	parentUid := util.UUID(req.ParentUid)
 	authorUid := util.UUID(uid)
@@ -109,6 +149,16 @@ func (s *CommentService) CreateReply(ctx context.Context, uid string, req *api.C
 	if err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
 		qtx := s.db.WithTx(tx)
 
+		postRow, err := qtx.GetPostVisibilityByUid(ctx, commentRow.PostUid)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("post not found")
+			}
+			return fmt.Errorf("get post: %w", err)
+		}
+		if err := checkCommentPolicy(ctx, qtx, postRow.Author, postRow.CommentPolicy, authorUid); err != nil {
+			return err
+		}
 		_, err = qtx.CreateComment(ctx, db.CreateCommentParams{
 			Uid:              replyUid,
 			PostUid:          commentRow.PostUid,
@@ -117,10 +167,17 @@ func (s *CommentService) CreateReply(ctx context.Context, uid string, req *api.C
 			ReplyToAuthorUid: uuid.NullUUID{UUID: commentRow.AuthorUid, Valid: commentRow.RootUid != parentUid},
 			AuthorUid:        authorUid,
 			Content:          req.Content,
+			Images:           req.Images,
+			Attachments:      req.Attachments,
+			Ip:               ip,
+			IpRegion:         s.geo.Region(ip),
 		})
 		if err != nil {
 			return fmt.Errorf("create reply: %w", err)
 		}
+		if err := linkCommentFiles(ctx, qtx, replyUid, authorUid, req.Images, req.Attachments); err != nil {
+			return err
+		}
 		replyCount, err := qtx.IncrementCommentReplyCount(ctx, commentRow.RootUid)
 		if err != nil {
 			return fmt.Errorf("increment comment reply count: %w", err)
@@ -137,6 +194,12 @@ func (s *CommentService) CreateReply(ctx context.Context, uid string, req *api.C
 				return fmt.Errorf("enqueue comment inbox job: %w", err)
 			}
 		}
+		if err := s.producer.EnqueueUpdateCommentSearchTx(ctx, tx, async.UpdateCommentSearchArgs{
+			CommentUID: replyUid,
+			Action:     async.CommentSearchActionUpsert,
+		}); err != nil {
+			return fmt.Errorf("enqueue update comment search job: %w", err)
+		}
 		resp = &api.CreateReplyResponse{
 			Uid:        replyUid.String(),
 			ReplyCount: replyCount,
@@ -153,16 +216,67 @@ func (s *CommentService) ListTopComments(ctx context.Context, viewerUid string,
 	if err != nil {
 		return nil, err
 	}
+	sort := req.Sort
+	if sort == "" {
+		sort = db.ListTopCommentsSortNewest
+	}
+	switch sort {
+	case db.ListTopCommentsSortNewest, db.ListTopCommentsSortOldest, db.ListTopCommentsSortLikes, db.ListTopCommentsSortBest:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported sort %q", req.Sort)
+	}
+	if req.PageToken != "" && token.Sort != sort {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
 
 	rows, err := s.db.ListTopComments(ctx, db.ListTopCommentsParams{
 		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
 		PostUid:         util.UUID(req.PostUid),
-		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		Sort:            sort,
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.UnixMicro(token.CursorCreatedAt).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorLikeCount: pgtype.Int4{Int32: token.CursorLikeCount, Valid: token.CursorID != ""},
+		CursorBestScore: pgtype.Float8{Float64: token.CursorBestScore, Valid: token.CursorID != ""},
 		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("list top comments: %w", err)
 	}
+	// The cursor only covers the sorted rows; pinned ones lead the first page.
+	pageRows := rows
+	if req.PageToken == "" {
+		pinnedRows, err := s.db.ListPinnedComments(ctx, db.ListPinnedCommentsParams{
+			Viewer:  uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+			PostUid: util.UUID(req.PostUid),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list pinned comments: %w", err)
+		}
+		pinned := make([]db.ListTopCommentsRow, 0, len(pinnedRows)+len(rows))
+		for _, row := range pinnedRows {
+			pinned = append(pinned, db.ListTopCommentsRow(row))
+		}
+		rows = append(pinned, rows...)
+	}
+	showIP, err := isAdmin(ctx, s.db, viewerUid)
+	if err != nil {
+		return nil, err
+	}
+	commentUids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		commentUids = append(commentUids, row.Uid)
+	}
+	reactionMap, err := s.listReactionMap(ctx, viewerUid, commentUids)
+	if err != nil {
+		return nil, err
+	}
+	attachmentLists := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		attachmentLists = append(attachmentLists, row.Attachments)
+	}
+	fileMap, err := listAttachmentFileMap(ctx, s.db, attachmentLists...)
+	if err != nil {
+		return nil, err
+	}
 
 	comments := make([]*api.Comment, 0, len(rows))
 	for _, row := range rows {
@@ -188,21 +302,36 @@ func (s *CommentService) ListTopComments(ctx context.Context, viewerUid string,
 			ReplyToAuthor: replyToAuthor,
 			Content:       row.Content,
 			Images:        row.Images,
+			Attachments:   buildAttachmentsByURLOrder(row.Attachments, fileMap),
 			ReplyCount:    row.ReplyCount,
 			LikeCount:     row.LikeCount,
 			Liked:         row.Liked,
+			Reactions:     reactionMap[row.Uid],
+			Edited:        row.Edited,
+			Pinned:        row.Pinned,
+			IpRegion:      row.IpRegion,
+			Ip:            visibleIP(row.Ip, showIP),
 			CreatedAt:     row.CreatedAt.Time.Unix(),
 			UpdatedAt:     row.UpdatedAt.Time.Unix(),
 		})
 	}
 
 	var nextPageToken string
-	if len(rows) > 0 {
-		last := rows[len(rows)-1]
-		nextPageToken, err = encodeTopCommentsPageToken(topCommentsPageToken{
-			CursorCreatedAt: last.CreatedAt.Time.Unix(),
-			CursorID:        last.Uid.String(),
-		})
+	if len(pageRows) > 0 {
+		last := pageRows[len(pageRows)-1]
+		token := topCommentsPageToken{
+			Sort:     sort,
+			CursorID: last.Uid.String(),
+		}
+		switch sort {
+		case db.ListTopCommentsSortLikes:
+			token.CursorLikeCount = last.LikeCount
+		case db.ListTopCommentsSortBest:
+			token.CursorBestScore = last.BestScore
+		default:
+			token.CursorCreatedAt = last.CreatedAt.Time.UnixMicro()
+		}
+		nextPageToken, err = encodeTopCommentsPageToken(token)
 		if err != nil {
 			return nil, fmt.Errorf("encode page token: %w", err)
 		}
@@ -223,6 +352,26 @@ func (s *CommentService) ListReplies(ctx context.Context, viewerUid string, req
 	if err != nil {
 		return nil, fmt.Errorf("list replies: %w", err)
 	}
+	showIP, err := isAdmin(ctx, s.db, viewerUid)
+	if err != nil {
+		return nil, err
+	}
+	commentUids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		commentUids = append(commentUids, row.Uid)
+	}
+	reactionMap, err := s.listReactionMap(ctx, viewerUid, commentUids)
+	if err != nil {
+		return nil, err
+	}
+	attachmentLists := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		attachmentLists = append(attachmentLists, row.Attachments)
+	}
+	fileMap, err := listAttachmentFileMap(ctx, s.db, attachmentLists...)
+	if err != nil {
+		return nil, err
+	}
 
 	comments := make([]*api.Comment, 0, len(rows))
 	for _, row := range rows {
@@ -248,9 +397,14 @@ func (s *CommentService) ListReplies(ctx context.Context, viewerUid string, req
 			ReplyToAuthor: replyToAuthor,
 			Content:       row.Content,
 			Images:        row.Images,
+			Attachments:   buildAttachmentsByURLOrder(row.Attachments, fileMap),
 			ReplyCount:    row.ReplyCount,
 			LikeCount:     row.LikeCount,
 			Liked:         row.Liked,
+			Reactions:     reactionMap[row.Uid],
+			Edited:        row.Edited,
+			IpRegion:      row.IpRegion,
+			Ip:            visibleIP(row.Ip, showIP),
 			CreatedAt:     row.CreatedAt.Time.Unix(),
 			UpdatedAt:     row.UpdatedAt.Time.Unix(),
 		})
@@ -268,6 +422,114 @@ func (s *CommentService) ListReplies(ctx context.Context, viewerUid string, req
 	}, nil
 }
 
+func (s *CommentService) SearchComments(ctx context.Context, viewerUid string, req *api.SearchCommentsRequest) (*api.SearchCommentsResponse, error) {
+	token, err := decodePostSearchPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.search.SearchComments(searchrepo.SearchCommentsParams{
+		Query:         req.Query,
+		ViewerUID:     viewerUid,
+		PostUID:       req.PostUid,
+		AuthorUID:     req.AuthorUid,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+		Limit:         20,
+		Offset:        token.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search comments: %w", err)
+	}
+
+	commentUIDs := make([]uuid.UUID, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		uid, err := uuid.Parse(hit.UID)
+		if err != nil {
+			continue
+		}
+		commentUIDs = append(commentUIDs, uid)
+	}
+
+	rows, err := s.db.ListSearchedCommentsByUids(ctx, db.ListSearchedCommentsByUidsParams{
+		Viewer: uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		Uids:   commentUIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get searched comments: %w", err)
+	}
+
+	showIP, err := isAdmin(ctx, s.db, viewerUid)
+	if err != nil {
+		return nil, err
+	}
+	visibleUIDs := make([]uuid.UUID, 0, len(rows))
+	attachmentLists := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		visibleUIDs = append(visibleUIDs, row.Uid)
+		attachmentLists = append(attachmentLists, row.Attachments)
+	}
+	reactionMap, err := s.listReactionMap(ctx, viewerUid, visibleUIDs)
+	if err != nil {
+		return nil, err
+	}
+	fileMap, err := listAttachmentFileMap(ctx, s.db, attachmentLists...)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*api.Comment, 0, len(rows))
+	for _, row := range rows {
+		var replyToAuthor *api.CommentAuthor
+		if row.ReplyToAuthorUid.Valid && row.ReplyToAuthorNickname.Valid && row.ReplyToAuthorAvatarUrl.Valid {
+			replyToAuthor = &api.CommentAuthor{
+				Uid:       util.NullUUIDString(row.ReplyToAuthorUid),
+				Nickname:  row.ReplyToAuthorNickname.String,
+				AvatarUrl: row.ReplyToAuthorAvatarUrl.String,
+			}
+		}
+		comments = append(comments, &api.Comment{
+			Uid: row.Uid.String(),
+			Author: &api.CommentAuthor{
+				Uid:       row.AuthorUid.String(),
+				Nickname:  row.AuthorNickname,
+				AvatarUrl: row.AuthorAvatarUrl,
+			},
+			PostUid:       row.PostUid.String(),
+			RootUid:       row.RootUid.String(),
+			ParentUid:     util.NullUUIDString(row.ParentUid),
+			ReplyToAuthor: replyToAuthor,
+			Content:       row.Content,
+			Images:        row.Images,
+			Attachments:   buildAttachmentsByURLOrder(row.Attachments, fileMap),
+			ReplyCount:    row.ReplyCount,
+			LikeCount:     row.LikeCount,
+			Liked:         row.Liked,
+			Reactions:     reactionMap[row.Uid],
+			Edited:        row.Edited,
+			Pinned:        row.Pinned,
+			IpRegion:      row.IpRegion,
+			Ip:            visibleIP(row.Ip, showIP),
+			CreatedAt:     row.CreatedAt.Time.Unix(),
+			UpdatedAt:     row.UpdatedAt.Time.Unix(),
+		})
+	}
+
+	nextPageToken := ""
+	nextOffset := token.Offset + int64(len(result.Hits))
+	if len(result.Hits) > 0 && nextOffset < result.EstimatedTotalHits {
+		nextPageToken, err = encodePostSearchPageToken(postSearchPageToken{Offset: nextOffset})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.SearchCommentsResponse{
+		Comments:      comments,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func (s *CommentService) GetComment(ctx context.Context, viewerUid string, req *api.GetCommentRequest) (*api.GetCommentResponse, error) {
 	row, err := s.db.GetCommentByUid(ctx, db.GetCommentByUidParams{
 		Viewer: uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
@@ -279,6 +541,18 @@ func (s *CommentService) GetComment(ctx context.Context, viewerUid string, req *
 		}
 		return nil, fmt.Errorf("get comment: %w", err)
 	}
+	showIP, err := isAdmin(ctx, s.db, viewerUid)
+	if err != nil {
+		return nil, err
+	}
+	reactionMap, err := s.listReactionMap(ctx, viewerUid, []uuid.UUID{row.Uid})
+	if err != nil {
+		return nil, err
+	}
+	fileMap, err := listAttachmentFileMap(ctx, s.db, row.Attachments)
+	if err != nil {
+		return nil, err
+	}
 
 	parentUid := util.NullUUIDString(row.ParentUid)
 	var replyToAuthor *api.CommentAuthor
@@ -304,15 +578,224 @@ func (s *CommentService) GetComment(ctx context.Context, viewerUid string, req *
 			ReplyToAuthor: replyToAuthor,
 			Content:       row.Content,
 			Images:        row.Images,
+			Attachments:   buildAttachmentsByURLOrder(row.Attachments, fileMap),
 			ReplyCount:    row.ReplyCount,
 			LikeCount:     row.LikeCount,
 			Liked:         row.Liked,
+			Reactions:     reactionMap[row.Uid],
+			Edited:        row.Edited,
+			Pinned:        row.Pinned,
+			IpRegion:      row.IpRegion,
+			Ip:            visibleIP(row.Ip, showIP),
 			CreatedAt:     row.CreatedAt.Time.Unix(),
 			UpdatedAt:     row.UpdatedAt.Time.Unix(),
 		},
 	}, nil
 }
 
+// GetCommentThread returns a comment with the chain of ancestors leading to
+// it and the first page of its direct replies, so a client can show the
+// conversation around a reply in one call.
+func (s *CommentService) GetCommentThread(ctx context.Context, viewerUid string, req *api.GetCommentThreadRequest) (*api.GetCommentThreadResponse, error) {
+	token, err := decodeTopCommentsPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	viewer := uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""}
+	commentUid := util.UUID(req.Uid)
+
+	target, err := s.db.GetCommentByUid(ctx, db.GetCommentByUidParams{
+		Viewer: viewer,
+		Uid:    commentUid,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("comment not found")
+		}
+		return nil, fmt.Errorf("get comment: %w", err)
+	}
+	post, err := s.db.GetPostVisibilityByUid(ctx, target.PostUid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("comment not found")
+		}
+		return nil, fmt.Errorf("get post: %w", err)
+	}
+	if post.Visibility == db.PostVisibilityPRIVATE && post.Author != viewer.UUID {
+		return nil, fmt.Errorf("comment not found")
+	}
+
+	ancestorRows, err := s.db.ListCommentAncestors(ctx, db.ListCommentAncestorsParams{
+		Viewer: viewer,
+		Uid:    commentUid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list comment ancestors: %w", err)
+	}
+	replyRows, err := s.db.ListDirectReplies(ctx, db.ListDirectRepliesParams{
+		Viewer:          viewer,
+		ParentUid:       commentUid,
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.UnixMicro(token.CursorCreatedAt).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list direct replies: %w", err)
+	}
+	repliesTotal, err := s.db.CountDirectReplies(ctx, commentUid)
+	if err != nil {
+		return nil, fmt.Errorf("count direct replies: %w", err)
+	}
+
+	rows := make([]db.GetCommentByUidRow, 0, len(ancestorRows)+1+len(replyRows))
+	for _, row := range ancestorRows {
+		rows = append(rows, db.GetCommentByUidRow(row))
+	}
+	rows = append(rows, target)
+	for _, row := range replyRows {
+		rows = append(rows, db.GetCommentByUidRow(row))
+	}
+
+	showIP, err := isAdmin(ctx, s.db, viewerUid)
+	if err != nil {
+		return nil, err
+	}
+	commentUids := make([]uuid.UUID, 0, len(rows))
+	attachmentLists := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		commentUids = append(commentUids, row.Uid)
+		attachmentLists = append(attachmentLists, row.Attachments)
+	}
+	reactionMap, err := s.listReactionMap(ctx, viewerUid, commentUids)
+	if err != nil {
+		return nil, err
+	}
+	fileMap, err := listAttachmentFileMap(ctx, s.db, attachmentLists...)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*api.Comment, 0, len(rows))
+	for _, row := range rows {
+		var replyToAuthor *api.CommentAuthor
+		if row.ReplyToAuthorUid.Valid && row.ReplyToAuthorNickname.Valid && row.ReplyToAuthorAvatarUrl.Valid {
+			replyToAuthor = &api.CommentAuthor{
+				Uid:       util.NullUUIDString(row.ReplyToAuthorUid),
+				Nickname:  row.ReplyToAuthorNickname.String,
+				AvatarUrl: row.ReplyToAuthorAvatarUrl.String,
+			}
+		}
+		comments = append(comments, &api.Comment{
+			Uid: row.Uid.String(),
+			Author: &api.CommentAuthor{
+				Uid:       row.AuthorUid.String(),
+				Nickname:  row.AuthorNickname,
+				AvatarUrl: row.AuthorAvatarUrl,
+			},
+			PostUid:       row.PostUid.String(),
+			RootUid:       row.RootUid.String(),
+			ParentUid:     util.NullUUIDString(row.ParentUid),
+			ReplyToAuthor: replyToAuthor,
+			Content:       row.Content,
+			Images:        row.Images,
+			Attachments:   buildAttachmentsByURLOrder(row.Attachments, fileMap),
+			ReplyCount:    row.ReplyCount,
+			LikeCount:     row.LikeCount,
+			Liked:         row.Liked,
+			Reactions:     reactionMap[row.Uid],
+			Edited:        row.Edited,
+			Pinned:        row.Pinned,
+			IpRegion:      row.IpRegion,
+			Ip:            visibleIP(row.Ip, showIP),
+			CreatedAt:     row.CreatedAt.Time.Unix(),
+			UpdatedAt:     row.UpdatedAt.Time.Unix(),
+		})
+	}
+
+	var nextPageToken string
+	if len(replyRows) > 0 {
+		last := replyRows[len(replyRows)-1]
+		nextPageToken, err = encodeTopCommentsPageToken(topCommentsPageToken{
+			CursorCreatedAt: last.CreatedAt.Time.UnixMicro(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.GetCommentThreadResponse{
+		Ancestors:     comments[:len(ancestorRows)],
+		Comment:       comments[len(ancestorRows)],
+		Replies:       comments[len(ancestorRows)+1:],
+		RepliesTotal:  repliesTotal,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// UpdateComment replaces a comment's text and keeps the old text as a
+// revision. Only the author may edit, and only within the edit window.
+func (s *CommentService) UpdateComment(ctx context.Context, uid string, req *api.UpdateCommentRequest) error {
+	commentUid := util.UUID(req.Uid)
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		comment, err := qtx.GetCommentForUpdateByUid(ctx, commentUid)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("comment not found")
+			}
+			return fmt.Errorf("get comment: %w", err)
+		}
+		if comment.AuthorUid != util.UUID(uid) {
+			return fmt.Errorf("comment not found or no permission")
+		}
+		if s.editWindow > 0 && time.Since(comment.CreatedAt.Time) > s.editWindow {
+			return fmt.Errorf("comments can only be edited within %s of posting", s.editWindow)
+		}
+		if comment.Content == req.Content {
+			return nil
+		}
+
+		if err := qtx.InsertCommentRevision(ctx, db.InsertCommentRevisionParams{
+			CommentUid: commentUid,
+			Content:    comment.Content,
+		}); err != nil {
+			return fmt.Errorf("insert comment revision: %w", err)
+		}
+		if _, err := qtx.UpdateCommentContent(ctx, db.UpdateCommentContentParams{
+			Uid:     commentUid,
+			Content: req.Content,
+		}); err != nil {
+			return fmt.Errorf("update comment content: %w", err)
+		}
+		if err := s.producer.EnqueueUpdateCommentSearchTx(ctx, tx, async.UpdateCommentSearchArgs{
+			CommentUID: commentUid,
+			Action:     async.CommentSearchActionUpsert,
+		}); err != nil {
+			return fmt.Errorf("enqueue update comment search job: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *CommentService) ListCommentRevisions(ctx context.Context, req *api.ListCommentRevisionsRequest) (*api.ListCommentRevisionsResponse, error) {
+	rows, err := s.db.ListCommentRevisions(ctx, util.UUID(req.Uid))
+	if err != nil {
+		return nil, fmt.Errorf("list comment revisions: %w", err)
+	}
+
+	revisions := make([]*api.CommentRevision, 0, len(rows))
+	for _, row := range rows {
+		revisions = append(revisions, &api.CommentRevision{
+			Content:    row.Content,
+			ReplacedAt: row.CreatedAt.Time.Unix(),
+		})
+	}
+	return &api.ListCommentRevisionsResponse{
+		Revisions: revisions,
+	}, nil
+}
+
 func (s *CommentService) DeleteComment(ctx context.Context, uid string, req *api.DeleteCommentRequest) error {
 	commentUid := util.UUID(req.Uid)
 	authorUid := util.UUID(uid)
@@ -328,16 +811,32 @@ func (s *CommentService) DeleteComment(ctx context.Context, uid string, req *api
 	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
 		qtx := s.db.WithTx(tx)
 
-		affected, err := qtx.ArchiveCommentByUidAndAuthor(ctx, db.ArchiveCommentByUidAndAuthorParams{
-			Uid:       commentUid,
-			AuthorUid: authorUid,
-		})
+		var affected int64
+		if commentRow.AuthorUid == authorUid {
+			affected, err = qtx.ArchiveCommentByUidAndAuthor(ctx, db.ArchiveCommentByUidAndAuthorParams{
+				Uid:       commentUid,
+				AuthorUid: authorUid,
+			})
+		} else {
+			// Removed by the post author; it does not go to the comment
+			// author's trash.
+			affected, err = qtx.ArchiveCommentByUidAndPostAuthor(ctx, db.ArchiveCommentByUidAndPostAuthorParams{
+				Uid:        commentUid,
+				PostAuthor: authorUid,
+			})
+		}
 		if err != nil {
 			return fmt.Errorf("archive comment: %w", err)
 		}
 		if affected == 0 {
 			return fmt.Errorf("comment not found or no permission")
 		}
+		if err := s.producer.EnqueueUpdateCommentSearchTx(ctx, tx, async.UpdateCommentSearchArgs{
+			CommentUID: commentUid,
+			Action:     async.CommentSearchActionDelete,
+		}); err != nil {
+			return fmt.Errorf("enqueue update comment search job: %w", err)
+		}
 
 		if commentRow.RootUid == commentUid {
 			if _, err := qtx.DecrementPostCommentCount(ctx, commentRow.PostUid); err != nil {
@@ -358,11 +857,246 @@ func (s *CommentService) DeleteComment(ctx context.Context, uid string, req *api
 	})
 }
 
+// ListMyTrashedComments lists the caller's deleted comments that can still be
+// restored, most recently deleted first.
+func (s *CommentService) ListMyTrashedComments(ctx context.Context, uid string, req *api.ListMyTrashedCommentsRequest) (*api.ListMyTrashedCommentsResponse, error) {
+	token, err := decodeTrashPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListTrashedCommentsByAuthor(ctx, db.ListTrashedCommentsByAuthorParams{
+		AuthorUid:        util.UUID(uid),
+		ArchivedAfter:    trashCutoff(),
+		CursorArchivedAt: pgtype.Timestamptz{Time: time.UnixMicro(token.CursorArchivedAt).UTC(), Valid: token.CursorArchivedAt > 0},
+		CursorID:         uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list trashed comments: %w", err)
+	}
+
+	comments := make([]*api.TrashedComment, 0, len(rows))
+	for _, row := range rows {
+		comments = append(comments, &api.TrashedComment{
+			Uid:       row.Uid.String(),
+			PostUid:   row.PostUid.String(),
+			RootUid:   row.RootUid.String(),
+			ParentUid: util.NullUUIDString(row.ParentUid),
+			Content:   row.Content,
+			Images:    row.Images,
+			CreatedAt: row.CreatedAt.Time.Unix(),
+			DeletedAt: row.ArchivedAt.Time.Unix(),
+			ExpiresAt: row.ArchivedAt.Time.Add(async.TrashRetention).Unix(),
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodeTrashPageToken(trashPageToken{
+			CursorArchivedAt: last.ArchivedAt.Time.UnixMicro(),
+			CursorID:         last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListMyTrashedCommentsResponse{
+		Comments:      comments,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// RestoreComment moves a deleted comment out of the trash and puts back the
+// post comment count or root reply count that deleting it took away.
+func (s *CommentService) RestoreComment(ctx context.Context, uid string, req *api.RestoreCommentRequest) error {
+	commentUid := util.UUID(req.Uid)
+	authorUid := util.UUID(uid)
+	cutoff := trashCutoff()
+
+	commentRow, err := s.db.GetTrashedCommentMetaByUidAndAuthor(ctx, db.GetTrashedCommentMetaByUidAndAuthorParams{
+		Uid:           commentUid,
+		AuthorUid:     authorUid,
+		ArchivedAfter: cutoff,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("comment not found in trash")
+		}
+		return fmt.Errorf("get trashed comment: %w", err)
+	}
+
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		affected, err := qtx.RestoreCommentByUidAndAuthor(ctx, db.RestoreCommentByUidAndAuthorParams{
+			Uid:           commentUid,
+			AuthorUid:     authorUid,
+			ArchivedAfter: cutoff,
+		})
+		if err != nil {
+			return fmt.Errorf("restore comment: %w", err)
+		}
+		if affected == 0 {
+			return fmt.Errorf("comment not found in trash")
+		}
+		if err := s.producer.EnqueueUpdateCommentSearchTx(ctx, tx, async.UpdateCommentSearchArgs{
+			CommentUID: commentUid,
+			Action:     async.CommentSearchActionUpsert,
+		}); err != nil {
+			return fmt.Errorf("enqueue update comment search job: %w", err)
+		}
+
+		if commentRow.RootUid == commentUid {
+			if _, err := qtx.IncrementPostCommentCount(ctx, commentRow.PostUid); err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return fmt.Errorf("post not found")
+				}
+				return fmt.Errorf("increment post comment count: %w", err)
+			}
+			if err := s.producer.EnqueueUpdatePostSearchTx(ctx, tx, async.UpdatePostSearchArgs{
+				PostUID: commentRow.PostUid,
+				Action:  async.PostSearchActionUpsert,
+			}); err != nil {
+				return fmt.Errorf("enqueue update post search job: %w", err)
+			}
+			return nil
+		}
+		if _, err := qtx.IncrementCommentReplyCount(ctx, commentRow.RootUid); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("root comment not found, restore it first")
+			}
+			return fmt.Errorf("increment comment reply count: %w", err)
+		}
+		return nil
+	})
+}
+
+// PinComment pins or unpins a top-level comment. Only the post author may
+// pin, and at most maxPinned comments per post.
+func (s *CommentService) PinComment(ctx context.Context, uid string, req *api.PinCommentRequest) error {
+	commentUid := util.UUID(req.Uid)
+
+	commentRow, err := s.db.GetCommentMetaByUid(ctx, commentUid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("comment not found")
+		}
+		return fmt.Errorf("get comment: %w", err)
+	}
+	if commentRow.RootUid != commentUid {
+		return fmt.Errorf("only top-level comments can be pinned")
+	}
+
+	var pinned bool
+	switch req.Action {
+	case api.ToggleAction_TOGGLE_ACTION_ADD:
+		pinned = true
+	case api.ToggleAction_TOGGLE_ACTION_REMOVE:
+		pinned = false
+	default:
+		return fmt.Errorf("unsupported action: %v", req.Action)
+	}
+
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		postAuthor, err := qtx.LockPostByUid(ctx, commentRow.PostUid)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("post not found")
+			}
+			return fmt.Errorf("lock post: %w", err)
+		}
+		if postAuthor != util.UUID(uid) {
+			return fmt.Errorf("comment not found or no permission")
+		}
+
+		affected, err := qtx.SetCommentPinned(ctx, db.SetCommentPinnedParams{
+			Pinned:  pinned,
+			Uid:     commentUid,
+			PostUid: commentRow.PostUid,
+		})
+		if err != nil {
+			return fmt.Errorf("set comment pinned: %w", err)
+		}
+		if affected == 0 {
+			return fmt.Errorf("comment not found")
+		}
+		if !pinned {
+			return nil
+		}
+
+		count, err := qtx.CountPinnedComments(ctx, commentRow.PostUid)
+		if err != nil {
+			return fmt.Errorf("count pinned comments: %w", err)
+		}
+		if int(count) > s.maxPinned {
+			return fmt.Errorf("at most %d comments can be pinned", s.maxPinned)
+		}
+		return nil
+	})
+}
+
+// linkCommentFiles validates a new comment's images and attachments against
+// the author's uploads and records the references.
+func linkCommentFiles(ctx context.Context, qtx *db.Queries, commentUid, uploader uuid.UUID, images, attachments []string) error {
+	for _, group := range []struct {
+		kind db.PostFileKind
+		urls []string
+	}{
+		{db.PostFileKindIMAGE, images},
+		{db.PostFileKindATTACHMENT, attachments},
+	} {
+		if len(group.urls) == 0 {
+			continue
+		}
+		fileIDs, err := lockUploadedFiles(ctx, qtx, uploader, group.kind, group.urls)
+		if err != nil {
+			return err
+		}
+		if err := qtx.InsertCommentFiles(ctx, db.InsertCommentFilesParams{
+			CommentUid: commentUid,
+			FileIds:    fileIDs,
+			Kind:       group.kind,
+		}); err != nil {
+			return fmt.Errorf("insert comment files: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkCommentPolicy enforces the post's comment policy for a new comment or
+// reply. The post author can always comment.
+func checkCommentPolicy(ctx context.Context, qtx *db.Queries, postAuthor uuid.UUID, policy db.PostCommentPolicy, commenter uuid.UUID) error {
+	if commenter == postAuthor {
+		return nil
+	}
+	switch policy {
+	case db.PostCommentPolicyLOCKED:
+		return fmt.Errorf("comments are locked on this post")
+	case db.PostCommentPolicyFOLLOWERS:
+		following, err := qtx.IsFollowing(ctx, db.IsFollowingParams{
+			FollowerUid: commenter,
+			FolloweeUid: postAuthor,
+		})
+		if err != nil {
+			return fmt.Errorf("check following: %w", err)
+		}
+		if !following {
+			return fmt.Errorf("only followers of the author can comment on this post")
+		}
+	}
+	return nil
+}
+
 func (s *CommentService) LikeComment(ctx context.Context, uid string, req *api.LikeCommentRequest) (*api.LikeCommentResponse, error) {
 	commentUid := util.UUID(req.Uid)
 	userUid := util.UUID(uid)
 
 	var count int32
+	var shouldEnqueue bool
 
 	if err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
 		qtx := s.db.WithTx(tx)
@@ -382,6 +1116,24 @@ func (s *CommentService) LikeComment(ctx context.Context, uid string, req *api.L
 				if err != nil {
 					return fmt.Errorf("comment like: increment comment like count: %w", err)
 				}
+				shouldEnqueue = true
+
+				commentRow, err := qtx.GetCommentMetaByUid(ctx, commentUid)
+				if err != nil {
+					return fmt.Errorf("comment like: get comment meta: %w", err)
+				}
+				if commentRow.AuthorUid != userUid {
+					if err := s.producer.EnqueueLikeInboxTx(ctx, tx, async.LikeInboxArgs{
+						MessageUID:  uuid.New(),
+						ReceiverUID: commentRow.AuthorUid,
+						ActorUID:    userUid,
+						Type:        async.LikeInboxTypeLike,
+						PostUID:     commentRow.PostUid,
+						CommentUID:  commentUid,
+					}); err != nil {
+						return fmt.Errorf("comment like: enqueue like inbox job: %w", err)
+					}
+				}
 			} else {
 				count, err = qtx.GetCommentLikeCount(ctx, commentUid)
 				if err != nil {
@@ -403,6 +1155,7 @@ func (s *CommentService) LikeComment(ctx context.Context, uid string, req *api.L
 				if err != nil {
 					return fmt.Errorf("comment like: decrement comment like count: %w", err)
 				}
+				shouldEnqueue = true
 			} else {
 				count, err = qtx.GetCommentLikeCount(ctx, commentUid)
 				if err != nil {
@@ -413,6 +1166,14 @@ func (s *CommentService) LikeComment(ctx context.Context, uid string, req *api.L
 		default:
 			return fmt.Errorf("comment like: unsupported action: %v", req.Action)
 		}
+		if shouldEnqueue {
+			if err := s.producer.EnqueueUpdateCommentSearchTx(ctx, tx, async.UpdateCommentSearchArgs{
+				CommentUID: commentUid,
+				Action:     async.CommentSearchActionUpsert,
+			}); err != nil {
+				return fmt.Errorf("comment like: enqueue update comment search job: %w", err)
+			}
+		}
 
 		return nil
 	}); err != nil {
@@ -424,9 +1185,144 @@ func (s *CommentService) LikeComment(ctx context.Context, uid string, req *api.L
 	}, nil
 }
 
+// ReactComment adds or removes one emoji reaction. The default emoji is a
+// like and goes through LikeComment.
+func (s *CommentService) ReactComment(ctx context.Context, uid string, commentUid string, emoji string, action api.ToggleAction) ([]*api.Reaction, error) {
+	if !s.reactions.Contains(emoji) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported emoji %q", emoji)
+	}
+	if _, err := s.db.GetCommentMetaByUid(ctx, util.UUID(commentUid)); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "comment not found")
+		}
+		return nil, fmt.Errorf("get comment meta: %w", err)
+	}
+
+	if emoji == s.reactions.Default() {
+		if _, err := s.LikeComment(ctx, uid, &api.LikeCommentRequest{Uid: commentUid, Action: action}); err != nil {
+			return nil, err
+		}
+	} else {
+		switch action {
+		case api.ToggleAction_TOGGLE_ACTION_ADD:
+			if _, err := s.db.InsertCommentReactionEdge(ctx, db.InsertCommentReactionEdgeParams{
+				CommentUid: util.UUID(commentUid),
+				UserUid:    util.UUID(uid),
+				Emoji:      emoji,
+			}); err != nil {
+				return nil, fmt.Errorf("insert comment reaction edge: %w", err)
+			}
+		case api.ToggleAction_TOGGLE_ACTION_REMOVE:
+			if _, err := s.db.DeleteCommentReactionEdge(ctx, db.DeleteCommentReactionEdgeParams{
+				CommentUid: util.UUID(commentUid),
+				UserUid:    util.UUID(uid),
+				Emoji:      emoji,
+			}); err != nil {
+				return nil, fmt.Errorf("delete comment reaction edge: %w", err)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported action: %v", action)
+		}
+	}
+
+	reactionMap, err := s.listReactionMap(ctx, uid, []uuid.UUID{util.UUID(commentUid)})
+	if err != nil {
+		return nil, err
+	}
+	return reactionMap[util.UUID(commentUid)], nil
+}
+
+func (s *CommentService) ListCommentReactors(ctx context.Context, viewerUid string, req *api.ListReactorsRequest) (*api.ListReactorsResponse, error) {
+	if !s.reactions.Contains(req.Emoji) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported emoji %q", req.Emoji)
+	}
+	token, err := decodeTopCommentsPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	// Reactions often land within the same second, so the cursor keeps
+	// microseconds to match the (reacted_at, user_uid) keyset.
+	rows, err := s.db.ListCommentReactors(ctx, db.ListCommentReactorsParams{
+		CommentUid:      util.UUID(req.CommentUid),
+		Emoji:           req.Emoji,
+		LikeEmoji:       s.reactions.Default(),
+		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.UnixMicro(token.CursorCreatedAt).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list comment reactors: %w", err)
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+			IsFollowing:    row.Following,
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodeTopCommentsPageToken(topCommentsPageToken{
+			CursorCreatedAt: last.ReactedAt.Time.UnixMicro(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListReactorsResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// listReactionMap returns each comment's reactions, likes included as the
+// default emoji, with viewerUid's own reactions flagged.
+func (s *CommentService) listReactionMap(ctx context.Context, viewerUid string, commentUids []uuid.UUID) (map[uuid.UUID][]*api.Reaction, error) {
+	if len(commentUids) == 0 {
+		return nil, nil
+	}
+
+	rows, err := s.db.ListCommentReactionCounts(ctx, db.ListCommentReactionCountsParams{
+		Viewer:      uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		LikeEmoji:   s.reactions.Default(),
+		CommentUids: commentUids,
+		Emojis:      s.reactions.Emojis(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list comment reaction counts: %w", err)
+	}
+
+	reactionMap := make(map[uuid.UUID][]*api.Reaction, len(commentUids))
+	for _, row := range rows {
+		reactionMap[row.CommentUid] = append(reactionMap[row.CommentUid], &api.Reaction{
+			Emoji:   row.Emoji,
+			Count:   row.Count,
+			Reacted: row.Reacted,
+		})
+	}
+	for _, reactions := range reactionMap {
+		sortReactions(s.reactions, reactions)
+	}
+	return reactionMap, nil
+}
+
 type topCommentsPageToken struct {
-	CursorCreatedAt int64  `json:"cursor_created_at,omitempty"`
-	CursorID        string `json:"cursor_id,omitempty"`
+	Sort            string  `json:"sort,omitempty"`
+	CursorCreatedAt int64   `json:"cursor_created_at,omitempty"` // unix micros
+	CursorLikeCount int32   `json:"cursor_like_count,omitempty"`
+	CursorBestScore float64 `json:"cursor_best_score,omitempty"`
+	CursorID        string  `json:"cursor_id,omitempty"`
 }
 
 func decodeTopCommentsPageToken(pageToken string) (topCommentsPageToken, error) {
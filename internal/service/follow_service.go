@@ -5,18 +5,23 @@ import (
 	"aeibi/internal/async"
 	"aeibi/internal/repository/db"
 	"aeibi/util"
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/riverqueue/river"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -167,6 +172,7 @@ func (s *FollowService) ListMyFollowers(ctx context.Context, uid string, req *ap
 			FollowersCount: row.FollowersCount,
 			FollowingCount: row.FollowingCount,
 			IsFollowing:    row.Following,
+			FollowsYou:     true,
 		})
 	}
 
@@ -215,6 +221,7 @@ func (s *FollowService) ListMyFollowing(ctx context.Context, uid string, req *ap
 			FollowersCount: row.FollowersCount,
 			FollowingCount: row.FollowingCount,
 			IsFollowing:    true,
+			FollowsYou:     row.FollowsYou,
 		})
 	}
 
@@ -236,6 +243,398 @@ func (s *FollowService) ListMyFollowing(ctx context.Context, uid string, req *ap
 	}, nil
 }
 
+// ListUserFollowers lists anyone's followers, unless they hid their lists.
+func (s *FollowService) ListUserFollowers(ctx context.Context, viewerUid string, req *api.ListUserFollowersRequest) (*api.ListUserFollowersResponse, error) {
+	if err := s.checkFollowListsVisible(ctx, viewerUid, req.Uid); err != nil {
+		return nil, err
+	}
+	query := strings.TrimSpace(req.GetQuery())
+	token, err := decodeFollowPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListUserFollowers(ctx, db.ListUserFollowersParams{
+		Uid:             util.UUID(req.Uid),
+		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+		Query:           pgtype.Text{String: query, Valid: query != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list user followers: %w", err)
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+			IsFollowing:    row.IsFollowing,
+			FollowsYou:     row.FollowsYou,
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodeFollowPageToken(followPageToken{
+			CursorCreatedAt: last.FollowedAt.Time.Unix(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListUserFollowersResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ListUserFollowing lists who anyone follows, unless they hid their lists.
+func (s *FollowService) ListUserFollowing(ctx context.Context, viewerUid string, req *api.ListUserFollowingRequest) (*api.ListUserFollowingResponse, error) {
+	if err := s.checkFollowListsVisible(ctx, viewerUid, req.Uid); err != nil {
+		return nil, err
+	}
+	query := strings.TrimSpace(req.GetQuery())
+	token, err := decodeFollowPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListUserFollowing(ctx, db.ListUserFollowingParams{
+		Uid:             util.UUID(req.Uid),
+		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+		Query:           pgtype.Text{String: query, Valid: query != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list user following: %w", err)
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+			IsFollowing:    row.IsFollowing,
+			FollowsYou:     row.FollowsYou,
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodeFollowPageToken(followPageToken{
+			CursorCreatedAt: last.FollowedAt.Time.Unix(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListUserFollowingResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ListMutualFollowers lists the users the viewer follows who also follow the
+// target, for "followed by people you follow".
+func (s *FollowService) ListMutualFollowers(ctx context.Context, viewerUid string, req *api.ListMutualFollowersRequest) (*api.ListMutualFollowersResponse, error) {
+	if err := s.checkFollowListsVisible(ctx, viewerUid, req.Uid); err != nil {
+		return nil, err
+	}
+	token, err := decodeFollowPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListMutualFollowers(ctx, db.ListMutualFollowersParams{
+		Uid:             util.UUID(req.Uid),
+		Viewer:          util.UUID(viewerUid),
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list mutual followers: %w", err)
+	}
+	total, err := s.db.CountMutualFollowers(ctx, db.CountMutualFollowersParams{
+		Uid:    util.UUID(req.Uid),
+		Viewer: util.UUID(viewerUid),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("count mutual followers: %w", err)
+	}
+
+	userUIDs := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		userUIDs = append(userUIDs, row.Uid)
+	}
+	followsYou, err := listFollowsYouSet(ctx, s.db, viewerUid, userUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+			IsFollowing:    true,
+			FollowsYou:     followsYou[row.Uid],
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodeFollowPageToken(followPageToken{
+			CursorCreatedAt: last.FollowedAt.Time.Unix(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListMutualFollowersResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+		Total:         total,
+	}, nil
+}
+
+// SuggestUsersToFollow returns the viewer's precomputed suggestions, best
+// first, skipping anyone followed since they were computed.
+func (s *FollowService) SuggestUsersToFollow(ctx context.Context, uid string, _ *api.SuggestUsersToFollowRequest) (*api.SuggestUsersToFollowResponse, error) {
+	rows, err := s.db.ListFollowSuggestions(ctx, util.UUID(uid))
+	if err != nil {
+		return nil, fmt.Errorf("list follow suggestions: %w", err)
+	}
+
+	userUIDs := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		userUIDs = append(userUIDs, row.Uid)
+	}
+	followsYou, err := listFollowsYouSet(ctx, s.db, uid, userUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]*api.FollowSuggestion, 0, len(rows))
+	for _, row := range rows {
+		suggestions = append(suggestions, &api.FollowSuggestion{
+			User: &api.User{
+				Uid:            row.Uid.String(),
+				Role:           string(row.Role),
+				Nickname:       row.Nickname,
+				AvatarUrl:      row.AvatarUrl,
+				FollowersCount: row.FollowersCount,
+				FollowingCount: row.FollowingCount,
+				Description:    row.Description,
+				FollowsYou:     followsYou[row.Uid],
+			},
+			MutualCount:    row.MutualCount,
+			SharedTagCount: row.SharedTagCount,
+		})
+	}
+
+	return &api.SuggestUsersToFollowResponse{
+		Suggestions: suggestions,
+	}, nil
+}
+
+// DismissFollowSuggestion drops a suggestion and keeps it from coming back.
+func (s *FollowService) DismissFollowSuggestion(ctx context.Context, uid string, req *api.DismissFollowSuggestionRequest) error {
+	userUID := util.UUID(uid)
+	suggestedUID := util.UUID(req.Uid)
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		if err := qtx.InsertFollowSuggestionDismissal(ctx, db.InsertFollowSuggestionDismissalParams{
+			UserUid:      userUID,
+			SuggestedUid: suggestedUID,
+		}); err != nil {
+			return fmt.Errorf("insert follow suggestion dismissal: %w", err)
+		}
+		if err := qtx.DeleteFollowSuggestion(ctx, db.DeleteFollowSuggestionParams{
+			UserUid:      userUID,
+			SuggestedUid: suggestedUID,
+		}); err != nil {
+			return fmt.Errorf("delete follow suggestion: %w", err)
+		}
+		return nil
+	})
+}
+
+// maxFollowImportFileSize bounds the CSV accepted by ImportFollows; a file
+// with MaxFollowImportEntries rows of uid and nickname fits comfortably.
+const maxFollowImportFileSize = 1 << 20
+
+func (s *FollowService) ExportFollows(ctx context.Context, uid string, req *api.ExportFollowsRequest) (*httpbody.HttpBody, error) {
+	type exportRow struct {
+		uid        uuid.UUID
+		nickname   string
+		followedAt time.Time
+	}
+	var rows []exportRow
+
+	switch req.List {
+	case api.FollowExportList_FOLLOW_EXPORT_LIST_UNSPECIFIED, api.FollowExportList_FOLLOW_EXPORT_LIST_FOLLOWING:
+		following, err := s.db.ExportFollowing(ctx, util.UUID(uid))
+		if err != nil {
+			return nil, fmt.Errorf("export following: %w", err)
+		}
+		for _, row := range following {
+			rows = append(rows, exportRow{uid: row.Uid, nickname: row.Nickname, followedAt: row.FollowedAt.Time})
+		}
+	case api.FollowExportList_FOLLOW_EXPORT_LIST_FOLLOWERS:
+		followers, err := s.db.ExportFollowers(ctx, util.UUID(uid))
+		if err != nil {
+			return nil, fmt.Errorf("export followers: %w", err)
+		}
+		for _, row := range followers {
+			rows = append(rows, exportRow{uid: row.Uid, nickname: row.Nickname, followedAt: row.FollowedAt.Time})
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unsupported list")
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"uid", "nickname", "followed_at"}); err != nil {
+		return nil, fmt.Errorf("write csv header: %w", err)
+	}
+	for _, row := range rows {
+		if err := w.Write([]string{row.uid.String(), util.EscapeCSVCell(row.nickname), row.followedAt.UTC().Format(time.RFC3339)}); err != nil {
+			return nil, fmt.Errorf("write csv row: %w", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("write csv: %w", err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "text/csv; charset=utf-8",
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+// ImportFollows queues a follow import for a CSV file the user uploaded. The
+// follows happen in the background and the result arrives in the inbox.
+func (s *FollowService) ImportFollows(ctx context.Context, uid string, req *api.ImportFollowsRequest) (*api.ImportFollowsResponse, error) {
+	file, err := s.db.GetFileByURL(ctx, req.FileUrl)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "file not found")
+		}
+		return nil, fmt.Errorf("get import file: %w", err)
+	}
+	if file.Status != db.FileStatusNORMAL {
+		return nil, status.Error(codes.NotFound, "file not found")
+	}
+	if file.Uploader != util.UUID(uid) {
+		return nil, status.Error(codes.PermissionDenied, "file was not uploaded by you")
+	}
+	if file.Size > maxFollowImportFileSize {
+		return nil, status.Errorf(codes.InvalidArgument, "file is larger than %d bytes", maxFollowImportFileSize)
+	}
+
+	importUID := uuid.New()
+	if err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		if err := s.db.WithTx(tx).CreateFollowImport(ctx, db.CreateFollowImportParams{
+			Uid:     importUID,
+			UserUid: util.UUID(uid),
+			FileUrl: file.Url,
+		}); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return status.Error(codes.FailedPrecondition, "a follow import is already running")
+			}
+			return fmt.Errorf("create follow import: %w", err)
+		}
+		return s.producer.EnqueueFollowImportTx(ctx, tx, async.FollowImportArgs{
+			ImportUID: importUID,
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return &api.ImportFollowsResponse{
+		Import: &api.FollowImport{
+			Uid:    importUID.String(),
+			Status: api.FollowImportStatus_FOLLOW_IMPORT_STATUS_RUNNING,
+		},
+	}, nil
+}
+
+func followImportStatusToProto(importStatus db.FollowImportStatus) api.FollowImportStatus {
+	switch importStatus {
+	case db.FollowImportStatusRUNNING:
+		return api.FollowImportStatus_FOLLOW_IMPORT_STATUS_RUNNING
+	case db.FollowImportStatusDONE:
+		return api.FollowImportStatus_FOLLOW_IMPORT_STATUS_DONE
+	case db.FollowImportStatusFAILED:
+		return api.FollowImportStatus_FOLLOW_IMPORT_STATUS_FAILED
+	default:
+		return api.FollowImportStatus_FOLLOW_IMPORT_STATUS_UNSPECIFIED
+	}
+}
+
+// checkFollowListsVisible lets the owner always see their own lists and
+// everyone else only when the owner has not hidden them.
+func (s *FollowService) checkFollowListsVisible(ctx context.Context, viewerUid, ownerUid string) error {
+	hidden, err := s.db.GetUserHideFollowLists(ctx, util.UUID(ownerUid))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "user not found")
+		}
+		return fmt.Errorf("get follow list privacy: %w", err)
+	}
+	if hidden && viewerUid != ownerUid {
+		return status.Error(codes.PermissionDenied, "this user's follow lists are hidden")
+	}
+	return nil
+}
+
+// listFollowsYouSet reports which of uids follow the viewer, in one query.
+func listFollowsYouSet(ctx context.Context, q *db.Queries, viewerUid string, uids []uuid.UUID) (map[uuid.UUID]bool, error) {
+	followsYou := make(map[uuid.UUID]bool)
+	if viewerUid == "" || len(uids) == 0 {
+		return followsYou, nil
+	}
+
+	followers, err := q.ListFollowersAmongUids(ctx, db.ListFollowersAmongUidsParams{
+		Viewer: util.UUID(viewerUid),
+		Uids:   uids,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list followers among uids: %w", err)
+	}
+	for _, uid := range followers {
+		followsYou[uid] = true
+	}
+	return followsYou, nil
+}
+
 type followPageToken struct {
 	CursorCreatedAt int64  `json:"cursor_created_at,omitempty"`
 	CursorID        string `json:"cursor_id,omitempty"`
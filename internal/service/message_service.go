@@ -55,20 +55,31 @@ func (s *MessageService) ListCommentInboxMessages(ctx context.Context, uid strin
 		}
 	}
 
+	attachmentLists := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		attachmentLists = append(attachmentLists, row.CommentAttachments)
+	}
+	fileMap, err := listAttachmentFileMap(ctx, s.db, attachmentLists...)
+	if err != nil {
+		return nil, err
+	}
+
 	messages := make([]*api.CommentInboxMessage, 0, len(rows))
 	for _, row := range rows {
 		if !row.CommentUid.Valid {
 			continue
 		}
 		messages = append(messages, &api.CommentInboxMessage{
-			Uid:            row.Uid.String(),
-			IsRead:         row.IsRead,
-			CreatedAt:      row.CreatedAt.Time.Unix(),
-			CommentUid:     util.NullUUIDString(row.CommentUid),
-			CommentContent: row.CommentContent.String,
-			PostUid:        util.NullUUIDString(row.PostUid),
-			ParentUid:      util.NullUUIDString(row.ParentUid),
-			ParentContent:  row.ParentContent,
+			Uid:                row.Uid.String(),
+			IsRead:             row.IsRead,
+			CreatedAt:          row.CreatedAt.Time.Unix(),
+			CommentUid:         util.NullUUIDString(row.CommentUid),
+			CommentContent:     row.CommentContent.String,
+			CommentImages:      row.CommentImages,
+			CommentAttachments: buildAttachmentsByURLOrder(row.CommentAttachments, fileMap),
+			PostUid:            util.NullUUIDString(row.PostUid),
+			ParentUid:          util.NullUUIDString(row.ParentUid),
+			ParentContent:      row.ParentContent,
 			Actor: &api.InboxMessageActor{
 				Uid:       row.ActorUid.String(),
 				Nickname:  row.ActorNickname,
@@ -157,6 +168,141 @@ func (s *MessageService) ListFollowInboxMessages(ctx context.Context, uid string
 	}, nil
 }
 
+func (s *MessageService) ListLikeInboxMessages(ctx context.Context, uid string, req *api.ListLikeInboxMessagesRequest) (*api.ListLikeInboxMessagesResponse, error) {
+	token, err := decodeInboxPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	isReadFilter := readFilterToIsReadFilter(req.ReadFilter)
+	rows, err := s.db.ListLikeInboxMessages(ctx, db.ListLikeInboxMessagesParams{
+		ReceiverUid:     util.UUID(uid),
+		IsRead:          isReadFilter,
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list like inbox messages: %w", err)
+	}
+
+	if len(rows) > 0 && req.ReadFilter != api.InboxMessageReadFilter_INBOX_MESSAGE_READ_FILTER_READ {
+		messageUids := make([]uuid.UUID, 0, len(rows))
+		for _, row := range rows {
+			messageUids = append(messageUids, row.Uid)
+		}
+		if _, err := s.db.MarkInboxMessagesReadByUidsAndReceiver(ctx, db.MarkInboxMessagesReadByUidsAndReceiverParams{
+			ReceiverUid: util.UUID(uid),
+			Uids:        messageUids,
+		}); err != nil {
+			return nil, fmt.Errorf("mark like inbox messages read: %w", err)
+		}
+	}
+
+	messages := make([]*api.LikeInboxMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, &api.LikeInboxMessage{
+			Uid:            row.Uid.String(),
+			IsRead:         row.IsRead,
+			CreatedAt:      row.CreatedAt.Time.Unix(),
+			Type:           likeInboxMessageType(row.Type, row.CommentUid),
+			PostUid:        util.NullUUIDString(row.PostUid),
+			PostText:       row.PostText.String,
+			CommentUid:     util.NullUUIDString(row.CommentUid),
+			CommentContent: row.CommentContent.String,
+			Actor: &api.InboxMessageActor{
+				Uid:       row.ActorUid.String(),
+				Nickname:  row.ActorNickname,
+				AvatarUrl: row.ActorAvatarUrl,
+			},
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodeInboxPageToken(inboxPageToken{
+			CursorCreatedAt: last.CreatedAt.Time.Unix(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListLikeInboxMessagesResponse{
+		Messages:      messages,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *MessageService) ListSystemInboxMessages(ctx context.Context, uid string, req *api.ListSystemInboxMessagesRequest) (*api.ListSystemInboxMessagesResponse, error) {
+	token, err := decodeInboxPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	isReadFilter := readFilterToIsReadFilter(req.ReadFilter)
+	rows, err := s.db.ListSystemInboxMessages(ctx, db.ListSystemInboxMessagesParams{
+		ReceiverUid:     util.UUID(uid),
+		IsRead:          isReadFilter,
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list system inbox messages: %w", err)
+	}
+
+	if len(rows) > 0 && req.ReadFilter != api.InboxMessageReadFilter_INBOX_MESSAGE_READ_FILTER_READ {
+		messageUids := make([]uuid.UUID, 0, len(rows))
+		for _, row := range rows {
+			messageUids = append(messageUids, row.Uid)
+		}
+		if _, err := s.db.MarkInboxMessagesReadByUidsAndReceiver(ctx, db.MarkInboxMessagesReadByUidsAndReceiverParams{
+			ReceiverUid: util.UUID(uid),
+			Uids:        messageUids,
+		}); err != nil {
+			return nil, fmt.Errorf("mark system inbox messages read: %w", err)
+		}
+	}
+
+	messages := make([]*api.SystemInboxMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, &api.SystemInboxMessage{
+			Uid:       row.Uid.String(),
+			IsRead:    row.IsRead,
+			CreatedAt: row.CreatedAt.Time.Unix(),
+			Type:      api.SystemInboxMessageType_SYSTEM_INBOX_MESSAGE_TYPE_FOLLOW_IMPORT,
+			FollowImport: &api.FollowImport{
+				Uid:                   row.FollowImportUid.String(),
+				Status:                followImportStatusToProto(row.FollowImportStatus),
+				TotalCount:            row.FollowImportTotalCount,
+				FollowedCount:         row.FollowImportFollowedCount,
+				AlreadyFollowingCount: row.FollowImportAlreadyFollowingCount,
+				NotFoundCount:         row.FollowImportNotFoundCount,
+				SkippedCount:          row.FollowImportSkippedCount,
+				Error:                 row.FollowImportError,
+			},
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodeInboxPageToken(inboxPageToken{
+			CursorCreatedAt: last.CreatedAt.Time.Unix(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListSystemInboxMessagesResponse{
+		Messages:      messages,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func (s *MessageService) DeleteInboxMessage(ctx context.Context, uid string, req *api.DeleteInboxMessageRequest) error {
 	affected, err := s.db.ArchiveInboxMessageByUidAndReceiver(ctx, db.ArchiveInboxMessageByUidAndReceiverParams{
 		Uid:         util.UUID(req.Uid),
@@ -190,6 +336,8 @@ func (s *MessageService) CountUnreadInboxMessages(ctx context.Context, uid strin
 		UnreadCount:        counts.UnreadCount,
 		FollowUnreadCount:  counts.FollowUnreadCount,
 		CommentUnreadCount: counts.CommentUnreadCount,
+		LikeUnreadCount:    counts.LikeUnreadCount,
+		SystemUnreadCount:  counts.SystemUnreadCount,
 	}, nil
 }
 
@@ -204,6 +352,19 @@ func readFilterToIsReadFilter(readFilter api.InboxMessageReadFilter) pgtype.Bool
 	}
 }
 
+func likeInboxMessageType(messageType db.MessageType, commentUid uuid.NullUUID) api.LikeInboxMessageType {
+	switch {
+	case messageType == db.MessageTypeCOLLECT:
+		return api.LikeInboxMessageType_LIKE_INBOX_MESSAGE_TYPE_COLLECT_POST
+	case messageType == db.MessageTypeLIKE && commentUid.Valid:
+		return api.LikeInboxMessageType_LIKE_INBOX_MESSAGE_TYPE_LIKE_COMMENT
+	case messageType == db.MessageTypeLIKE:
+		return api.LikeInboxMessageType_LIKE_INBOX_MESSAGE_TYPE_LIKE_POST
+	default:
+		return api.LikeInboxMessageType_LIKE_INBOX_MESSAGE_TYPE_UNSPECIFIED
+	}
+}
+
 type inboxPageToken struct {
 	CursorCreatedAt int64  `json:"cursor_created_at,omitempty"`
 	CursorID        string `json:"cursor_id,omitempty"`
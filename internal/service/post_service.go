@@ -3,15 +3,22 @@ package service
 import (
 	"aeibi/api"
 	"aeibi/internal/async"
+	"aeibi/internal/auth"
+	"aeibi/internal/geoip"
+	"aeibi/internal/linkpreview"
+	"aeibi/internal/reaction"
 	"aeibi/internal/repository/db"
 	"aeibi/internal/repository/oss"
 	searchrepo "aeibi/internal/repository/search"
+	"aeibi/internal/viewcount"
 	"aeibi/util"
 	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,43 +31,66 @@ import (
 )
 
 type PostService struct {
-	db       *db.Queries
-	pool     *pgxpool.Pool
-	oss      *oss.OSS
-	search   *searchrepo.Search
-	producer *async.Producer
+	db        *db.Queries
+	pool      *pgxpool.Pool
+	oss       *oss.OSS
+	search    *searchrepo.Search
+	producer  *async.Producer
+	views     *viewcount.Counter
+	geo       *geoip.Resolver
+	reactions *reaction.Set
 }
 
-func NewPostService(pool *pgxpool.Pool, ossClient *oss.OSS, search *searchrepo.Search, riverClient *river.Client[pgx.Tx]) *PostService {
+func NewPostService(pool *pgxpool.Pool, ossClient *oss.OSS, search *searchrepo.Search, riverClient *river.Client[pgx.Tx], views *viewcount.Counter, geo *geoip.Resolver, reactions *reaction.Set) *PostService {
 	return &PostService{
-		db:       db.New(pool),
-		pool:     pool,
-		oss:      ossClient,
-		search:   search,
-		producer: async.New(riverClient),
+		db:        db.New(pool),
+		pool:      pool,
+		oss:       ossClient,
+		search:    search,
+		producer:  async.New(riverClient),
+		views:     views,
+		geo:       geo,
+		reactions: reactions,
 	}
 }
 
 func (s *PostService) CreatePost(ctx context.Context, uid string, req *api.CreatePostRequest) (*api.CreatePostResponse, error) {
 	var resp *api.CreatePostResponse
+	linkURL := linkpreview.FirstURL(req.Text)
+	ip, _ := auth.ClientIPFromContext(ctx)
 
 	if err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
 		qtx := s.db.WithTx(tx)
 
 		row, err := qtx.CreatePost(ctx, db.CreatePostParams{
-			Uid:         uuid.New(),
-			Author:      util.UUID(uid),
-			Text:        req.Text,
-			Images:      req.Images,
-			Attachments: req.Attachments,
-			Visibility:  db.NullPostVisibility{PostVisibility: db.PostVisibility(req.Visibility), Valid: req.Visibility != ""},
-			Pinned:      req.Pinned,
+			Uid:            uuid.New(),
+			Author:         util.UUID(uid),
+			Text:           req.Text,
+			Images:         req.Images,
+			Attachments:    req.Attachments,
+			Visibility:     db.NullPostVisibility{PostVisibility: db.PostVisibility(req.Visibility), Valid: req.Visibility != ""},
+			Pinned:         req.Pinned,
+			ContentWarning: strings.TrimSpace(req.ContentWarning),
+			SensitiveMedia: req.SensitiveMedia,
+			CommentPolicy:  db.NullPostCommentPolicy{PostCommentPolicy: db.PostCommentPolicy(req.CommentPolicy), Valid: req.CommentPolicy != ""},
+			LinkUrl:        linkURL,
+			Ip:             ip,
+			IpRegion:       s.geo.Region(ip),
 		})
 		if err != nil {
 			return fmt.Errorf("create post: %w", err)
 		}
+		if err := linkPostFiles(ctx, qtx, row.Uid, util.UUID(uid), db.PostFileKindIMAGE, req.Images); err != nil {
+			return err
+		}
+		if err := linkPostFiles(ctx, qtx, row.Uid, util.UUID(uid), db.PostFileKindATTACHMENT, req.Attachments); err != nil {
+			return err
+		}
 
-		tags := util.NormalizeStrings(req.Tags)
+		tags, err := resolveTags(ctx, qtx, req.Tags)
+		if err != nil {
+			return err
+		}
 		if len(tags) > 0 {
 			if err := qtx.InsertTagsIfNotExists(ctx, tags); err != nil {
 				return fmt.Errorf("insert tags if not exists: %w", err)
@@ -84,6 +114,11 @@ func (s *PostService) CreatePost(ctx context.Context, uid string, req *api.Creat
 		}); err != nil {
 			return fmt.Errorf("enqueue update post search job: %w", err)
 		}
+		if linkURL != "" {
+			if err := s.producer.EnqueueLinkPreviewTx(ctx, tx, async.LinkPreviewArgs{URL: linkURL}); err != nil {
+				return fmt.Errorf("enqueue link preview job: %w", err)
+			}
+		}
 
 		resp = &api.CreatePostResponse{
 			Uid: row.Uid.String(),
@@ -110,6 +145,8 @@ func (s *PostService) GetPost(ctx context.Context, viewerUid string, req *api.Ge
 	if postRow.Visibility == db.PostVisibilityPRIVATE && util.UUID(viewerUid) != postRow.Author {
 		return nil, fmt.Errorf("post not found")
 	}
+	s.recordViews(ctx, viewerUid, postRow.Uid)
+
 	fileRow, err := s.db.GetFilesByUrls(ctx, postRow.Attachments)
 	if err != nil {
 		return nil, fmt.Errorf("get attachments: %w", err)
@@ -124,6 +161,22 @@ func (s *PostService) GetPost(ctx context.Context, viewerUid string, req *api.Ge
 			Checksum:    file.Checksum,
 		})
 	}
+	linkPreviewMap, err := s.listLinkPreviewMap(ctx, []string{postRow.LinkUrl})
+	if err != nil {
+		return nil, err
+	}
+	reactionMap, err := s.listReactionMap(ctx, viewerUid, []uuid.UUID{postRow.Uid})
+	if err != nil {
+		return nil, err
+	}
+	followsYou, err := listFollowsYouSet(ctx, s.db, viewerUid, []uuid.UUID{postRow.AuthorUid})
+	if err != nil {
+		return nil, err
+	}
+	showIP, err := isAdmin(ctx, s.db, viewerUid)
+	if err != nil {
+		return nil, err
+	}
 	return &api.GetPostResponse{Post: &api.Post{
 		Uid: postRow.Uid.String(),
 		Author: &api.PostAuthor{
@@ -131,6 +184,7 @@ func (s *PostService) GetPost(ctx context.Context, viewerUid string, req *api.Ge
 			Nickname:    postRow.AuthorNickname,
 			AvatarUrl:   postRow.AuthorAvatarUrl,
 			IsFollowing: postRow.Following,
+			FollowsYou:  followsYou[postRow.AuthorUid],
 		},
 		Text:            postRow.Text,
 		Images:          postRow.Images,
@@ -139,14 +193,21 @@ func (s *PostService) GetPost(ctx context.Context, viewerUid string, req *api.Ge
 		CommentCount:    postRow.CommentCount,
 		CollectionCount: postRow.CollectionCount,
 		LikeCount:       postRow.LikeCount,
+		ViewCount:       postRow.ViewCount,
 		Visibility:      string(postRow.Visibility),
 		LatestRepliedOn: postRow.LatestRepliedOn.Time.Unix(),
-		Ip:              postRow.Ip,
+		Ip:              visibleIP(postRow.Ip, showIP),
+		IpRegion:        postRow.IpRegion,
 		Pinned:          postRow.Pinned,
 		Liked:           postRow.Liked,
 		Collected:       postRow.Collected,
 		CreatedAt:       postRow.CreatedAt.Time.Unix(),
 		UpdatedAt:       postRow.UpdatedAt.Time.Unix(),
+		ContentWarning:  postRow.ContentWarning,
+		SensitiveMedia:  postRow.SensitiveMedia,
+		CommentPolicy:   string(postRow.CommentPolicy),
+		LinkPreview:     linkPreviewMap[postRow.LinkUrl],
+		Reactions:       reactionMap[postRow.Uid],
 	}}, nil
 }
 
@@ -155,12 +216,22 @@ func (s *PostService) ListPosts(ctx context.Context, viewerUid string, req *api.
 	if err != nil {
 		return nil, err
 	}
+	sort := req.Sort
+	if sort == "" {
+		sort = db.ListPostsSortLatest
+	}
+	if req.PageToken != "" && token.Sort != sort {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
 
 	rows, err := s.db.ListPosts(ctx, db.ListPostsParams{
 		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
 		AuthorUid:       uuid.NullUUID{UUID: util.UUID(req.AuthorUid), Valid: req.AuthorUid != ""},
 		TagName:         pgtype.Text{String: req.TagName, Valid: req.TagName != ""},
+		Sort:            sort,
 		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorRepliedOn: pgtype.Timestamptz{Time: time.Unix(token.CursorRepliedOn, 0).UTC(), Valid: token.CursorRepliedOn > 0},
+		CursorHotScore:  pgtype.Float8{Float64: token.CursorHotScore, Valid: token.CursorID != ""},
 		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
 	})
 	if err != nil {
@@ -168,11 +239,34 @@ func (s *PostService) ListPosts(ctx context.Context, viewerUid string, req *api.
 	}
 
 	posts := make([]*api.Post, 0, len(rows))
+	postUIDs := make([]uuid.UUID, 0, len(rows))
+	authorUIDs := make([]uuid.UUID, 0, len(rows))
 	attachmentLists := make([][]string, 0, len(rows))
+	linkURLs := make([]string, 0, len(rows))
 	for _, row := range rows {
+		postUIDs = append(postUIDs, row.Uid)
+		authorUIDs = append(authorUIDs, row.AuthorUid)
 		attachmentLists = append(attachmentLists, row.Attachments)
+		linkURLs = append(linkURLs, row.LinkUrl)
+	}
+	s.recordViews(ctx, viewerUid, postUIDs...)
+	fileMap, err := listAttachmentFileMap(ctx, s.db, attachmentLists...)
+	if err != nil {
+		return nil, err
+	}
+	linkPreviewMap, err := s.listLinkPreviewMap(ctx, linkURLs)
+	if err != nil {
+		return nil, err
+	}
+	reactionMap, err := s.listReactionMap(ctx, viewerUid, postUIDs)
+	if err != nil {
+		return nil, err
+	}
+	followsYou, err := listFollowsYouSet(ctx, s.db, viewerUid, authorUIDs)
+	if err != nil {
+		return nil, err
 	}
-	fileMap, err := s.listAttachmentFileMap(ctx, attachmentLists...)
+	showIP, err := isAdmin(ctx, s.db, viewerUid)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +279,7 @@ func (s *PostService) ListPosts(ctx context.Context, viewerUid string, req *api.
 				Nickname:    row.AuthorNickname,
 				AvatarUrl:   row.AuthorAvatarUrl,
 				IsFollowing: row.Following,
+				FollowsYou:  followsYou[row.AuthorUid],
 			},
 			Text:            row.Text,
 			Images:          row.Images,
@@ -193,14 +288,21 @@ func (s *PostService) ListPosts(ctx context.Context, viewerUid string, req *api.
 			CommentCount:    row.CommentCount,
 			CollectionCount: row.CollectionCount,
 			LikeCount:       row.LikeCount,
+			ViewCount:       row.ViewCount,
 			Visibility:      string(row.Visibility),
 			LatestRepliedOn: row.LatestRepliedOn.Time.Unix(),
-			Ip:              row.Ip,
+			Ip:              visibleIP(row.Ip, showIP),
+			IpRegion:        row.IpRegion,
 			Pinned:          row.Pinned,
 			Liked:           row.Liked,
 			Collected:       row.Collected,
 			CreatedAt:       row.CreatedAt.Time.Unix(),
 			UpdatedAt:       row.UpdatedAt.Time.Unix(),
+			ContentWarning:  row.ContentWarning,
+			SensitiveMedia:  row.SensitiveMedia,
+			CommentPolicy:   string(row.CommentPolicy),
+			LinkPreview:     linkPreviewMap[row.LinkUrl],
+			Reactions:       reactionMap[row.Uid],
 		})
 	}
 
@@ -208,8 +310,16 @@ func (s *PostService) ListPosts(ctx context.Context, viewerUid string, req *api.
 	if len(rows) > 0 {
 		last := rows[len(rows)-1]
 		token := postPageToken{
-			CursorCreatedAt: last.CreatedAt.Time.Unix(),
-			CursorID:        last.Uid.String(),
+			Sort:     sort,
+			CursorID: last.Uid.String(),
+		}
+		switch sort {
+		case db.ListPostsSortActive:
+			token.CursorRepliedOn = last.LatestRepliedOn.Time.Unix()
+		case db.ListPostsSortHot:
+			token.CursorHotScore = last.HotScore
+		default:
+			token.CursorCreatedAt = last.CreatedAt.Time.Unix()
 		}
 		nextPageToken, err = encodePostPageToken(token)
 		if err != nil {
@@ -229,25 +339,38 @@ func (s *PostService) SearchPosts(ctx context.Context, viewerUid string, req *ap
 		return nil, err
 	}
 
+	excludeSensitive := false
+	if viewerUid != "" {
+		preference, err := s.db.GetUserSensitiveMediaPreference(ctx, util.UUID(viewerUid))
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("get sensitive media preference: %w", err)
+		}
+		excludeSensitive = preference == db.SensitiveMediaPreferenceFILTER
+	}
+
 	result, err := s.search.SearchPosts(searchrepo.SearchPostsParams{
-		Query:     req.Query,
-		ViewerUID: viewerUid,
-		AuthorUID: req.AuthorUid,
-		TagName:   req.TagName,
-		Limit:     20,
-		Offset:    token.Offset,
+		Query:            req.Query,
+		ViewerUID:        viewerUid,
+		AuthorUID:        req.AuthorUid,
+		TagName:          req.TagName,
+		Limit:            20,
+		Offset:           token.Offset,
+		SortBy:           req.Sort,
+		ExcludeSensitive: excludeSensitive,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("search posts: %w", err)
 	}
 
 	postUIDs := make([]uuid.UUID, 0, len(result.Hits))
+	authorUIDs := make([]uuid.UUID, 0, len(result.Hits))
 	for _, hit := range result.Hits {
 		uid, err := uuid.Parse(hit.UID)
 		if err != nil {
 			continue
 		}
 		postUIDs = append(postUIDs, uid)
+		authorUIDs = append(authorUIDs, util.UUID(hit.AuthorUID))
 	}
 
 	extrasRows, err := s.db.GetPostSearchExtrasByUids(ctx, db.GetPostSearchExtrasByUidsParams{
@@ -259,19 +382,36 @@ func (s *PostService) SearchPosts(ctx context.Context, viewerUid string, req *ap
 	}
 
 	extrasByUID := make(map[string]db.GetPostSearchExtrasByUidsRow, len(extrasRows))
+	visibleUIDs := make([]uuid.UUID, 0, len(extrasRows))
 	for _, row := range extrasRows {
 		extrasByUID[row.Uid.String()] = row
+		visibleUIDs = append(visibleUIDs, row.Uid)
 	}
+	s.recordViews(ctx, viewerUid, visibleUIDs...)
 
 	attachmentLists := make([][]string, 0, len(result.Hits))
+	linkURLs := make([]string, 0, len(result.Hits))
 	for _, hit := range result.Hits {
 		if _, ok := extrasByUID[hit.UID]; !ok {
 			continue
 		}
 		attachmentLists = append(attachmentLists, hit.Attachments)
+		linkURLs = append(linkURLs, hit.LinkURL)
 	}
 
-	fileMap, err := s.listAttachmentFileMap(ctx, attachmentLists...)
+	fileMap, err := listAttachmentFileMap(ctx, s.db, attachmentLists...)
+	if err != nil {
+		return nil, err
+	}
+	linkPreviewMap, err := s.listLinkPreviewMap(ctx, linkURLs)
+	if err != nil {
+		return nil, err
+	}
+	reactionMap, err := s.listReactionMap(ctx, viewerUid, visibleUIDs)
+	if err != nil {
+		return nil, err
+	}
+	followsYou, err := listFollowsYouSet(ctx, s.db, viewerUid, authorUIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -291,6 +431,7 @@ func (s *PostService) SearchPosts(ctx context.Context, viewerUid string, req *ap
 				Nickname:    extra.AuthorNickname,
 				AvatarUrl:   extra.AuthorAvatarUrl,
 				IsFollowing: extra.IsFollowing,
+				FollowsYou:  followsYou[util.UUID(hit.AuthorUID)],
 			},
 			Text:            hit.Text,
 			Images:          hit.Images,
@@ -299,14 +440,21 @@ func (s *PostService) SearchPosts(ctx context.Context, viewerUid string, req *ap
 			CommentCount:    int32(hit.CommentCount),
 			CollectionCount: int32(hit.CollectionCount),
 			LikeCount:       int32(hit.LikeCount),
+			ViewCount:       hit.ViewCount,
 			Visibility:      hit.Visibility,
 			LatestRepliedOn: hit.LatestRepliedOn,
 			Ip:              "",
+			IpRegion:        hit.IPRegion,
 			Pinned:          hit.Pinned,
 			Liked:           extra.Liked,
 			Collected:       extra.Collected,
 			CreatedAt:       hit.CreatedAt,
 			UpdatedAt:       hit.UpdatedAt,
+			ContentWarning:  hit.ContentWarning,
+			SensitiveMedia:  hit.SensitiveMedia,
+			CommentPolicy:   hit.CommentPolicy,
+			LinkPreview:     linkPreviewMap[hit.LinkURL],
+			Reactions:       reactionMap[util.UUID(hit.UID)],
 		})
 	}
 
@@ -325,12 +473,26 @@ func (s *PostService) SearchPosts(ctx context.Context, viewerUid string, req *ap
 	}, nil
 }
 
-func (s *PostService) ListMyCollections(ctx context.Context, uid string, req *api.ListPostsRequest) (*api.ListPostsResponse, error) {
+func (s *PostService) ListMyCollections(ctx context.Context, uid string, req *api.ListMyCollectionsRequest) (*api.ListPostsResponse, error) {
 	token, err := decodePostPageToken(req.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 
+	if req.FolderUid != "" {
+		folder, err := s.db.GetCollectionFolderByUid(ctx, util.UUID(req.FolderUid))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, fmt.Errorf("collection folder not found")
+			}
+			return nil, fmt.Errorf("get collection folder: %w", err)
+		}
+		if folder.OwnerUid != util.UUID(uid) {
+			return nil, fmt.Errorf("collection folder not found")
+		}
+		return s.listCollectionFolderPosts(ctx, uid, folder.Uid, token)
+	}
+
 	rows, err := s.db.ListPostsByCollector(ctx, db.ListPostsByCollectorParams{
 		Collector:       util.UUID(uid),
 		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
@@ -342,10 +504,32 @@ func (s *PostService) ListMyCollections(ctx context.Context, uid string, req *ap
 
 	posts := make([]*api.Post, 0, len(rows))
 	attachmentLists := make([][]string, 0, len(rows))
+	linkURLs := make([]string, 0, len(rows))
+	postUIDs := make([]uuid.UUID, 0, len(rows))
+	authorUIDs := make([]uuid.UUID, 0, len(rows))
 	for _, row := range rows {
 		attachmentLists = append(attachmentLists, row.Attachments)
+		linkURLs = append(linkURLs, row.LinkUrl)
+		postUIDs = append(postUIDs, row.Uid)
+		authorUIDs = append(authorUIDs, row.AuthorUid)
+	}
+	fileMap, err := listAttachmentFileMap(ctx, s.db, attachmentLists...)
+	if err != nil {
+		return nil, err
+	}
+	linkPreviewMap, err := s.listLinkPreviewMap(ctx, linkURLs)
+	if err != nil {
+		return nil, err
+	}
+	reactionMap, err := s.listReactionMap(ctx, uid, postUIDs)
+	if err != nil {
+		return nil, err
+	}
+	followsYou, err := listFollowsYouSet(ctx, s.db, uid, authorUIDs)
+	if err != nil {
+		return nil, err
 	}
-	fileMap, err := s.listAttachmentFileMap(ctx, attachmentLists...)
+	showIP, err := isAdmin(ctx, s.db, uid)
 	if err != nil {
 		return nil, err
 	}
@@ -364,6 +548,7 @@ func (s *PostService) ListMyCollections(ctx context.Context, uid string, req *ap
 				Nickname:    row.AuthorNickname,
 				AvatarUrl:   row.AuthorAvatarUrl,
 				IsFollowing: row.Following,
+				FollowsYou:  followsYou[row.AuthorUid],
 			},
 			Text:            row.Text,
 			Images:          row.Images,
@@ -372,14 +557,21 @@ func (s *PostService) ListMyCollections(ctx context.Context, uid string, req *ap
 			CommentCount:    row.CommentCount,
 			CollectionCount: row.CollectionCount,
 			LikeCount:       row.LikeCount,
+			ViewCount:       row.ViewCount,
 			Visibility:      string(row.Visibility),
 			LatestRepliedOn: row.LatestRepliedOn.Time.Unix(),
-			Ip:              row.Ip,
+			Ip:              visibleIP(row.Ip, showIP),
+			IpRegion:        row.IpRegion,
 			Pinned:          row.Pinned,
 			Liked:           row.Liked,
 			Collected:       row.Collected,
 			CreatedAt:       row.CreatedAt.Time.Unix(),
 			UpdatedAt:       row.UpdatedAt.Time.Unix(),
+			ContentWarning:  row.ContentWarning,
+			SensitiveMedia:  row.SensitiveMedia,
+			CommentPolicy:   string(row.CommentPolicy),
+			LinkPreview:     linkPreviewMap[row.LinkUrl],
+			Reactions:       reactionMap[row.Uid],
 		})
 	}
 
@@ -402,6 +594,124 @@ func (s *PostService) ListMyCollections(ctx context.Context, uid string, req *ap
 	}, nil
 }
 
+func (s *PostService) ListCollectionFolderPosts(ctx context.Context, viewerUid string, req *api.ListCollectionFolderPostsRequest) (*api.ListPostsResponse, error) {
+	token, err := decodePostPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := s.db.GetCollectionFolderByUid(ctx, util.UUID(req.Uid))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("collection folder not found")
+		}
+		return nil, fmt.Errorf("get collection folder: %w", err)
+	}
+	if !folder.IsPublic && folder.OwnerUid != util.UUID(viewerUid) {
+		return nil, fmt.Errorf("collection folder not found")
+	}
+
+	return s.listCollectionFolderPosts(ctx, viewerUid, folder.Uid, token)
+}
+
+func (s *PostService) listCollectionFolderPosts(ctx context.Context, viewerUid string, folderUid uuid.UUID, token postPageToken) (*api.ListPostsResponse, error) {
+	rows, err := s.db.ListPostsByCollectionFolder(ctx, db.ListPostsByCollectionFolderParams{
+		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		FolderUid:       folderUid,
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list posts: %w", err)
+	}
+
+	attachmentLists := make([][]string, 0, len(rows))
+	linkURLs := make([]string, 0, len(rows))
+	postUIDs := make([]uuid.UUID, 0, len(rows))
+	authorUIDs := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		attachmentLists = append(attachmentLists, row.Attachments)
+		linkURLs = append(linkURLs, row.LinkUrl)
+		postUIDs = append(postUIDs, row.Uid)
+		authorUIDs = append(authorUIDs, row.AuthorUid)
+	}
+	fileMap, err := listAttachmentFileMap(ctx, s.db, attachmentLists...)
+	if err != nil {
+		return nil, err
+	}
+	linkPreviewMap, err := s.listLinkPreviewMap(ctx, linkURLs)
+	if err != nil {
+		return nil, err
+	}
+	reactionMap, err := s.listReactionMap(ctx, viewerUid, postUIDs)
+	if err != nil {
+		return nil, err
+	}
+	followsYou, err := listFollowsYouSet(ctx, s.db, viewerUid, authorUIDs)
+	if err != nil {
+		return nil, err
+	}
+	showIP, err := isAdmin(ctx, s.db, viewerUid)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*api.Post, 0, len(rows))
+	for _, row := range rows {
+		attachments := buildAttachmentsByURLOrder(row.Attachments, fileMap)
+
+		posts = append(posts, &api.Post{
+			Uid: row.Uid.String(),
+			Author: &api.PostAuthor{
+				Uid:         row.AuthorUid.String(),
+				Nickname:    row.AuthorNickname,
+				AvatarUrl:   row.AuthorAvatarUrl,
+				IsFollowing: row.Following,
+				FollowsYou:  followsYou[row.AuthorUid],
+			},
+			Text:            row.Text,
+			Images:          row.Images,
+			Attachments:     attachments,
+			Tags:            row.TagNames,
+			CommentCount:    row.CommentCount,
+			CollectionCount: row.CollectionCount,
+			LikeCount:       row.LikeCount,
+			ViewCount:       row.ViewCount,
+			Visibility:      string(row.Visibility),
+			LatestRepliedOn: row.LatestRepliedOn.Time.Unix(),
+			Ip:              visibleIP(row.Ip, showIP),
+			IpRegion:        row.IpRegion,
+			Pinned:          row.Pinned,
+			Liked:           row.Liked,
+			Collected:       row.Collected,
+			CreatedAt:       row.CreatedAt.Time.Unix(),
+			UpdatedAt:       row.UpdatedAt.Time.Unix(),
+			ContentWarning:  row.ContentWarning,
+			SensitiveMedia:  row.SensitiveMedia,
+			CommentPolicy:   string(row.CommentPolicy),
+			LinkPreview:     linkPreviewMap[row.LinkUrl],
+			Reactions:       reactionMap[row.Uid],
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodePostPageToken(postPageToken{
+			CursorCreatedAt: last.CreatedAt.Time.Unix(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListPostsResponse{
+		Posts:         posts,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func (s *PostService) SearchTags(_ context.Context, req *api.SearchTagsRequest) (*api.SearchTagsResponse, error) {
 	result, err := s.search.SearchTags(searchrepo.SearchTagsParams{
 		Query: req.Query,
@@ -414,7 +724,8 @@ func (s *PostService) SearchTags(_ context.Context, req *api.SearchTagsRequest)
 	tags := make([]*api.SearchTag, 0, len(result.Hits))
 	for _, hit := range result.Hits {
 		tags = append(tags, &api.SearchTag{
-			Name: hit.Name,
+			Name:      hit.Name,
+			PostCount: int32(hit.PostCount),
 		})
 	}
 
@@ -423,111 +734,445 @@ func (s *PostService) SearchTags(_ context.Context, req *api.SearchTagsRequest)
 	}, nil
 }
 
-func (s *PostService) SuggestTagsByPrefix(_ context.Context, req *api.SuggestTagsByPrefixRequest) (*api.SuggestTagsByPrefixResponse, error) {
-	result, err := s.search.SuggestTagsByName(req.Prefix, 10)
+func (s *PostService) ListTrendingTags(ctx context.Context, req *api.ListTrendingTagsRequest) (*api.ListTrendingTagsResponse, error) {
+	var window time.Duration
+	switch req.Window {
+	case api.TagTrendWindow_TAG_TREND_WINDOW_1H:
+		window = time.Hour
+	case api.TagTrendWindow_TAG_TREND_WINDOW_UNSPECIFIED, api.TagTrendWindow_TAG_TREND_WINDOW_24H:
+		window = 24 * time.Hour
+	case api.TagTrendWindow_TAG_TREND_WINDOW_7D:
+		window = 7 * 24 * time.Hour
+	default:
+		return nil, status.Error(codes.InvalidArgument, "window is invalid")
+	}
+
+	rows, err := s.db.ListTrendingTags(ctx, pgtype.Timestamptz{Time: time.Now().Add(-window), Valid: true})
 	if err != nil {
-		return nil, fmt.Errorf("suggest tags by prefix: %w", err)
+		return nil, fmt.Errorf("list trending tags: %w", err)
 	}
 
-	tags := make([]*api.SearchTag, 0, len(result.Hits))
-	for _, hit := range result.Hits {
-		tags = append(tags, &api.SearchTag{
-			Name: hit.Name,
+	tags := make([]*api.TrendingTag, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, &api.TrendingTag{
+			Name:            row.Name,
+			PostCount:       row.PostCount,
+			EngagementCount: row.EngagementCount,
 		})
 	}
 
-	return &api.SuggestTagsByPrefixResponse{
+	return &api.ListTrendingTagsResponse{
 		Tags: tags,
 	}, nil
 }
 
-func (s *PostService) UpdatePost(ctx context.Context, uid string, req *api.UpdatePostRequest) error {
-	if err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
-		qtx := s.db.WithTx(tx)
-
-		params := db.UpdatePostByUidAndAuthorParams{
-			Uid:    util.UUID(req.Uid),
-			Author: util.UUID(uid),
+func (s *PostService) GetTag(ctx context.Context, viewerUid string, req *api.GetTagRequest) (*api.GetTagResponse, error) {
+	row, err := s.db.GetTagByName(ctx, db.GetTagByNameParams{
+		Viewer: uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		Name:   req.Name,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("tag not found")
 		}
+		return nil, fmt.Errorf("get tag: %w", err)
+	}
+	if row.Banned {
+		return nil, fmt.Errorf("tag not found")
+	}
 
-		paths := make(map[string]struct{}, len(req.UpdateMask.GetPaths()))
-		for _, path := range req.UpdateMask.GetPaths() {
-			paths[path] = struct{}{}
-		}
+	return &api.GetTagResponse{
+		Tag: &api.Tag{
+			Name:          row.Name,
+			PostCount:     row.PostCount,
+			FollowerCount: row.FollowerCount,
+			IsFollowing:   row.Following,
+		},
+	}, nil
+}
 
-		if _, ok := paths["text"]; ok {
-			params.Text = pgtype.Text{String: req.Post.Text, Valid: true}
-		}
-		if _, ok := paths["images"]; ok {
-			params.Images = req.Post.Images
-		}
-		if _, ok := paths["attachments"]; ok {
-			params.Attachments = req.Post.Attachments
-		}
-		if _, ok := paths["visibility"]; ok {
-			params.Visibility = db.NullPostVisibility{PostVisibility: db.PostVisibility(req.Post.Visibility), Valid: true}
-		}
-		if _, ok := paths["pinned"]; ok {
-			params.Pinned = pgtype.Bool{Bool: req.Post.Pinned, Valid: true}
-		}
+func (s *PostService) FollowTag(ctx context.Context, uid string, req *api.FollowTagRequest) (*api.FollowTagResponse, error) {
+	userUid := util.UUID(uid)
 
-		id, err := qtx.UpdatePostByUidAndAuthor(ctx, params)
+	var count int32
+	if err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		tag, err := qtx.GetTagByName(ctx, db.GetTagByNameParams{Name: req.Name})
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
-				return fmt.Errorf("post not found")
+				return fmt.Errorf("tag not found")
 			}
-			return fmt.Errorf("update post: %w", err)
+			return fmt.Errorf("tag follow: get tag: %w", err)
+		}
+		if tag.Banned {
+			return fmt.Errorf("tag not found")
 		}
 
-		if _, ok := paths["tags"]; ok {
-			tags := util.NormalizeStrings(req.Post.Tags)
-
-			if len(tags) > 0 {
-				if err := qtx.InsertTagsIfNotExists(ctx, tags); err != nil {
-					return fmt.Errorf("update post: insert tags if not exists: %w", err)
-				}
-			}
+		affected, err := qtx.InsertTagFollowEdge(ctx, db.InsertTagFollowEdgeParams{
+			UserUid: userUid,
+			TagID:   tag.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("tag follow: insert tag follow edge: %w", err)
+		}
 
-			if err := qtx.DeletePostTagsNotInNames(ctx, db.DeletePostTagsNotInNamesParams{
-				PostID: id,
-				Tags:   tags,
-			}); err != nil {
-				return fmt.Errorf("update post: delete obsolete post tags: %w", err)
+		if affected > 0 {
+			count, err = qtx.IncrementTagFollowerCount(ctx, tag.ID)
+			if err != nil {
+				return fmt.Errorf("tag follow: increment tag follower count: %w", err)
 			}
-
-			if len(tags) > 0 {
-				if err := qtx.InsertPostTagsByNames(ctx, db.InsertPostTagsByNamesParams{
-					PostID: id,
-					Tags:   tags,
-				}); err != nil {
-					return fmt.Errorf("update post: insert post tags: %w", err)
-				}
-				if err := s.producer.EnqueueUpdateTagSearchTx(ctx, tx, async.UpdateTagSearchArgs{
-					TagNames: tags,
-				}); err != nil {
-					return fmt.Errorf("update post: enqueue update tag search job: %w", err)
-				}
+		} else {
+			count, err = qtx.GetTagFollowerCount(ctx, tag.ID)
+			if err != nil {
+				return fmt.Errorf("tag follow: get tag follower count: %w", err)
 			}
 		}
-		if err := s.producer.EnqueueUpdatePostSearchTx(ctx, tx, async.UpdatePostSearchArgs{
-			PostUID: params.Uid,
-			Action:  async.PostSearchActionUpsert,
-		}); err != nil {
-			return fmt.Errorf("enqueue update post search job: %w", err)
-		}
 
 		return nil
 	}); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return &api.FollowTagResponse{
+		FollowerCount: count,
+	}, nil
 }
 
-func (s *PostService) DeletePost(ctx context.Context, uid string, req *api.DeletePostRequest) error {
-	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+func (s *PostService) UnfollowTag(ctx context.Context, uid string, req *api.UnfollowTagRequest) (*api.UnfollowTagResponse, error) {
+	userUid := util.UUID(uid)
+
+	var count int32
+	if err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
 		qtx := s.db.WithTx(tx)
-		affected, err := qtx.ArchivePostByUidAndAuthor(ctx, db.ArchivePostByUidAndAuthorParams{
+
+		tag, err := qtx.GetTagByName(ctx, db.GetTagByNameParams{Name: req.Name})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("tag not found")
+			}
+			return fmt.Errorf("tag unfollow: get tag: %w", err)
+		}
+
+		affected, err := qtx.DeleteTagFollowEdge(ctx, db.DeleteTagFollowEdgeParams{
+			UserUid: userUid,
+			TagID:   tag.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("tag unfollow: delete tag follow edge: %w", err)
+		}
+
+		if affected > 0 {
+			count, err = qtx.DecrementTagFollowerCount(ctx, tag.ID)
+			if err != nil {
+				return fmt.Errorf("tag unfollow: decrement tag follower count: %w", err)
+			}
+		} else {
+			count, err = qtx.GetTagFollowerCount(ctx, tag.ID)
+			if err != nil {
+				return fmt.Errorf("tag unfollow: get tag follower count: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &api.UnfollowTagResponse{
+		FollowerCount: count,
+	}, nil
+}
+
+func (s *PostService) ListMyFollowedTags(ctx context.Context, uid string, req *api.ListMyFollowedTagsRequest) (*api.ListMyFollowedTagsResponse, error) {
+	token, err := decodeTagPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListFollowedTags(ctx, db.ListFollowedTagsParams{
+		UserUid:         util.UUID(uid),
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorName:      pgtype.Text{String: token.CursorName, Valid: token.CursorName != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list followed tags: %w", err)
+	}
+
+	tags := make([]*api.Tag, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, &api.Tag{
+			Name:          row.Name,
+			PostCount:     row.PostCount,
+			FollowerCount: row.FollowerCount,
+			IsFollowing:   true,
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodeTagPageToken(tagPageToken{
+			CursorCreatedAt: last.FollowedAt.Time.Unix(),
+			CursorName:      last.Name,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListMyFollowedTagsResponse{
+		Tags:          tags,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *PostService) ListMyFeed(ctx context.Context, uid string, req *api.ListMyFeedRequest) (*api.ListPostsResponse, error) {
+	token, err := decodePostPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListFeedPosts(ctx, db.ListFeedPostsParams{
+		ViewerUid:       util.UUID(uid),
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list feed posts: %w", err)
+	}
+
+	postUIDs := make([]uuid.UUID, 0, len(rows))
+	authorUIDs := make([]uuid.UUID, 0, len(rows))
+	attachmentLists := make([][]string, 0, len(rows))
+	linkURLs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		postUIDs = append(postUIDs, row.Uid)
+		authorUIDs = append(authorUIDs, row.AuthorUid)
+		attachmentLists = append(attachmentLists, row.Attachments)
+		linkURLs = append(linkURLs, row.LinkUrl)
+	}
+	s.recordViews(ctx, uid, postUIDs...)
+	fileMap, err := listAttachmentFileMap(ctx, s.db, attachmentLists...)
+	if err != nil {
+		return nil, err
+	}
+	linkPreviewMap, err := s.listLinkPreviewMap(ctx, linkURLs)
+	if err != nil {
+		return nil, err
+	}
+	reactionMap, err := s.listReactionMap(ctx, uid, postUIDs)
+	if err != nil {
+		return nil, err
+	}
+	followsYou, err := listFollowsYouSet(ctx, s.db, uid, authorUIDs)
+	if err != nil {
+		return nil, err
+	}
+	showIP, err := isAdmin(ctx, s.db, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*api.Post, 0, len(rows))
+	for _, row := range rows {
+		attachments := buildAttachmentsByURLOrder(row.Attachments, fileMap)
+		posts = append(posts, &api.Post{
+			Uid: row.Uid.String(),
+			Author: &api.PostAuthor{
+				Uid:         row.AuthorUid.String(),
+				Nickname:    row.AuthorNickname,
+				AvatarUrl:   row.AuthorAvatarUrl,
+				IsFollowing: row.Following,
+				FollowsYou:  followsYou[row.AuthorUid],
+			},
+			Text:            row.Text,
+			Images:          row.Images,
+			Attachments:     attachments,
+			Tags:            row.TagNames,
+			CommentCount:    row.CommentCount,
+			CollectionCount: row.CollectionCount,
+			LikeCount:       row.LikeCount,
+			ViewCount:       row.ViewCount,
+			Visibility:      string(row.Visibility),
+			LatestRepliedOn: row.LatestRepliedOn.Time.Unix(),
+			Ip:              visibleIP(row.Ip, showIP),
+			IpRegion:        row.IpRegion,
+			Pinned:          row.Pinned,
+			Liked:           row.Liked,
+			Collected:       row.Collected,
+			CreatedAt:       row.CreatedAt.Time.Unix(),
+			UpdatedAt:       row.UpdatedAt.Time.Unix(),
+			ContentWarning:  row.ContentWarning,
+			SensitiveMedia:  row.SensitiveMedia,
+			CommentPolicy:   string(row.CommentPolicy),
+			LinkPreview:     linkPreviewMap[row.LinkUrl],
+			Reactions:       reactionMap[row.Uid],
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodePostPageToken(postPageToken{
+			CursorCreatedAt: last.CreatedAt.Time.Unix(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListPostsResponse{
+		Posts:         posts,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *PostService) SuggestTagsByPrefix(_ context.Context, req *api.SuggestTagsByPrefixRequest) (*api.SuggestTagsByPrefixResponse, error) {
+	result, err := s.search.SuggestTagsByName(req.Prefix, 10)
+	if err != nil {
+		return nil, fmt.Errorf("suggest tags by prefix: %w", err)
+	}
+
+	tags := make([]*api.SearchTag, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		tags = append(tags, &api.SearchTag{
+			Name:      hit.Name,
+			PostCount: int32(hit.PostCount),
+		})
+	}
+
+	return &api.SuggestTagsByPrefixResponse{
+		Tags: tags,
+	}, nil
+}
+
+func (s *PostService) UpdatePost(ctx context.Context, uid string, req *api.UpdatePostRequest) error {
+	if err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+
+		params := db.UpdatePostByUidAndAuthorParams{
+			Uid:    util.UUID(req.Uid),
+			Author: util.UUID(uid),
+		}
+
+		paths := make(map[string]struct{}, len(req.UpdateMask.GetPaths()))
+		for _, path := range req.UpdateMask.GetPaths() {
+			paths[path] = struct{}{}
+		}
+
+		linkURL := ""
+		if _, ok := paths["text"]; ok {
+			params.Text = pgtype.Text{String: req.Post.Text, Valid: true}
+			linkURL = linkpreview.FirstURL(req.Post.Text)
+			params.LinkUrl = pgtype.Text{String: linkURL, Valid: true}
+		}
+		if _, ok := paths["images"]; ok {
+			params.Images = req.Post.Images
+		}
+		if _, ok := paths["attachments"]; ok {
+			params.Attachments = req.Post.Attachments
+		}
+		if _, ok := paths["visibility"]; ok {
+			params.Visibility = db.NullPostVisibility{PostVisibility: db.PostVisibility(req.Post.Visibility), Valid: true}
+		}
+		if _, ok := paths["pinned"]; ok {
+			params.Pinned = pgtype.Bool{Bool: req.Post.Pinned, Valid: true}
+		}
+		if _, ok := paths["content_warning"]; ok {
+			params.ContentWarning = pgtype.Text{String: strings.TrimSpace(req.Post.ContentWarning), Valid: true}
+		}
+		if _, ok := paths["sensitive_media"]; ok {
+			params.SensitiveMedia = pgtype.Bool{Bool: req.Post.SensitiveMedia, Valid: true}
+		}
+		if _, ok := paths["comment_policy"]; ok {
+			params.CommentPolicy = db.NullPostCommentPolicy{PostCommentPolicy: db.PostCommentPolicy(req.Post.CommentPolicy), Valid: true}
+		}
+
+		id, err := qtx.UpdatePostByUidAndAuthor(ctx, params)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("post not found")
+			}
+			return fmt.Errorf("update post: %w", err)
+		}
+		if _, ok := paths["images"]; ok {
+			if err := linkPostFiles(ctx, qtx, params.Uid, params.Author, db.PostFileKindIMAGE, req.Post.Images); err != nil {
+				return err
+			}
+		}
+		if _, ok := paths["attachments"]; ok {
+			if err := linkPostFiles(ctx, qtx, params.Uid, params.Author, db.PostFileKindATTACHMENT, req.Post.Attachments); err != nil {
+				return err
+			}
+		}
+
+		if _, ok := paths["tags"]; ok {
+			tags, err := resolveTags(ctx, qtx, req.Post.Tags)
+			if err != nil {
+				return err
+			}
+
+			if len(tags) > 0 {
+				if err := qtx.InsertTagsIfNotExists(ctx, tags); err != nil {
+					return fmt.Errorf("update post: insert tags if not exists: %w", err)
+				}
+			}
+
+			if err := qtx.DeletePostTagsNotInNames(ctx, db.DeletePostTagsNotInNamesParams{
+				PostID: id,
+				Tags:   tags,
+			}); err != nil {
+				return fmt.Errorf("update post: delete obsolete post tags: %w", err)
+			}
+
+			if len(tags) > 0 {
+				if err := qtx.InsertPostTagsByNames(ctx, db.InsertPostTagsByNamesParams{
+					PostID: id,
+					Tags:   tags,
+				}); err != nil {
+					return fmt.Errorf("update post: insert post tags: %w", err)
+				}
+				if err := s.producer.EnqueueUpdateTagSearchTx(ctx, tx, async.UpdateTagSearchArgs{
+					TagNames: tags,
+				}); err != nil {
+					return fmt.Errorf("update post: enqueue update tag search job: %w", err)
+				}
+			}
+		}
+		if err := s.producer.EnqueueUpdatePostSearchTx(ctx, tx, async.UpdatePostSearchArgs{
+			PostUID: params.Uid,
+			Action:  async.PostSearchActionUpsert,
+		}); err != nil {
+			return fmt.Errorf("enqueue update post search job: %w", err)
+		}
+		if _, ok := paths["visibility"]; ok {
+			if err := s.producer.EnqueueUpdateCommentSearchTx(ctx, tx, async.UpdateCommentSearchArgs{
+				PostUID: params.Uid,
+				Action:  async.CommentSearchActionSyncPost,
+			}); err != nil {
+				return fmt.Errorf("enqueue update comment search job: %w", err)
+			}
+		}
+		if linkURL != "" {
+			if err := s.producer.EnqueueLinkPreviewTx(ctx, tx, async.LinkPreviewArgs{URL: linkURL}); err != nil {
+				return fmt.Errorf("enqueue link preview job: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *PostService) DeletePost(ctx context.Context, uid string, req *api.DeletePostRequest) error {
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+		affected, err := qtx.ArchivePostByUidAndAuthor(ctx, db.ArchivePostByUidAndAuthorParams{
 			Uid:    util.UUID(req.Uid),
 			Author: util.UUID(uid),
 		})
@@ -543,6 +1188,92 @@ func (s *PostService) DeletePost(ctx context.Context, uid string, req *api.Delet
 		}); err != nil {
 			return fmt.Errorf("enqueue update post search job: %w", err)
 		}
+		if err := s.producer.EnqueueUpdateCommentSearchTx(ctx, tx, async.UpdateCommentSearchArgs{
+			PostUID: util.UUID(req.Uid),
+			Action:  async.CommentSearchActionSyncPost,
+		}); err != nil {
+			return fmt.Errorf("enqueue update comment search job: %w", err)
+		}
+		return nil
+	})
+}
+
+// ListMyTrashedPosts lists the caller's deleted posts that can still be
+// restored, most recently deleted first.
+func (s *PostService) ListMyTrashedPosts(ctx context.Context, uid string, req *api.ListMyTrashedPostsRequest) (*api.ListMyTrashedPostsResponse, error) {
+	token, err := decodeTrashPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListTrashedPostsByAuthor(ctx, db.ListTrashedPostsByAuthorParams{
+		Author:           util.UUID(uid),
+		ArchivedAfter:    trashCutoff(),
+		CursorArchivedAt: pgtype.Timestamptz{Time: time.UnixMicro(token.CursorArchivedAt).UTC(), Valid: token.CursorArchivedAt > 0},
+		CursorID:         uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list trashed posts: %w", err)
+	}
+
+	posts := make([]*api.TrashedPost, 0, len(rows))
+	for _, row := range rows {
+		posts = append(posts, &api.TrashedPost{
+			Uid:       row.Uid.String(),
+			Text:      row.Text,
+			Images:    row.Images,
+			Tags:      row.TagNames,
+			CreatedAt: row.CreatedAt.Time.Unix(),
+			DeletedAt: row.ArchivedAt.Time.Unix(),
+			ExpiresAt: row.ArchivedAt.Time.Add(async.TrashRetention).Unix(),
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodeTrashPageToken(trashPageToken{
+			CursorArchivedAt: last.ArchivedAt.Time.UnixMicro(),
+			CursorID:         last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListMyTrashedPostsResponse{
+		Posts:         posts,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// RestorePost moves a deleted post out of the trash and back into search.
+func (s *PostService) RestorePost(ctx context.Context, uid string, req *api.RestorePostRequest) error {
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		qtx := s.db.WithTx(tx)
+		affected, err := qtx.RestorePostByUidAndAuthor(ctx, db.RestorePostByUidAndAuthorParams{
+			Uid:           util.UUID(req.Uid),
+			Author:        util.UUID(uid),
+			ArchivedAfter: trashCutoff(),
+		})
+		if err != nil {
+			return fmt.Errorf("restore post: %w", err)
+		}
+		if affected == 0 {
+			return fmt.Errorf("post not found in trash")
+		}
+		if err := s.producer.EnqueueUpdatePostSearchTx(ctx, tx, async.UpdatePostSearchArgs{
+			PostUID: util.UUID(req.Uid),
+			Action:  async.PostSearchActionUpsert,
+		}); err != nil {
+			return fmt.Errorf("enqueue update post search job: %w", err)
+		}
+		if err := s.producer.EnqueueUpdateCommentSearchTx(ctx, tx, async.UpdateCommentSearchArgs{
+			PostUID: util.UUID(req.Uid),
+			Action:  async.CommentSearchActionSyncPost,
+		}); err != nil {
+			return fmt.Errorf("enqueue update comment search job: %w", err)
+		}
 		return nil
 	})
 }
@@ -570,9 +1301,25 @@ func (s *PostService) LikePost(ctx context.Context, uid string, req *api.LikePos
 			if affected > 0 {
 				count, err = qtx.IncrementPostLikeCount(ctx, postUid)
 				if err != nil {
-					return fmt.Errorf("increment post like count: %w", err)
+					return fmt.Errorf("increment post like count: %w", err)
+				}
+				shouldEnqueue = true
+
+				author, err := qtx.GetPostAuthorByUid(ctx, postUid)
+				if err != nil {
+					return fmt.Errorf("get post author: %w", err)
+				}
+				if author != userUid {
+					if err := s.producer.EnqueueLikeInboxTx(ctx, tx, async.LikeInboxArgs{
+						MessageUID:  uuid.New(),
+						ReceiverUID: author,
+						ActorUID:    userUid,
+						Type:        async.LikeInboxTypeLike,
+						PostUID:     postUid,
+					}); err != nil {
+						return fmt.Errorf("enqueue like inbox job: %w", err)
+					}
 				}
-				shouldEnqueue = true
 			} else {
 				count, err = qtx.GetPostLikeCount(ctx, postUid)
 				if err != nil {
@@ -624,6 +1371,114 @@ func (s *PostService) LikePost(ctx context.Context, uid string, req *api.LikePos
 	}, nil
 }
 
+func (s *PostService) ListReactionEmojis() *api.ListReactionEmojisResponse {
+	return &api.ListReactionEmojisResponse{
+		Emojis:       s.reactions.Emojis(),
+		DefaultEmoji: s.reactions.Default(),
+	}
+}
+
+// ReactPost adds or removes one emoji reaction. The default emoji is a like
+// and goes through LikePost so counts, search and the inbox stay in step.
+func (s *PostService) ReactPost(ctx context.Context, uid string, postUid string, emoji string, action api.ToggleAction) ([]*api.Reaction, error) {
+	if !s.reactions.Contains(emoji) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported emoji %q", emoji)
+	}
+	if err := s.checkPostVisible(ctx, uid, postUid); err != nil {
+		return nil, err
+	}
+
+	if emoji == s.reactions.Default() {
+		if _, err := s.LikePost(ctx, uid, &api.LikePostRequest{Uid: postUid, Action: action}); err != nil {
+			return nil, err
+		}
+	} else {
+		switch action {
+		case api.ToggleAction_TOGGLE_ACTION_ADD:
+			if _, err := s.db.InsertPostReactionEdge(ctx, db.InsertPostReactionEdgeParams{
+				PostUid: util.UUID(postUid),
+				UserUid: util.UUID(uid),
+				Emoji:   emoji,
+			}); err != nil {
+				return nil, fmt.Errorf("insert post reaction edge: %w", err)
+			}
+		case api.ToggleAction_TOGGLE_ACTION_REMOVE:
+			if _, err := s.db.DeletePostReactionEdge(ctx, db.DeletePostReactionEdgeParams{
+				PostUid: util.UUID(postUid),
+				UserUid: util.UUID(uid),
+				Emoji:   emoji,
+			}); err != nil {
+				return nil, fmt.Errorf("delete post reaction edge: %w", err)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported action: %v", action)
+		}
+	}
+
+	reactionMap, err := s.listReactionMap(ctx, uid, []uuid.UUID{util.UUID(postUid)})
+	if err != nil {
+		return nil, err
+	}
+	return reactionMap[util.UUID(postUid)], nil
+}
+
+func (s *PostService) ListPostReactors(ctx context.Context, viewerUid string, req *api.ListReactorsRequest) (*api.ListReactorsResponse, error) {
+	if !s.reactions.Contains(req.Emoji) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported emoji %q", req.Emoji)
+	}
+	token, err := decodePostPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPostVisible(ctx, viewerUid, req.PostUid); err != nil {
+		return nil, err
+	}
+
+	// Reactions often land within the same second, so the cursor keeps
+	// microseconds to match the (reacted_at, user_uid) keyset.
+	rows, err := s.db.ListPostReactors(ctx, db.ListPostReactorsParams{
+		PostUid:         util.UUID(req.PostUid),
+		Emoji:           req.Emoji,
+		LikeEmoji:       s.reactions.Default(),
+		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.UnixMicro(token.CursorCreatedAt).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list post reactors: %w", err)
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+			IsFollowing:    row.Following,
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodePostPageToken(postPageToken{
+			CursorCreatedAt: last.ReactedAt.Time.UnixMicro(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListReactorsResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func (s *PostService) CollectPost(ctx context.Context, uid string, req *api.CollectPostRequest) (*api.CollectPostResponse, error) {
 	postUid := util.UUID(req.Uid)
 	userUid := util.UUID(uid)
@@ -650,6 +1505,38 @@ func (s *PostService) CollectPost(ctx context.Context, uid string, req *api.Coll
 					return fmt.Errorf("post collection: increment post collection count: %w", err)
 				}
 				shouldEnqueue = true
+
+				folderUid, err := qtx.EnsureDefaultCollectionFolder(ctx, db.EnsureDefaultCollectionFolderParams{
+					Uid:      uuid.New(),
+					OwnerUid: userUid,
+					Name:     defaultCollectionFolderName,
+				})
+				if err != nil {
+					return fmt.Errorf("post collection: ensure default collection folder: %w", err)
+				}
+				if err := qtx.InsertCollectionFolderItem(ctx, db.InsertCollectionFolderItemParams{
+					FolderUid: folderUid,
+					PostUid:   postUid,
+					UserUid:   userUid,
+				}); err != nil {
+					return fmt.Errorf("post collection: insert collection folder item: %w", err)
+				}
+
+				author, err := qtx.GetPostAuthorByUid(ctx, postUid)
+				if err != nil {
+					return fmt.Errorf("post collection: get post author: %w", err)
+				}
+				if author != userUid {
+					if err := s.producer.EnqueueLikeInboxTx(ctx, tx, async.LikeInboxArgs{
+						MessageUID:  uuid.New(),
+						ReceiverUID: author,
+						ActorUID:    userUid,
+						Type:        async.LikeInboxTypeCollect,
+						PostUID:     postUid,
+					}); err != nil {
+						return fmt.Errorf("post collection: enqueue like inbox job: %w", err)
+					}
+				}
 			} else {
 				count, err = qtx.GetPostCollectionCount(ctx, postUid)
 				if err != nil {
@@ -672,6 +1559,13 @@ func (s *PostService) CollectPost(ctx context.Context, uid string, req *api.Coll
 					return fmt.Errorf("post collection: decrement post collection count: %w", err)
 				}
 				shouldEnqueue = true
+
+				if err := qtx.DeleteCollectionFolderItemsByUserAndPost(ctx, db.DeleteCollectionFolderItemsByUserAndPostParams{
+					UserUid: userUid,
+					PostUid: postUid,
+				}); err != nil {
+					return fmt.Errorf("post collection: delete collection folder items: %w", err)
+				}
 			} else {
 				count, err = qtx.GetPostCollectionCount(ctx, postUid)
 				if err != nil {
@@ -701,7 +1595,200 @@ func (s *PostService) CollectPost(ctx context.Context, uid string, req *api.Coll
 	}, nil
 }
 
-func (s *PostService) listAttachmentFileMap(ctx context.Context, attachmentLists ...[]string) (map[string]db.GetFilesByUrlsRow, error) {
+func (s *PostService) ListPostLikers(ctx context.Context, viewerUid string, req *api.ListPostLikersRequest) (*api.ListPostLikersResponse, error) {
+	token, err := decodePostPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPostVisible(ctx, viewerUid, req.Uid); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListPostLikers(ctx, db.ListPostLikersParams{
+		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		PostUid:         util.UUID(req.Uid),
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list post likers: %w", err)
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+			IsFollowing:    row.Following,
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodePostPageToken(postPageToken{
+			CursorCreatedAt: last.LikedAt.Time.Unix(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListPostLikersResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *PostService) ListPostCollectors(ctx context.Context, viewerUid string, req *api.ListPostCollectorsRequest) (*api.ListPostCollectorsResponse, error) {
+	token, err := decodePostPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPostVisible(ctx, viewerUid, req.Uid); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListPostCollectors(ctx, db.ListPostCollectorsParams{
+		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		PostUid:         util.UUID(req.Uid),
+		CursorCreatedAt: pgtype.Timestamptz{Time: time.Unix(token.CursorCreatedAt, 0).UTC(), Valid: token.CursorCreatedAt > 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(token.CursorID), Valid: token.CursorID != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list post collectors: %w", err)
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+			IsFollowing:    row.Following,
+		})
+	}
+
+	var nextPageToken string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextPageToken, err = encodePostPageToken(postPageToken{
+			CursorCreatedAt: last.CollectedAt.Time.Unix(),
+			CursorID:        last.Uid.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	return &api.ListPostCollectorsResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// checkPostVisible reports a private post as missing to everyone but its author.
+func (s *PostService) checkPostVisible(ctx context.Context, viewerUid string, postUid string) error {
+	row, err := s.db.GetPostVisibilityByUid(ctx, util.UUID(postUid))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("post not found")
+		}
+		return fmt.Errorf("get post: %w", err)
+	}
+	if row.Visibility == db.PostVisibilityPRIVATE && util.UUID(viewerUid) != row.Author {
+		return fmt.Errorf("post not found")
+	}
+	return nil
+}
+
+// resolveTags normalizes user supplied tags, maps aliases to their canonical
+// tag and rejects banned tags.
+func resolveTags(ctx context.Context, qtx *db.Queries, tags []string) ([]string, error) {
+	tags = util.NormalizeStrings(tags)
+	if len(tags) == 0 {
+		return tags, nil
+	}
+
+	rows, err := qtx.ResolveTagNames(ctx, tags)
+	if err != nil {
+		return nil, fmt.Errorf("resolve tag names: %w", err)
+	}
+
+	resolved := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.Banned {
+			return nil, status.Errorf(codes.InvalidArgument, "tag %q is not allowed", row.Name)
+		}
+		resolved = append(resolved, row.Name)
+	}
+	return util.NormalizeStrings(resolved), nil
+}
+
+// linkPostFiles checks that every url is a NORMAL file uploaded by uploader,
+// and an image when kind is IMAGE, then records them as the post's files of
+// that kind. The file rows stay share-locked until the transaction ends.
+func linkPostFiles(ctx context.Context, qtx *db.Queries, postUid, uploader uuid.UUID, kind db.PostFileKind, urls []string) error {
+	if err := qtx.DeletePostFilesByKind(ctx, db.DeletePostFilesByKindParams{
+		PostUid: postUid,
+		Kind:    kind,
+	}); err != nil {
+		return fmt.Errorf("delete post files: %w", err)
+	}
+	if len(urls) == 0 {
+		return nil
+	}
+
+	fileIDs, err := lockUploadedFiles(ctx, qtx, uploader, kind, urls)
+	if err != nil {
+		return err
+	}
+	if err := qtx.InsertPostFiles(ctx, db.InsertPostFilesParams{
+		PostUid: postUid,
+		FileIds: fileIDs,
+		Kind:    kind,
+	}); err != nil {
+		return fmt.Errorf("insert post files: %w", err)
+	}
+	return nil
+}
+
+// lockUploadedFiles checks that every url is a live upload of uploader, and an
+// image when kind is IMAGE, and returns the file ids in url order. The rows
+// stay share-locked so they cannot be deleted before the caller links them.
+func lockUploadedFiles(ctx context.Context, qtx *db.Queries, uploader uuid.UUID, kind db.PostFileKind, urls []string) ([]int32, error) {
+	files, err := qtx.LockFilesByUrls(ctx, urls)
+	if err != nil {
+		return nil, fmt.Errorf("lock files: %w", err)
+	}
+	fileMap := make(map[string]db.LockFilesByUrlsRow, len(files))
+	for _, file := range files {
+		fileMap[file.Url] = file
+	}
+
+	fileIDs := make([]int32, 0, len(urls))
+	for _, url := range urls {
+		file, ok := fileMap[url]
+		if !ok || file.Status != db.FileStatusNORMAL || file.Uploader != uploader {
+			return nil, status.Errorf(codes.InvalidArgument, "file %q not found", url)
+		}
+		if kind == db.PostFileKindIMAGE && !strings.HasPrefix(file.ContentType, "image/") {
+			return nil, status.Errorf(codes.InvalidArgument, "file %q is not an image", url)
+		}
+		fileIDs = append(fileIDs, file.ID)
+	}
+	return fileIDs, nil
+}
+
+func listAttachmentFileMap(ctx context.Context, q *db.Queries, attachmentLists ...[]string) (map[string]db.GetFilesByUrlsRow, error) {
 	attachmentUrls := make([]string, 0)
 	seen := make(map[string]struct{})
 	for _, list := range attachmentLists {
@@ -714,7 +1801,7 @@ func (s *PostService) listAttachmentFileMap(ctx context.Context, attachmentLists
 		}
 	}
 
-	files, err := s.db.GetFilesByUrls(ctx, attachmentUrls)
+	files, err := q.GetFilesByUrls(ctx, attachmentUrls)
 	if err != nil {
 		return nil, fmt.Errorf("get attachments: %w", err)
 	}
@@ -727,6 +1814,88 @@ func (s *PostService) listAttachmentFileMap(ctx context.Context, attachmentLists
 	return fileMap, nil
 }
 
+// visibleIP hides the raw client IP from everyone but admins.
+func visibleIP(ip string, isAdmin bool) string {
+	if !isAdmin {
+		return ""
+	}
+	return ip
+}
+
+// recordViews counts an impression of each post, deduplicated per signed-in
+// viewer or, for anonymous requests, per client IP.
+func (s *PostService) recordViews(ctx context.Context, viewerUid string, postUIDs ...uuid.UUID) {
+	viewerKey := ""
+	if viewerUid != "" {
+		viewerKey = "user:" + viewerUid
+	} else if ip, ok := auth.ClientIPFromContext(ctx); ok {
+		viewerKey = "ip:" + ip
+	}
+	s.views.Record(viewerKey, postUIDs...)
+}
+
+func (s *PostService) listLinkPreviewMap(ctx context.Context, urls []string) (map[string]*api.LinkPreview, error) {
+	urls = util.NormalizeStrings(urls)
+	if len(urls) == 0 {
+		return nil, nil
+	}
+
+	rows, err := s.db.ListReadyLinkPreviewsByURLs(ctx, urls)
+	if err != nil {
+		return nil, fmt.Errorf("get link previews: %w", err)
+	}
+
+	previewMap := make(map[string]*api.LinkPreview, len(rows))
+	for _, row := range rows {
+		previewMap[row.Url] = &api.LinkPreview{
+			Url:         row.Url,
+			Title:       row.Title,
+			Description: row.Description,
+			SiteName:    row.SiteName,
+			ImageUrl:    row.ImageUrl,
+		}
+	}
+
+	return previewMap, nil
+}
+
+// listReactionMap returns each post's reactions, likes included as the
+// default emoji, with viewerUid's own reactions flagged.
+func (s *PostService) listReactionMap(ctx context.Context, viewerUid string, postUids []uuid.UUID) (map[uuid.UUID][]*api.Reaction, error) {
+	if len(postUids) == 0 {
+		return nil, nil
+	}
+
+	rows, err := s.db.ListPostReactionCounts(ctx, db.ListPostReactionCountsParams{
+		Viewer:    uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		LikeEmoji: s.reactions.Default(),
+		PostUids:  postUids,
+		Emojis:    s.reactions.Emojis(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list post reaction counts: %w", err)
+	}
+
+	reactionMap := make(map[uuid.UUID][]*api.Reaction, len(postUids))
+	for _, row := range rows {
+		reactionMap[row.PostUid] = append(reactionMap[row.PostUid], &api.Reaction{
+			Emoji:   row.Emoji,
+			Count:   row.Count,
+			Reacted: row.Reacted,
+		})
+	}
+	for _, reactions := range reactionMap {
+		sortReactions(s.reactions, reactions)
+	}
+	return reactionMap, nil
+}
+
+func sortReactions(set *reaction.Set, reactions []*api.Reaction) {
+	slices.SortFunc(reactions, func(a, b *api.Reaction) int {
+		return set.Position(a.Emoji) - set.Position(b.Emoji)
+	})
+}
+
 func buildAttachmentsByURLOrder(urls []string, fileMap map[string]db.GetFilesByUrlsRow) []*api.Attachment {
 	attachments := make([]*api.Attachment, 0, len(urls))
 	for _, url := range urls {
@@ -746,14 +1915,27 @@ func buildAttachmentsByURLOrder(urls []string, fileMap map[string]db.GetFilesByU
 }
 
 type postPageToken struct {
-	CursorCreatedAt int64  `json:"cursor_created_at,omitempty"`
-	CursorID        string `json:"cursor_id,omitempty"`
+	Sort            string  `json:"sort,omitempty"`
+	CursorCreatedAt int64   `json:"cursor_created_at,omitempty"`
+	CursorRepliedOn int64   `json:"cursor_replied_on,omitempty"`
+	CursorHotScore  float64 `json:"cursor_hot_score,omitempty"`
+	CursorID        string  `json:"cursor_id,omitempty"`
+}
+
+type trashPageToken struct {
+	CursorArchivedAt int64  `json:"cursor_archived_at,omitempty"` // unix micros
+	CursorID         string `json:"cursor_id,omitempty"`
 }
 
 type postSearchPageToken struct {
 	Offset int64 `json:"offset,omitempty"`
 }
 
+type tagPageToken struct {
+	CursorCreatedAt int64  `json:"cursor_created_at,omitempty"`
+	CursorName      string `json:"cursor_name,omitempty"`
+}
+
 func decodePostPageToken(pageToken string) (postPageToken, error) {
 	if pageToken == "" {
 		return postPageToken{}, nil
@@ -803,3 +1985,58 @@ func encodePostSearchPageToken(token postSearchPageToken) (string, error) {
 	}
 	return base64.RawURLEncoding.EncodeToString(raw), nil
 }
+
+func decodeTagPageToken(pageToken string) (tagPageToken, error) {
+	if pageToken == "" {
+		return tagPageToken{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return tagPageToken{}, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	var token tagPageToken
+	if err := json.Unmarshal(raw, &token); err != nil {
+		return tagPageToken{}, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	return token, nil
+}
+
+func encodeTagPageToken(token tagPageToken) (string, error) {
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeTrashPageToken(pageToken string) (trashPageToken, error) {
+	if pageToken == "" {
+		return trashPageToken{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return trashPageToken{}, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	var token trashPageToken
+	if err := json.Unmarshal(raw, &token); err != nil {
+		return trashPageToken{}, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	return token, nil
+}
+
+func encodeTrashPageToken(token trashPageToken) (string, error) {
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// trashCutoff is the oldest deletion time that is still restorable.
+func trashCutoff() pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: time.Now().Add(-async.TrashRetention), Valid: true}
+}
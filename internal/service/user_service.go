@@ -77,6 +77,13 @@ func (s *UserService) CreateUser(ctx context.Context, req *api.CreateUserRequest
 		}); err != nil {
 			return fmt.Errorf("enqueue update user search job: %w", err)
 		}
+		// New users have nothing to go on but popular accounts; compute
+		// those now instead of waiting for the next full refresh.
+		if err := s.producer.EnqueueRefreshFollowSuggestionsTx(ctx, tx, async.RefreshFollowSuggestionsArgs{
+			UserUIDs: []uuid.UUID{uid},
+		}); err != nil {
+			return fmt.Errorf("enqueue follow suggestions job: %w", err)
+		}
 		return nil
 	}); err != nil {
 		return err
@@ -93,6 +100,7 @@ func (s *UserService) GetUser(ctx context.Context, viewerUid string, req *api.Ge
 		return nil, fmt.Errorf("get user: %w", err)
 	}
 	isFollowing := false
+	followsYou := false
 	if viewerUid != "" && viewerUid != req.Uid {
 		isFollowing, err = s.db.IsFollowing(ctx, db.IsFollowingParams{
 			FollowerUid: util.UUID(viewerUid),
@@ -101,6 +109,13 @@ func (s *UserService) GetUser(ctx context.Context, viewerUid string, req *api.Ge
 		if err != nil {
 			return nil, fmt.Errorf("get follow: %w", err)
 		}
+		followsYou, err = s.db.IsFollowing(ctx, db.IsFollowingParams{
+			FollowerUid: util.UUID(req.Uid),
+			FolloweeUid: util.UUID(viewerUid),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get follow back: %w", err)
+		}
 	}
 	return &api.GetUserResponse{
 		User: &api.User{
@@ -113,6 +128,7 @@ func (s *UserService) GetUser(ctx context.Context, viewerUid string, req *api.Ge
 			FollowersCount: row.FollowersCount,
 			FollowingCount: row.FollowingCount,
 			IsFollowing:    isFollowing,
+			FollowsYou:     followsYou,
 			Description:    row.Description,
 		},
 	}, nil
@@ -185,6 +201,8 @@ func (s *UserService) GetMe(ctx context.Context, uid string) (*api.GetMeResponse
 			IsFollowing:    false,
 			Description:    row.Description,
 		},
+		SensitiveMediaPreference: string(row.SensitiveMediaPreference),
+		HideFollowLists:          row.HideFollowLists,
 	}, nil
 }
 
@@ -207,6 +225,15 @@ func (s *UserService) UpdateMe(ctx context.Context, uid string, req *api.UpdateM
 	if _, ok := paths["avatar_url"]; ok {
 		params.AvatarUrl = pgtype.Text{String: req.User.AvatarUrl, Valid: true}
 	}
+	if _, ok := paths["sensitive_media_preference"]; ok {
+		params.SensitiveMediaPreference = db.NullSensitiveMediaPreference{
+			SensitiveMediaPreference: db.SensitiveMediaPreference(req.User.SensitiveMediaPreference),
+			Valid:                    true,
+		}
+	}
+	if _, ok := paths["hide_follow_lists"]; ok {
+		params.HideFollowLists = pgtype.Bool{Bool: req.User.HideFollowLists, Valid: true}
+	}
 	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
 		qtx := s.db.WithTx(tx)
 
@@ -0,0 +1,143 @@
+// Package viewcount buffers post views in memory and writes them to the
+// database in batches.
+package viewcount
+
+import (
+	"aeibi/internal/repository/db"
+	searchrepo "aeibi/internal/repository/search"
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	flushInterval = 10 * time.Second
+	// dedupeWindow is how long repeat views of a post by the same viewer are
+	// ignored.
+	dedupeWindow = 30 * time.Minute
+	// maxSeen bounds the dedupe set between flushes; past it the set is
+	// reset and a few repeat views may be counted.
+	maxSeen = 1_000_000
+)
+
+type Counter struct {
+	db     *db.Queries
+	search *searchrepo.Search
+
+	mu      sync.Mutex
+	seen    map[string]time.Time
+	pending map[uuid.UUID]int64
+}
+
+func New(pool *pgxpool.Pool, search *searchrepo.Search) *Counter {
+	return &Counter{
+		db:      db.New(pool),
+		search:  search,
+		seen:    make(map[string]time.Time),
+		pending: make(map[uuid.UUID]int64),
+	}
+}
+
+// Record counts one view of each post by viewerKey, skipping posts the same
+// viewer has already seen within the dedupe window. An empty viewerKey is
+// ignored since it cannot be deduplicated.
+func (c *Counter) Record(viewerKey string, postUIDs ...uuid.UUID) {
+	if c == nil || viewerKey == "" || len(postUIDs) == 0 {
+		return
+	}
+
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, postUID := range postUIDs {
+		key := postUID.String() + "|" + viewerKey
+		if expiresAt, ok := c.seen[key]; ok && now.Before(expiresAt) {
+			continue
+		}
+		c.seen[key] = now.Add(dedupeWindow)
+		c.pending[postUID]++
+	}
+}
+
+// Run flushes buffered views every flushInterval until ctx is done, then
+// flushes once more.
+func (c *Counter) Run(ctx context.Context) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.Flush(ctx); err != nil {
+				slog.Warn("flush post views", "error", err)
+			}
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if err := c.Flush(flushCtx); err != nil {
+				slog.Warn("flush post views", "error", err)
+			}
+			cancel()
+			return
+		}
+	}
+}
+
+// Flush writes buffered views to posts.view_count and refreshes view_count in
+// search. Views that fail to write are kept for the next flush.
+func (c *Counter) Flush(ctx context.Context) error {
+	now := time.Now()
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = make(map[uuid.UUID]int64)
+	for key, expiresAt := range c.seen {
+		if !now.Before(expiresAt) {
+			delete(c.seen, key)
+		}
+	}
+	if len(c.seen) > maxSeen {
+		c.seen = make(map[string]time.Time)
+	}
+	c.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	uids := make([]uuid.UUID, 0, len(pending))
+	counts := make([]int64, 0, len(pending))
+	for uid, count := range pending {
+		uids = append(uids, uid)
+		counts = append(counts, count)
+	}
+
+	rows, err := c.db.IncrementPostViewCounts(ctx, db.IncrementPostViewCountsParams{
+		Uids:   uids,
+		Counts: counts,
+	})
+	if err != nil {
+		c.mu.Lock()
+		for uid, count := range pending {
+			c.pending[uid] += count
+		}
+		c.mu.Unlock()
+		return fmt.Errorf("increment post view counts: %w", err)
+	}
+
+	docs := make([]searchrepo.PostViewCountDocument, 0, len(rows))
+	for _, row := range rows {
+		docs = append(docs, searchrepo.PostViewCountDocument{
+			UID:       row.Uid.String(),
+			ViewCount: row.ViewCount,
+		})
+	}
+	if err := c.search.UpdatePostViewCounts(docs); err != nil {
+		return fmt.Errorf("update post view counts in search: %w", err)
+	}
+	return nil
+}
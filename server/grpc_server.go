@@ -10,9 +10,12 @@ import (
 	"aeibi/internal/auth"
 	"aeibi/internal/config"
 	"aeibi/internal/controller"
+	"aeibi/internal/geoip"
+	"aeibi/internal/reaction"
 	"aeibi/internal/repository/oss"
 	searchrepo "aeibi/internal/repository/search"
 	"aeibi/internal/service"
+	"aeibi/internal/viewcount"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -21,16 +24,32 @@ import (
 )
 
 // StartGRPCServer starts the gRPC server and returns it plus an error channel.
-func StartGRPCServer(ctx context.Context, cfg *config.Config, dbPool *pgxpool.Pool, ossClient *oss.OSS, searchRepo *searchrepo.Search, riverClient *river.Client[pgx.Tx]) (*grpc.Server, <-chan error, error) {
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(auth.NewAuthUnaryServerInterceptor(cfg.Auth.JWTSecret)))
+func StartGRPCServer(ctx context.Context, cfg *config.Config, dbPool *pgxpool.Pool, ossClient *oss.OSS, searchRepo *searchrepo.Search, geoResolver *geoip.Resolver, riverClient *river.Client[pgx.Tx]) (*grpc.Server, <-chan error, error) {
+	clientIPInterceptor, err := auth.NewClientIPUnaryServerInterceptor(cfg.Server.TrustedProxies)
+	if err != nil {
+		return nil, nil, fmt.Errorf("client ip interceptor: %w", err)
+	}
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		clientIPInterceptor,
+		auth.NewAuthUnaryServerInterceptor(cfg.Auth.JWTSecret),
+	))
+
+	reactions, err := reaction.New(cfg.Reactions.Emojis)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reactions: %w", err)
+	}
 
 	userSvc := service.NewUserService(dbPool, ossClient, searchRepo, cfg, riverClient)
 	followSvc := service.NewFollowService(dbPool, riverClient)
-	postSvc := service.NewPostService(dbPool, ossClient, searchRepo, riverClient)
+	viewCounter := viewcount.New(dbPool, searchRepo)
+	go viewCounter.Run(ctx)
+	postSvc := service.NewPostService(dbPool, ossClient, searchRepo, riverClient, viewCounter, geoResolver, reactions)
 	fileSvc := service.NewFileService(dbPool, ossClient, cfg.OSS.MaxUploadSizeKB)
-	commentSvc := service.NewCommentService(dbPool, riverClient)
+	commentSvc := service.NewCommentService(dbPool, searchRepo, riverClient, geoResolver, reactions, cfg.Comments)
 	messageSvc := service.NewMessageService(dbPool)
 	reportSvc := service.NewReportService(dbPool)
+	collectionSvc := service.NewCollectionService(dbPool)
+	adminSvc := service.NewAdminService(dbPool, riverClient)
 
 	userHandler := controller.NewUserHandler(userSvc)
 	followHandler := controller.NewFollowHandler(followSvc)
@@ -39,6 +58,9 @@ func StartGRPCServer(ctx context.Context, cfg *config.Config, dbPool *pgxpool.Po
 	commentHandler := controller.NewCommentHandler(commentSvc)
 	messageHandler := controller.NewMessageHandler(messageSvc)
 	reportHandler := controller.NewReportHandler(reportSvc)
+	collectionHandler := controller.NewCollectionHandler(collectionSvc)
+	adminHandler := controller.NewAdminHandler(adminSvc)
+	reactionHandler := controller.NewReactionHandler(postSvc, commentSvc)
 
 	api.RegisterUserServiceServer(grpcServer, userHandler)
 	api.RegisterFollowServiceServer(grpcServer, followHandler)
@@ -47,6 +69,9 @@ func StartGRPCServer(ctx context.Context, cfg *config.Config, dbPool *pgxpool.Po
 	api.RegisterCommentServiceServer(grpcServer, commentHandler)
 	api.RegisterMessageServiceServer(grpcServer, messageHandler)
 	api.RegisterReportServiceServer(grpcServer, reportHandler)
+	api.RegisterCollectionServiceServer(grpcServer, collectionHandler)
+	api.RegisterAdminServiceServer(grpcServer, adminHandler)
+	api.RegisterReactionServiceServer(grpcServer, reactionHandler)
 
 	lis, err := net.Listen("tcp", cfg.Server.GRPCAddr)
 	if err != nil {
@@ -0,0 +1,24 @@
+package util
+
+import "strings"
+
+// csvFormulaPrefixes start a cell that spreadsheets evaluate as a formula.
+// A leading quote is included so escaping stays reversible.
+const csvFormulaPrefixes = "=+-@\t\r'"
+
+// EscapeCSVCell prefixes a quote to cells a spreadsheet would run as a
+// formula, so user-controlled text in exports is shown as plain text.
+func EscapeCSVCell(s string) string {
+	if s != "" && strings.ContainsRune(csvFormulaPrefixes, rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// UnescapeCSVCell reverses EscapeCSVCell.
+func UnescapeCSVCell(s string) string {
+	if len(s) > 1 && s[0] == '\'' && strings.ContainsRune(csvFormulaPrefixes, rune(s[1])) {
+		return s[1:]
+	}
+	return s
+}